@@ -12,6 +12,7 @@ import (
 
 	gaiaerrors "github.com/cosmos/gaia/v11/types/errors"
 
+	msgfilterkeeper "github.com/Nolus-Protocol/nolus-core/x/msgfilter/keeper"
 	taxkeeper "github.com/Nolus-Protocol/nolus-core/x/tax/keeper"
 	taxtypes "github.com/Nolus-Protocol/nolus-core/x/tax/types"
 )
@@ -21,6 +22,7 @@ type HandlerOptions struct {
 	ante.HandlerOptions
 	BankKeeper        taxtypes.BankKeeper
 	TaxKeeper         taxkeeper.Keeper
+	MsgFilterKeeper   msgfilterkeeper.Keeper
 	TxCounterStoreKey storetypes.StoreKey
 	WasmConfig        *wasmTypes.WasmConfig
 	IBCKeeper         *keeper.Keeper
@@ -54,19 +56,22 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 		wasmkeeper.NewCountTXDecorator(options.TxCounterStoreKey),
 		ante.NewExtensionOptionsDecorator(nil),
 		ante.NewValidateBasicDecorator(),
+		msgfilterkeeper.NewMsgFilterDecorator(options.MsgFilterKeeper), // reject the blocked messages before any fee is deducted
 		ante.NewTxTimeoutHeightDecorator(),
 		ante.NewValidateMemoDecorator(options.AccountKeeper),
 		ante.NewConsumeGasForTxSizeDecorator(options.AccountKeeper),
 		ante.NewDeductFeeDecorator(options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper, options.TxFeeChecker),
 
-		// Tax calculation must be called after fees
+		// Tax calculation must be called after fees. The IBC relay transactions within the
+		// relay gas budget skip both the minimum gas prices check and the tax.
 		taxkeeper.NewDeductTaxDecorator(options.AccountKeeper, options.BankKeeper, options.TaxKeeper),
 		ante.NewSetPubKeyDecorator(options.AccountKeeper), // SetPubKeyDecorator must be called before all signature verification decorators
 		ante.NewValidateSigCountDecorator(options.AccountKeeper),
 		ante.NewSigGasConsumeDecorator(options.AccountKeeper, sigGasConsumer),
 		ante.NewSigVerificationDecorator(options.AccountKeeper, options.SignModeHandler),
 		ante.NewIncrementSequenceDecorator(options.AccountKeeper),
-		ibcante.NewRedundantRelayDecorator(options.IBCKeeper),
+		ibcante.NewRedundantRelayDecorator(options.IBCKeeper),    // redundant relays fail even when fee-free
+		taxkeeper.NewConsumeRelayGasDecorator(options.TaxKeeper), // only the relays of new packets use up the relay gas budget
 	}
 
 	return sdk.ChainAnteDecorators(anteDecorators...), nil
@@ -32,6 +32,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
 	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	capabilitykeeper "github.com/cosmos/cosmos-sdk/x/capability/keeper"
 	"github.com/cosmos/cosmos-sdk/x/crisis"
 	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
@@ -48,9 +49,12 @@ import (
 	"github.com/Nolus-Protocol/nolus-core/app/params"
 	appparams "github.com/Nolus-Protocol/nolus-core/app/params"
 	"github.com/Nolus-Protocol/nolus-core/app/upgrades"
-	v053 "github.com/Nolus-Protocol/nolus-core/app/upgrades/v053"
+	v06 "github.com/Nolus-Protocol/nolus-core/app/upgrades/v06"
 	"github.com/Nolus-Protocol/nolus-core/docs"
 
+	ibckeeper "github.com/cosmos/ibc-go/v7/modules/core/keeper"
+	ibctestingtypes "github.com/cosmos/ibc-go/v7/testing/types"
+
 	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
 	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
 	interchaintxstypes "github.com/neutron-org/neutron/x/interchaintxs/types"
@@ -64,7 +68,8 @@ const (
 var (
 	DefaultNodeHome string
 
-	Upgrades = []upgrades.Upgrade{v053.Upgrade}
+	Upgrades = []upgrades.Upgrade{v06.Upgrade}
+	Forks    = []upgrades.Fork{}
 )
 
 var (
@@ -217,6 +222,7 @@ func New(
 			},
 			BankKeeper:        app.BankKeeper,
 			TaxKeeper:         *app.TaxKeeper,
+			MsgFilterKeeper:   *app.MsgFilterKeeper,
 			TxCounterStoreKey: app.GetKVStoreKey()[wasmtypes.StoreKey],
 			WasmConfig:        &app.WasmConfig,
 			IBCKeeper:         app.IBCKeeper,
@@ -320,8 +326,21 @@ func (app *App) Name() string { return app.BaseApp.Name() }
 // GetBaseApp returns the base app of the application.
 func (app *App) GetBaseApp() *baseapp.BaseApp { return app.BaseApp }
 
+// GetStakingKeeper implements the TestingApp interface of the ibc-go testing package.
+func (app *App) GetStakingKeeper() ibctestingtypes.StakingKeeper { return app.StakingKeeper }
+
+// GetIBCKeeper implements the TestingApp interface of the ibc-go testing package.
+func (app *App) GetIBCKeeper() *ibckeeper.Keeper { return app.IBCKeeper }
+
+// GetScopedIBCKeeper implements the TestingApp interface of the ibc-go testing package.
+func (app *App) GetScopedIBCKeeper() capabilitykeeper.ScopedKeeper { return app.ScopedIBCKeeper }
+
+// GetTxConfig implements the TestingApp interface of the ibc-go testing package.
+func (app *App) GetTxConfig() client.TxConfig { return app.encodingConfig.TxConfig }
+
 // BeginBlocker application updates every begin block.
 func (app *App) BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock) abci.ResponseBeginBlock {
+	BeginBlockForks(ctx, app)
 	return app.mm.BeginBlock(ctx, req)
 }
 
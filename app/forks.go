@@ -0,0 +1,27 @@
+package app
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// BeginBlockForks executes the BeginForkLogic of the fork scheduled for the current block height, if any.
+// The fork logic runs in a cached context whose writes are only committed if it succeeds. A failing fork
+// halts the chain like a failing upgrade handler does, as the state expected after the fork is missing.
+func BeginBlockForks(ctx sdk.Context, app *App) {
+	for _, fork := range Forks {
+		if ctx.BlockHeight() == fork.UpgradeHeight {
+			ctx.Logger().Info("executing fork logic", "name", fork.UpgradeName, "height", fork.UpgradeHeight)
+
+			cacheCtx, write := ctx.CacheContext()
+			if err := fork.BeginForkLogic(cacheCtx, &app.AppKeepers); err != nil {
+				ctx.Logger().Error("fork logic failed", "name", fork.UpgradeName, "height", fork.UpgradeHeight, "error", err)
+				panic(fmt.Errorf("failed to execute fork %s at height %d: %w", fork.UpgradeName, fork.UpgradeHeight, err))
+			}
+			write()
+
+			return
+		}
+	}
+}
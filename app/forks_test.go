@@ -0,0 +1,74 @@
+package app_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Nolus-Protocol/nolus-core/app"
+	"github.com/Nolus-Protocol/nolus-core/app/keepers"
+	"github.com/Nolus-Protocol/nolus-core/app/upgrades"
+	"github.com/Nolus-Protocol/nolus-core/app/upgrades/fixes"
+	"github.com/Nolus-Protocol/nolus-core/testutil/simapp"
+)
+
+func TestBeginBlockForks(t *testing.T) {
+	nolusApp, err := simapp.TestSetup(t)
+	require.NoError(t, err)
+
+	var executedAt []int64
+	forks := app.Forks
+	app.Forks = []upgrades.Fork{
+		{
+			UpgradeName:   "test-fork",
+			UpgradeHeight: 10,
+			BeginForkLogic: func(ctx sdk.Context, keepers *keepers.AppKeepers) error {
+				require.NotNil(t, keepers.MintKeeper)
+				executedAt = append(executedAt, ctx.BlockHeight())
+				return nil
+			},
+		},
+	}
+	defer func() { app.Forks = forks }()
+
+	for height := int64(8); height <= 12; height++ {
+		ctx := nolusApp.BaseApp.NewContext(false, tmproto.Header{Height: height})
+		app.BeginBlockForks(ctx, nolusApp)
+	}
+
+	require.Equal(t, []int64{10}, executedAt)
+}
+
+func TestBeginBlockForksFailure(t *testing.T) {
+	nolusApp, err := simapp.TestSetup(t)
+	require.NoError(t, err)
+
+	ctx := nolusApp.BaseApp.NewContext(false, tmproto.Header{Height: 10})
+	params := nolusApp.MintKeeper.GetParams(ctx)
+
+	forks := app.Forks
+	app.Forks = []upgrades.Fork{
+		{
+			UpgradeName:   "test-fork",
+			UpgradeHeight: 10,
+			BeginForkLogic: func(ctx sdk.Context, keepers *keepers.AppKeepers) error {
+				changed := params
+				changed.MaxMintableNanoseconds = params.MaxMintableNanoseconds.AddUint64(1)
+				require.NoError(t, fixes.ResetMintParams(ctx, keepers, changed))
+
+				return errors.New("fork failed")
+			},
+		},
+	}
+	defer func() { app.Forks = forks }()
+
+	// the fork halts the chain without applying any of its changes
+	require.PanicsWithError(t, "failed to execute fork test-fork at height 10: fork failed", func() {
+		app.BeginBlockForks(ctx, nolusApp)
+	})
+	require.Equal(t, params, nolusApp.MintKeeper.GetParams(ctx))
+}
@@ -0,0 +1,190 @@
+package app
+
+import (
+	"fmt"
+	"strings"
+
+	wasmcli "github.com/CosmWasm/wasmd/x/wasm/client/cli"
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+
+	minttypes "github.com/Nolus-Protocol/nolus-core/x/mint/types"
+	taxtypes "github.com/Nolus-Protocol/nolus-core/x/tax/types"
+)
+
+// ValidateNolusGenesis performs the cross-module checks of a genesis state which the
+// per-module validation does not cover. It returns all the problems found, each one
+// pointing to the genesis field to fix.
+//
+// The wasm contracts are those of the wasm genesis state, including the ones
+// instantiated by its gen_msgs.
+func ValidateNolusGenesis(cdc codec.Codec, genesis GenesisState) []error {
+	var (
+		authGenesis    authtypes.GenesisState
+		bankGenesis    banktypes.GenesisState
+		stakingGenesis stakingtypes.GenesisState
+		mintGenesis    minttypes.GenesisState
+		taxGenesis     taxtypes.GenesisState
+		wasmGenesis    wasmtypes.GenesisState
+	)
+
+	var errs []error
+	for _, module := range []struct {
+		name  string
+		state codec.ProtoMarshaler
+	}{
+		{authtypes.ModuleName, &authGenesis},
+		{banktypes.ModuleName, &bankGenesis},
+		{stakingtypes.ModuleName, &stakingGenesis},
+		{minttypes.ModuleName, &mintGenesis},
+		{taxtypes.ModuleName, &taxGenesis},
+		{wasmtypes.ModuleName, &wasmGenesis},
+	} {
+		if err := unmarshalModuleGenesis(cdc, genesis, module.name, module.state); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if len(errs) != 0 {
+		return errs
+	}
+
+	balances := make(map[string]sdk.Coins, len(bankGenesis.Balances))
+	for _, balance := range bankGenesis.Balances {
+		balances[balance.Address] = balances[balance.Address].Add(balance.Coins...)
+	}
+
+	errs = append(errs, validateTaxGenesis(taxGenesis, wasmGenesis)...)
+	errs = append(errs, validateMintGenesis(mintGenesis, taxGenesis, stakingGenesis, bankGenesis, balances)...)
+	errs = append(errs, validateVestingAccounts(authGenesis, balances)...)
+
+	return errs
+}
+
+func unmarshalModuleGenesis(cdc codec.Codec, genesis GenesisState, module string, state codec.ProtoMarshaler) error {
+	bz, found := genesis[module]
+	if !found {
+		return fmt.Errorf("%s: the genesis state is missing from app_state", module)
+	}
+
+	if err := cdc.UnmarshalJSON(bz, state); err != nil {
+		return fmt.Errorf("%s: failed to unmarshal the genesis state: %w", module, err)
+	}
+
+	return nil
+}
+
+// validateTaxGenesis checks that the x/tax contracts are instantiated at genesis and that the DEX denoms are valid.
+func validateTaxGenesis(taxGenesis taxtypes.GenesisState, wasmGenesis wasmtypes.GenesisState) []error {
+	contracts := make(map[string]bool)
+	for _, contract := range wasmcli.GetAllContracts(&wasmGenesis) {
+		contracts[contract.ContractAddress] = true
+	}
+
+	var errs []error
+	checkContract := func(field, address string) {
+		if !contracts[address] {
+			errs = append(errs, fmt.Errorf("tax: %s %q is not a contract of the wasm genesis; "+
+				"instantiate it with `nolusd add-wasm-genesis-message instantiate-contract` or correct app_state.tax.params.%s", field, address, field))
+		}
+	}
+
+	params := taxGenesis.Params
+	checkContract("contract_address", params.ContractAddress)
+
+	denoms := make(map[string]bool)
+	for i, feeParam := range params.FeeParams {
+		checkContract(fmt.Sprintf("fee_params[%d].oracle_address", i), feeParam.OracleAddress)
+		checkContract(fmt.Sprintf("fee_params[%d].profit_address", i), feeParam.ProfitAddress)
+
+		for j, accepted := range feeParam.AcceptedDenoms {
+			field := fmt.Sprintf("app_state.tax.params.fee_params[%d].accepted_denoms[%d]", i, j)
+			if err := validateFeeDenom(accepted.Denom); err != nil {
+				errs = append(errs, fmt.Errorf("tax: %s denom %q is invalid: %w", field, accepted.Denom, err))
+			}
+			if strings.TrimSpace(accepted.Ticker) == "" {
+				errs = append(errs, fmt.Errorf("tax: %s has no ticker; set the ticker the oracle quotes %q with", field, accepted.Denom))
+			}
+			if denoms[accepted.Denom] {
+				errs = append(errs, fmt.Errorf("tax: %s denom %q is accepted more than once; remove the duplicate", field, accepted.Denom))
+			}
+			denoms[accepted.Denom] = true
+		}
+	}
+
+	return errs
+}
+
+func validateFeeDenom(denom string) error {
+	if strings.HasPrefix(denom, ibctransfertypes.DenomPrefix+"/") {
+		return ibctransfertypes.ValidateIBCDenom(denom)
+	}
+
+	return sdk.ValidateDenom(denom)
+}
+
+// validateMintGenesis checks that the x/mint minter conforms to the minting schedule and matches the chain denoms and supply.
+func validateMintGenesis(
+	mintGenesis minttypes.GenesisState,
+	taxGenesis taxtypes.GenesisState,
+	stakingGenesis stakingtypes.GenesisState,
+	bankGenesis banktypes.GenesisState,
+	balances map[string]sdk.Coins,
+) []error {
+	var errs []error
+	if err := minttypes.ValidateMinter(mintGenesis.Minter); err != nil {
+		errs = append(errs, fmt.Errorf("mint: app_state.mint.minter does not conform to the minting schedule: %w", err))
+	}
+
+	mintDenom := mintGenesis.Params.MintDenom
+	if bondDenom := stakingGenesis.Params.BondDenom; mintDenom != bondDenom {
+		errs = append(errs, fmt.Errorf("mint: app_state.mint.params.mint_denom %q differs from app_state.staking.params.bond_denom %q", mintDenom, bondDenom))
+	}
+	if baseDenom := taxGenesis.Params.BaseDenom; mintDenom != baseDenom {
+		errs = append(errs, fmt.Errorf("mint: app_state.mint.params.mint_denom %q differs from app_state.tax.params.base_denom %q", mintDenom, baseDenom))
+	}
+
+	// the supply is computed from the balances when it is not set
+	supply := bankGenesis.Supply
+	if supply.Empty() {
+		for _, balance := range balances {
+			supply = supply.Add(balance...)
+		}
+	}
+	if total := supply.AmountOf(mintDenom); mintGenesis.Minter.TotalMinted.BigInt().Cmp(total.BigInt()) > 0 {
+		errs = append(errs, fmt.Errorf("mint: app_state.mint.minter.total_minted %s exceeds the %s supply %s of the bank genesis",
+			mintGenesis.Minter.TotalMinted, mintDenom, total))
+	}
+
+	return errs
+}
+
+// validateVestingAccounts checks that every vesting account holds the coins it vests.
+func validateVestingAccounts(authGenesis authtypes.GenesisState, balances map[string]sdk.Coins) []error {
+	accounts, err := authtypes.UnpackAccounts(authGenesis.Accounts)
+	if err != nil {
+		return []error{fmt.Errorf("auth: failed to unpack app_state.auth.accounts: %w", err)}
+	}
+
+	var errs []error
+	for _, account := range accounts {
+		vesting, ok := account.(vestingexported.VestingAccount)
+		if !ok {
+			continue
+		}
+
+		address := account.GetAddress().String()
+		balance := balances[address]
+		if original := vesting.GetOriginalVesting(); !balance.IsAllGTE(original) {
+			errs = append(errs, fmt.Errorf("auth: vesting account %s vests %s but holds %s; "+
+				"add the coins to its app_state.bank.balances entry or lower its original_vesting", address, original, balance))
+		}
+	}
+
+	return errs
+}
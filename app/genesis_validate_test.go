@@ -0,0 +1,79 @@
+package app_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/Nolus-Protocol/nolus-core/app"
+	"github.com/Nolus-Protocol/nolus-core/app/params"
+	minttypes "github.com/Nolus-Protocol/nolus-core/x/mint/types"
+	taxtypes "github.com/Nolus-Protocol/nolus-core/x/tax/types"
+)
+
+func TestValidateNolusGenesis(t *testing.T) {
+	_ = params.SetAddressPrefixes()
+	encCfg := app.MakeEncodingConfig(app.ModuleBasics)
+	cdc := encCfg.Marshaler
+	genesis := app.NewDefaultGenesisState(encCfg)
+
+	stakingGenesis := stakingtypes.DefaultGenesisState()
+	stakingGenesis.Params.BondDenom = params.DefaultBondDenom
+	genesis[stakingtypes.ModuleName] = cdc.MustMarshalJSON(stakingGenesis)
+
+	mintGenesis := minttypes.DefaultGenesisState()
+	mintGenesis.Params.MintDenom = params.DefaultBondDenom
+	genesis[minttypes.ModuleName] = cdc.MustMarshalJSON(mintGenesis)
+
+	// the default tax contracts are not instantiated and the default osmo denom is malformed
+	errs := app.ValidateNolusGenesis(cdc, genesis)
+	require.Len(t, errs, 4)
+
+	// the treasury, oracle and profit contracts are instantiated by the gen_msgs
+	sender := sdk.AccAddress("sender______________")
+	var genMsgs []wasmtypes.GenesisState_GenMsgs
+	for i := 0; i < 3; i++ {
+		genMsgs = append(genMsgs, wasmtypes.GenesisState_GenMsgs{Sum: &wasmtypes.GenesisState_GenMsgs_InstantiateContract{
+			InstantiateContract: &wasmtypes.MsgInstantiateContract{Sender: sender.String(), CodeID: 1, Label: "contract", Msg: []byte(`{}`)},
+		}})
+	}
+	genesis[wasmtypes.ModuleName] = cdc.MustMarshalJSON(&wasmtypes.GenesisState{Params: wasmtypes.DefaultParams(), GenMsgs: genMsgs})
+
+	taxGenesis := taxtypes.DefaultGenesis()
+	taxGenesis.Params.ContractAddress = wasmkeeper.BuildContractAddressClassic(1, 1).String()
+	taxGenesis.Params.FeeParams = []*taxtypes.FeeParam{{
+		OracleAddress:  wasmkeeper.BuildContractAddressClassic(1, 2).String(),
+		ProfitAddress:  wasmkeeper.BuildContractAddressClassic(1, 3).String(),
+		AcceptedDenoms: []*taxtypes.DenomTicker{{Denom: "ibc/5DE4FCAF68AE40F81F738C857C0D95F7C1BC47B00FA1026E85C1DD92524D4A11", Ticker: "USDC"}},
+	}}
+	genesis[taxtypes.ModuleName] = cdc.MustMarshalJSON(taxGenesis)
+
+	require.Empty(t, app.ValidateNolusGenesis(cdc, genesis))
+
+	// a vesting account without the vested coins and a minter off the schedule
+	vested := sdk.NewCoins(sdk.NewInt64Coin(params.DefaultBondDenom, 1000))
+	account := vestingtypes.NewContinuousVestingAccount(authtypes.NewBaseAccountWithAddress(sender), vested, 0, 100)
+	accounts, err := authtypes.PackAccounts(authtypes.GenesisAccounts{account})
+	require.NoError(t, err)
+	genesis[authtypes.ModuleName] = cdc.MustMarshalJSON(&authtypes.GenesisState{Params: authtypes.DefaultParams(), Accounts: accounts})
+	genesis[banktypes.ModuleName] = cdc.MustMarshalJSON(&banktypes.GenesisState{
+		Params:   banktypes.DefaultParams(),
+		Balances: []banktypes.Balance{{Address: sender.String(), Coins: vested.QuoInt(sdk.NewInt(2))}},
+	})
+
+	mintGenesis.Minter.NormTimePassed = mintGenesis.Minter.NormTimePassed.MulInt64(2)
+	genesis[minttypes.ModuleName] = cdc.MustMarshalJSON(mintGenesis)
+
+	errs = app.ValidateNolusGenesis(cdc, genesis)
+	require.Len(t, errs, 2)
+	require.ErrorContains(t, errs[0], "app_state.mint.minter")
+	require.ErrorContains(t, errs[1], "vesting account "+sender.String())
+}
@@ -57,11 +57,28 @@ import (
 	ibcexported "github.com/cosmos/ibc-go/v7/modules/core/exported"
 	ibckeeper "github.com/cosmos/ibc-go/v7/modules/core/keeper"
 
+	"github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v7/packetforward"
+	packetforwardkeeper "github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v7/packetforward/keeper"
+	packetforwardtypes "github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v7/packetforward/types"
+
 	"github.com/Nolus-Protocol/nolus-core/wasmbinding"
+	"github.com/Nolus-Protocol/nolus-core/x/cron"
+	cronkeeper "github.com/Nolus-Protocol/nolus-core/x/cron/keeper"
+	crontypes "github.com/Nolus-Protocol/nolus-core/x/cron/types"
+	"github.com/Nolus-Protocol/nolus-core/x/ibchooks"
 	mintkeeper "github.com/Nolus-Protocol/nolus-core/x/mint/keeper"
 	minttypes "github.com/Nolus-Protocol/nolus-core/x/mint/types"
+	"github.com/Nolus-Protocol/nolus-core/x/msgfilter"
+	msgfilterkeeper "github.com/Nolus-Protocol/nolus-core/x/msgfilter/keeper"
+	msgfiltertypes "github.com/Nolus-Protocol/nolus-core/x/msgfilter/types"
+	"github.com/Nolus-Protocol/nolus-core/x/ratelimit"
+	ratelimitkeeper "github.com/Nolus-Protocol/nolus-core/x/ratelimit/keeper"
+	ratelimittypes "github.com/Nolus-Protocol/nolus-core/x/ratelimit/types"
 	taxmodulekeeper "github.com/Nolus-Protocol/nolus-core/x/tax/keeper"
 	taxmoduletypes "github.com/Nolus-Protocol/nolus-core/x/tax/types"
+	"github.com/Nolus-Protocol/nolus-core/x/tokenfactory"
+	tokenfactorykeeper "github.com/Nolus-Protocol/nolus-core/x/tokenfactory/keeper"
+	tokenfactorytypes "github.com/Nolus-Protocol/nolus-core/x/tokenfactory/types"
 	"github.com/Nolus-Protocol/nolus-core/x/vestings"
 	vestingskeeper "github.com/Nolus-Protocol/nolus-core/x/vestings/keeper"
 	vestingstypes "github.com/Nolus-Protocol/nolus-core/x/vestings/types"
@@ -77,6 +94,7 @@ import (
 	"github.com/neutron-org/neutron/x/feerefunder"
 	feerefunderkeeper "github.com/neutron-org/neutron/x/feerefunder/keeper"
 	feetypes "github.com/neutron-org/neutron/x/feerefunder/types"
+	neutronibchooks "github.com/neutron-org/neutron/x/ibc-hooks"
 	"github.com/neutron-org/neutron/x/interchainqueries"
 	interchainquerieskeeper "github.com/neutron-org/neutron/x/interchainqueries/keeper"
 	interchainqueriestypes "github.com/neutron-org/neutron/x/interchainqueries/types"
@@ -87,6 +105,10 @@ import (
 	wrapkeeper "github.com/neutron-org/neutron/x/transfer/keeper"
 )
 
+// PacketForwardRetriesOnTimeout is the number of times the packet forward middleware sends a
+// forwarded packet again once it times out, unless the forward memo sets its own retries.
+const PacketForwardRetriesOnTimeout uint8 = 2
+
 type AppKeepers struct {
 	// keys to access the substores
 	keys    map[string]*storetypes.KVStoreKey
@@ -95,7 +117,7 @@ type AppKeepers struct {
 
 	// keepers
 	AccountKeeper         *authkeeper.AccountKeeper
-	BankKeeper            *bankkeeper.BaseKeeper
+	BankKeeper            *tokenfactorykeeper.BankKeeper
 	CapabilityKeeper      *capabilitykeeper.Keeper
 	FeegrantKeeper        *feegrantkeeper.Keeper
 	StakingKeeper         *stakingkeeper.Keeper
@@ -110,6 +132,8 @@ type AppKeepers struct {
 	ICAHostKeeper         *icahostkeeper.Keeper
 	EvidenceKeeper        *evidencekeeper.Keeper
 	TransferKeeper        *wrapkeeper.KeeperTransferWrapper
+	PacketForwardKeeper   *packetforwardkeeper.Keeper
+	HooksICS4Wrapper      neutronibchooks.ICS4Middleware
 	FeeRefunderKeeper     *feerefunderkeeper.Keeper
 	ConsensusParamsKeeper *consensusparamskeeper.Keeper
 	AuthzKeeper           *authzkeeper.Keeper
@@ -122,9 +146,14 @@ type AppKeepers struct {
 	ScopedICAControllerKeeper capabilitykeeper.ScopedKeeper
 	ScopedICAHostKeeper       capabilitykeeper.ScopedKeeper
 
-	MintKeeper     *mintkeeper.Keeper
-	TaxKeeper      *taxmodulekeeper.Keeper
-	VestingsKeeper *vestingskeeper.Keeper
+	MintKeeper      *mintkeeper.Keeper
+	TaxKeeper       *taxmodulekeeper.Keeper
+	VestingsKeeper  *vestingskeeper.Keeper
+	CronKeeper      *cronkeeper.Keeper
+	MsgFilterKeeper *msgfilterkeeper.Keeper
+	RateLimitKeeper *ratelimitkeeper.Keeper
+
+	TokenFactoryKeeper *tokenfactorykeeper.Keeper
 
 	InterchainTxsKeeper     *interchaintxskeeper.Keeper
 	InterchainQueriesKeeper *interchainquerieskeeper.Keeper
@@ -140,6 +169,11 @@ type AppKeepers struct {
 	TransferModule          transferSudo.AppModule
 	FeeRefunderModule       feerefunder.AppModule
 	VestingsModule          vestings.AppModule
+	CronModule              cron.AppModule
+	MsgFilterModule         msgfilter.AppModule
+	RateLimitModule         ratelimit.AppModule
+	TokenFactoryModule      tokenfactory.AppModule
+	PacketForwardModule     packetforward.AppModule
 	IcaModule               ica.AppModule
 	AuthzModule             authzmodule.AppModule
 }
@@ -219,13 +253,14 @@ func (appKeepers *AppKeepers) NewAppKeepers(
 	)
 	appKeepers.FeegrantKeeper = &feegrantKeeper
 
-	bankKeeper := bankkeeper.NewBaseKeeper(
+	// The bank keeper calls the before send hooks of the token factory, which are set below
+	bankKeeper := tokenfactorykeeper.NewBankKeeper(bankkeeper.NewBaseKeeper(
 		appCodec,
 		appKeepers.keys[banktypes.StoreKey],
 		appKeepers.AccountKeeper,
 		blockedAddress,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
-	)
+	))
 	appKeepers.BankKeeper = &bankKeeper
 
 	stakingKeeper := stakingkeeper.NewKeeper(
@@ -237,26 +272,41 @@ func (appKeepers *AppKeepers) NewAppKeepers(
 	)
 	appKeepers.StakingKeeper = stakingKeeper
 
-	mintKeeper := mintkeeper.NewKeeper(
+	distrKeeper := distrkeeper.NewKeeper(
 		appCodec,
-		appKeepers.keys[minttypes.StoreKey],
+		appKeepers.keys[distrtypes.StoreKey],
 		appKeepers.AccountKeeper,
 		appKeepers.BankKeeper,
+		stakingKeeper,
 		authtypes.FeeCollectorName,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
-	appKeepers.MintKeeper = &mintKeeper
+	appKeepers.DistrKeeper = &distrKeeper
 
-	distrKeeper := distrkeeper.NewKeeper(
+	// The tax keeper queries the wasm keeper, which is set below, and is itself used by the mint keeper
+	// and the custom wasm queries
+	taxKeeper := taxmodulekeeper.NewKeeper(
 		appCodec,
-		appKeepers.keys[distrtypes.StoreKey],
+		appKeepers.keys[taxmoduletypes.StoreKey],
+		appKeepers.keys[taxmoduletypes.MemStoreKey],
+		appKeepers.tkeys[taxmoduletypes.TStoreKey],
+		&appKeepers.WasmKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+	appKeepers.TaxKeeper = &taxKeeper
+
+	mintKeeper := mintkeeper.NewKeeper(
+		appCodec,
+		appKeepers.keys[minttypes.StoreKey],
 		appKeepers.AccountKeeper,
 		appKeepers.BankKeeper,
 		stakingKeeper,
+		appKeepers.DistrKeeper,
+		appKeepers.TaxKeeper,
 		authtypes.FeeCollectorName,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
-	appKeepers.DistrKeeper = &distrKeeper
+	appKeepers.MintKeeper = &mintKeeper
 
 	slashingKeeper := slashingkeeper.NewKeeper(
 		appCodec,
@@ -314,11 +364,49 @@ func (appKeepers *AppKeepers) NewAppKeepers(
 	)
 	appKeepers.FeeRefunderModule = feerefunder.NewAppModule(appCodec, *appKeepers.FeeRefunderKeeper, appKeepers.AccountKeeper, appKeepers.BankKeeper)
 
+	// The rate limit keeper is the ICS4Wrapper of the transfer keeper, so it checks the
+	// outflow of the packets sent by the transfer application
+	appKeepers.RateLimitKeeper = ratelimitkeeper.NewKeeper(
+		appCodec,
+		appKeepers.keys[ratelimittypes.StoreKey],
+		appKeepers.BankKeeper,
+		appKeepers.IBCKeeper.ChannelKeeper,
+		appKeepers.IBCKeeper.ChannelKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+	appKeepers.RateLimitModule = ratelimit.NewAppModule(appCodec, *appKeepers.RateLimitKeeper)
+
+	// The packet forward keeper sits between the hooks and the rate limit keeper,
+	// mirroring the order of the transfer stack. The transfer keeper is set once it is created.
+	appKeepers.PacketForwardKeeper = packetforwardkeeper.NewKeeper(
+		appCodec,
+		appKeepers.keys[packetforwardtypes.StoreKey],
+		nil,
+		appKeepers.IBCKeeper.ChannelKeeper,
+		appKeepers.DistrKeeper,
+		appKeepers.BankKeeper,
+		appKeepers.RateLimitKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
+	// The wasm hooks execute the contracts of the received transfers and call back the contracts
+	// on the outcome of the sent ones. The wasm keeper they refer to is created below.
+	wasmHooks := ibchooks.NewWasmHooks(
+		&appKeepers.WasmKeeper,
+		contractmanager.NewSudoLimitWrapper(appKeepers.ContractManagerKeeper, &appKeepers.WasmKeeper),
+		bech32Prefix,
+	)
+	appKeepers.HooksICS4Wrapper = neutronibchooks.NewICS4Middleware(
+		appKeepers.IBCKeeper.ChannelKeeper,
+		appKeepers.PacketForwardKeeper,
+		wasmHooks,
+	)
+
 	transferKeeper := wrapkeeper.NewKeeper(
 		appCodec,
 		appKeepers.keys[ibctransfertypes.StoreKey],
 		appKeepers.GetSubspace(ibctransfertypes.ModuleName),
-		appKeepers.IBCKeeper.ChannelKeeper,
+		appKeepers.HooksICS4Wrapper,
 		appKeepers.IBCKeeper.ChannelKeeper,
 		&appKeepers.IBCKeeper.PortKeeper,
 		appKeepers.AccountKeeper,
@@ -330,6 +418,14 @@ func (appKeepers *AppKeepers) NewAppKeepers(
 	appKeepers.TransferKeeper = &transferKeeper
 	appKeepers.TransferModule = transferSudo.NewAppModule(transferKeeper)
 
+	// The packet forward middleware forwards with the plain ibc-go transfer keeper, so the
+	// forwarded packets are not attributed to a contract and trigger no sudo callbacks.
+	// A contract sending a transfer with a forward memo is called back by the transfer
+	// wrapper once the acknowledgement of the whole route returns, and a timeout of the
+	// forwarded packet reaches it as an error acknowledgement.
+	appKeepers.PacketForwardKeeper.SetTransferKeeper(appKeepers.TransferKeeper.Keeper)
+	appKeepers.PacketForwardModule = packetforward.NewAppModule(appKeepers.PacketForwardKeeper, appKeepers.GetSubspace(packetforwardtypes.ModuleName))
+
 	// Create evidence Keeper for to register the IBC light client misbehaviour evidence route
 	appKeepers.EvidenceKeeper = evidencekeeper.NewKeeper(
 		appCodec,
@@ -392,39 +488,6 @@ func (appKeepers *AppKeepers) NewAppKeepers(
 	)
 	appKeepers.InterchainTxsModule = interchaintxs.NewAppModule(appCodec, *appKeepers.InterchainTxsKeeper, appKeepers.AccountKeeper, appKeepers.BankKeeper)
 
-	wasmDir := filepath.Join(homePath, "wasm")
-	wasmConfig, err := wasm.ReadWasmConfig(appOpts)
-	if err != nil {
-		panic("error while reading wasm config: " + err.Error())
-	}
-	appKeepers.WasmConfig = wasmConfig
-
-	var wasmOpts []wasmkeeper.Option
-	// The last arguments can contain custom message handlers, and custom query handlers,
-	// if we want to allow any custom callbacks
-	supportedFeatures := "iterator,staking,stargate,migrate,upgrade,neutron,cosmwasm_1_1,cosmwasm_1_2"
-	wasmOpts = append(wasmbinding.RegisterCustomPlugins(appKeepers.InterchainTxsKeeper, appKeepers.InterchainQueriesKeeper, *appKeepers.TransferKeeper, appKeepers.FeeRefunderKeeper, appKeepers.ContractManagerKeeper), wasmOpts...)
-	appKeepers.WasmKeeper = wasmkeeper.NewKeeper(
-		appCodec,
-		appKeepers.keys[wasmtypes.StoreKey],
-		appKeepers.AccountKeeper,
-		appKeepers.BankKeeper,
-		appKeepers.StakingKeeper,
-		distrkeeper.NewQuerier(*appKeepers.DistrKeeper),
-		appKeepers.IBCKeeper.ChannelKeeper, // may be replaced with middleware such as ics29 feerefunder
-		appKeepers.IBCKeeper.ChannelKeeper,
-		&appKeepers.IBCKeeper.PortKeeper,
-		appKeepers.ScopedWasmKeeper,
-		appKeepers.TransferKeeper,
-		bApp.MsgServiceRouter(),
-		bApp.GRPCQueryRouter(),
-		wasmDir,
-		wasmConfig,
-		supportedFeatures,
-		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
-		wasmOpts...,
-	)
-
 	// Register the proposal types
 	// Deprecated: Avoid adding new handlers, instead use the new proposal flow
 	// by granting the governance module the right to execute the message.
@@ -455,29 +518,99 @@ func (appKeepers *AppKeepers) NewAppKeepers(
 	// Set legacy router for backwards compatibility with gov v1beta1
 	appKeepers.GovKeeper.SetLegacyRouter(govRouter)
 
-	taxKeeper := taxmodulekeeper.NewKeeper(
+	appKeepers.VestingsKeeper = vestingskeeper.NewKeeper(
 		appCodec,
-		appKeepers.keys[taxmoduletypes.StoreKey],
-		appKeepers.keys[taxmoduletypes.MemStoreKey],
-		appKeepers.WasmKeeper,
+		appKeepers.keys[vestingstypes.StoreKey],
+		appKeepers.keys[vestingstypes.MemStoreKey],
+		appKeepers.AccountKeeper,
+		appKeepers.BankKeeper,
+		appKeepers.StakingKeeper,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
-	appKeepers.TaxKeeper = &taxKeeper
+	appKeepers.VestingsModule = vestings.NewAppModule(appCodec, *appKeepers.VestingsKeeper, appKeepers.AccountKeeper, appKeepers.BankKeeper)
 
-	appKeepers.VestingsKeeper = vestingskeeper.NewKeeper(
+	// The cron keeper executes the contracts through the wasm keeper, which is set below
+	appKeepers.CronKeeper = cronkeeper.NewKeeper(
 		appCodec,
-		appKeepers.keys[vestingstypes.StoreKey],
-		appKeepers.keys[vestingstypes.MemStoreKey],
+		appKeepers.keys[crontypes.StoreKey],
+		appKeepers.keys[crontypes.MemStoreKey],
+		wasmkeeper.NewDefaultPermissionKeeper(&appKeepers.WasmKeeper),
+		appKeepers.ContractManagerKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+	appKeepers.CronModule = cron.NewAppModule(appCodec, *appKeepers.CronKeeper)
+
+	// The msg filter keeper is used by the ante handler and wraps the message router of the wasm keeper
+	appKeepers.MsgFilterKeeper = msgfilterkeeper.NewKeeper(
+		appCodec,
+		appKeepers.keys[msgfiltertypes.StoreKey],
+		appKeepers.keys[msgfiltertypes.MemStoreKey],
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+	appKeepers.MsgFilterModule = msgfilter.NewAppModule(appCodec, *appKeepers.MsgFilterKeeper)
+
+	wasmDir := filepath.Join(homePath, "wasm")
+	wasmConfig, err := wasm.ReadWasmConfig(appOpts)
+	if err != nil {
+		panic("error while reading wasm config: " + err.Error())
+	}
+	appKeepers.WasmConfig = wasmConfig
+
+	// The token factory pays the denom creation fees to the treasury of the tax module and
+	// calls the before send hooks through the wasm keeper, which is set below
+	appKeepers.TokenFactoryKeeper = tokenfactorykeeper.NewKeeper(
+		appCodec,
+		appKeepers.keys[tokenfactorytypes.StoreKey],
+		appKeepers.BankKeeper,
+		appKeepers.TaxKeeper,
+		&appKeepers.WasmKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+	appKeepers.BankKeeper.SetHooks(appKeepers.TokenFactoryKeeper.Hooks())
+	appKeepers.TokenFactoryModule = tokenfactory.NewAppModule(appCodec, *appKeepers.TokenFactoryKeeper)
+
+	var wasmOpts []wasmkeeper.Option
+	// The last arguments can contain custom message handlers, and custom query handlers,
+	// if we want to allow any custom callbacks
+	supportedFeatures := "iterator,staking,stargate,migrate,upgrade,neutron,cosmwasm_1_1,cosmwasm_1_2"
+	wasmOpts = append(wasmbinding.RegisterCustomPlugins(appKeepers.InterchainTxsKeeper, appKeepers.InterchainQueriesKeeper, *appKeepers.TransferKeeper, appKeepers.FeeRefunderKeeper, appKeepers.ContractManagerKeeper, appKeepers.MintKeeper, appKeepers.TaxKeeper, appKeepers.GovKeeper, appKeepers.VestingsKeeper, appKeepers.CronKeeper, appKeepers.MsgFilterKeeper, appKeepers.TokenFactoryKeeper, bApp.GRPCQueryRouter(), appCodec), wasmOpts...)
+	appKeepers.WasmKeeper = wasmkeeper.NewKeeper(
+		appCodec,
+		appKeepers.keys[wasmtypes.StoreKey],
 		appKeepers.AccountKeeper,
 		appKeepers.BankKeeper,
+		appKeepers.StakingKeeper,
+		distrkeeper.NewQuerier(*appKeepers.DistrKeeper),
+		appKeepers.IBCKeeper.ChannelKeeper, // may be replaced with middleware such as ics29 feerefunder
+		appKeepers.IBCKeeper.ChannelKeeper,
+		&appKeepers.IBCKeeper.PortKeeper,
+		appKeepers.ScopedWasmKeeper,
+		appKeepers.TransferKeeper,
+		msgfilterkeeper.NewFilteredMessageRouter(bApp.MsgServiceRouter(), *appKeepers.MsgFilterKeeper),
+		bApp.GRPCQueryRouter(),
+		wasmDir,
+		wasmConfig,
+		supportedFeatures,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		wasmOpts...,
 	)
-	appKeepers.VestingsModule = vestings.NewAppModule(appCodec, *appKeepers.VestingsKeeper)
 
-	transferIBCModule := transferSudo.NewIBCModule(
+	var transferStack ibcporttypes.IBCModule
+
+	transferStack = transferSudo.NewIBCModule(
 		*appKeepers.TransferKeeper,
 		contractmanager.NewSudoLimitWrapper(appKeepers.ContractManagerKeeper, &appKeepers.WasmKeeper),
 	)
+	transferStack = neutronibchooks.NewIBCMiddleware(transferStack, &appKeepers.HooksICS4Wrapper)
+	// The forward memo of a packet may override both the retries and the timeout of its forward.
+	transferStack = packetforward.NewIBCMiddleware(
+		transferStack,
+		appKeepers.PacketForwardKeeper,
+		PacketForwardRetriesOnTimeout,
+		packetforwardkeeper.DefaultForwardTransferPacketTimeoutTimestamp,
+		packetforwardkeeper.DefaultRefundTransferPacketTimeoutTimestamp,
+	)
+	transferStack = ratelimit.NewIBCMiddleware(transferStack, *appKeepers.RateLimitKeeper)
 
 	var icaControllerStack ibcporttypes.IBCModule
 
@@ -490,7 +623,7 @@ func (appKeepers *AppKeepers) NewAppKeepers(
 	ibcRouter := ibcporttypes.NewRouter()
 	ibcRouter.AddRoute(icacontrollertypes.SubModuleName, icaControllerStack).
 		AddRoute(icahosttypes.SubModuleName, icaHostIBCModule).
-		AddRoute(ibctransfertypes.ModuleName, transferIBCModule).
+		AddRoute(ibctransfertypes.ModuleName, transferStack).
 		AddRoute(interchaintxstypes.ModuleName, icaControllerStack).
 		AddRoute(wasmtypes.ModuleName, wasm.NewIBCHandler(appKeepers.WasmKeeper, appKeepers.IBCKeeper.ChannelKeeper, appKeepers.IBCKeeper.ChannelKeeper))
 	appKeepers.IBCKeeper.SetRouter(ibcRouter)
@@ -536,6 +669,7 @@ func initParamsKeeper(
 	paramsKeeper.Subspace(interchaintxstypes.ModuleName).WithKeyTable(interchaintxstypes.ParamKeyTable())
 	paramsKeeper.Subspace(interchainqueriestypes.ModuleName).WithKeyTable(interchainqueriestypes.ParamKeyTable())
 	paramsKeeper.Subspace(vestingstypes.ModuleName)
+	paramsKeeper.Subspace(packetforwardtypes.ModuleName).WithKeyTable(packetforwardtypes.ParamKeyTable())
 
 	return &paramsKeeper
 }
@@ -23,8 +23,14 @@ import (
 	ibctransfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
 	ibcexported "github.com/cosmos/ibc-go/v7/modules/core/exported"
 
+	packetforwardtypes "github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v7/packetforward/types"
+
+	crontypes "github.com/Nolus-Protocol/nolus-core/x/cron/types"
 	minttypes "github.com/Nolus-Protocol/nolus-core/x/mint/types"
+	msgfiltertypes "github.com/Nolus-Protocol/nolus-core/x/msgfilter/types"
+	ratelimittypes "github.com/Nolus-Protocol/nolus-core/x/ratelimit/types"
 	taxmoduletypes "github.com/Nolus-Protocol/nolus-core/x/tax/types"
+	tokenfactorytypes "github.com/Nolus-Protocol/nolus-core/x/tokenfactory/types"
 	vestingstypes "github.com/Nolus-Protocol/nolus-core/x/vestings/types"
 
 	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
@@ -56,6 +62,11 @@ func (appKeepers *AppKeepers) GenerateKeys() {
 		ibctransfertypes.StoreKey,
 		taxmoduletypes.StoreKey,
 		vestingstypes.StoreKey,
+		crontypes.StoreKey,
+		msgfiltertypes.StoreKey,
+		ratelimittypes.StoreKey,
+		tokenfactorytypes.StoreKey,
+		packetforwardtypes.StoreKey,
 		icacontrollertypes.StoreKey,
 		icahosttypes.StoreKey,
 		capabilitytypes.StoreKey,
@@ -68,7 +79,7 @@ func (appKeepers *AppKeepers) GenerateKeys() {
 	)
 
 	// Define transient store keys
-	appKeepers.tkeys = sdk.NewTransientStoreKeys(paramstypes.TStoreKey)
+	appKeepers.tkeys = sdk.NewTransientStoreKeys(paramstypes.TStoreKey, taxmoduletypes.TStoreKey)
 
 	// MemKeys are for information that is stored only in RAM.
 	appKeepers.memKeys = sdk.NewMemoryStoreKeys(capabilitytypes.MemStoreKey, feerefundertypes.MemStoreKey)
@@ -47,10 +47,21 @@ import (
 	ibcexported "github.com/cosmos/ibc-go/v7/modules/core/exported"
 	ibctm "github.com/cosmos/ibc-go/v7/modules/light-clients/07-tendermint"
 
+	"github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v7/packetforward"
+	packetforwardtypes "github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v7/packetforward/types"
+
+	"github.com/Nolus-Protocol/nolus-core/x/cron"
+	crontypes "github.com/Nolus-Protocol/nolus-core/x/cron/types"
 	"github.com/Nolus-Protocol/nolus-core/x/mint"
 	minttypes "github.com/Nolus-Protocol/nolus-core/x/mint/types"
+	"github.com/Nolus-Protocol/nolus-core/x/msgfilter"
+	msgfiltertypes "github.com/Nolus-Protocol/nolus-core/x/msgfilter/types"
+	"github.com/Nolus-Protocol/nolus-core/x/ratelimit"
+	ratelimittypes "github.com/Nolus-Protocol/nolus-core/x/ratelimit/types"
 	"github.com/Nolus-Protocol/nolus-core/x/tax"
 	taxmoduletypes "github.com/Nolus-Protocol/nolus-core/x/tax/types"
+	"github.com/Nolus-Protocol/nolus-core/x/tokenfactory"
+	tokenfactorytypes "github.com/Nolus-Protocol/nolus-core/x/tokenfactory/types"
 	"github.com/Nolus-Protocol/nolus-core/x/vestings"
 	vestingstypes "github.com/Nolus-Protocol/nolus-core/x/vestings/types"
 
@@ -61,6 +72,8 @@ import (
 	contractmanagermoduletypes "github.com/neutron-org/neutron/x/contractmanager/types"
 	"github.com/neutron-org/neutron/x/feerefunder"
 	feetypes "github.com/neutron-org/neutron/x/feerefunder/types"
+	ibchooks "github.com/neutron-org/neutron/x/ibc-hooks"
+	ibchookstypes "github.com/neutron-org/neutron/x/ibc-hooks/types"
 	"github.com/neutron-org/neutron/x/interchainqueries"
 	interchainqueriestypes "github.com/neutron-org/neutron/x/interchainqueries/types"
 	"github.com/neutron-org/neutron/x/interchaintxs"
@@ -79,9 +92,12 @@ var maccPerms = map[string][]string{
 	ibctransfertypes.ModuleName:       {authtypes.Minter, authtypes.Burner},
 	wasmtypes.ModuleName:              {authtypes.Burner},
 	vestingstypes.ModuleName:          nil,
+	crontypes.ModuleName:              nil,
 	icatypes.ModuleName:               nil,
 	interchainqueriestypes.ModuleName: nil,
 	feetypes.ModuleName:               nil,
+	tokenfactorytypes.ModuleName:      {authtypes.Minter, authtypes.Burner},
+	taxmoduletypes.ModuleName:         {authtypes.Burner},
 }
 
 // ModuleBasics defines the module BasicManager is in charge of setting up basic,
@@ -115,6 +131,12 @@ var ModuleBasics = module.NewBasicManager(
 	vesting.AppModuleBasic{},
 	wasm.AppModuleBasic{},
 	vestings.AppModuleBasic{},
+	cron.AppModuleBasic{},
+	msgfilter.AppModuleBasic{},
+	ratelimit.AppModuleBasic{},
+	packetforward.AppModuleBasic{},
+	ibchooks.AppModuleBasic{},
+	tokenfactory.AppModuleBasic{},
 	tax.AppModuleBasic{},
 	ica.AppModuleBasic{},
 	interchaintxs.AppModuleBasic{},
@@ -143,7 +165,7 @@ func appModules(
 		authzmodule.NewAppModule(appCodec, *app.AuthzKeeper, app.AccountKeeper, app.BankKeeper, encodingConfig.InterfaceRegistry),
 		auth.NewAppModule(appCodec, *app.AccountKeeper, authsims.RandomGenesisAccounts, app.GetSubspace(authtypes.ModuleName)),
 		vesting.NewAppModule(*app.AccountKeeper, app.BankKeeper),
-		bank.NewAppModule(appCodec, *app.BankKeeper, *app.AccountKeeper, app.GetSubspace(banktypes.ModuleName)),
+		tokenfactory.NewBankAppModule(appCodec, *app.BankKeeper, *app.AccountKeeper, app.GetSubspace(banktypes.ModuleName)),
 		capability.NewAppModule(appCodec, *app.CapabilityKeeper, false),
 		crisis.NewAppModule(app.CrisisKeeper, skipGenesisInvariants, app.GetSubspace(crisistypes.ModuleName)),
 		feegrantmodule.NewAppModule(appCodec, app.AccountKeeper, app.BankKeeper, *app.FeegrantKeeper, app.interfaceRegistry),
@@ -160,6 +182,12 @@ func appModules(
 		tax.NewAppModule(appCodec, *app.TaxKeeper, app.AccountKeeper, app.BankKeeper, app.GetSubspace(taxmoduletypes.ModuleName)),
 		app.AppKeepers.TransferModule,
 		app.AppKeepers.VestingsModule,
+		app.AppKeepers.CronModule,
+		app.AppKeepers.MsgFilterModule,
+		app.AppKeepers.RateLimitModule,
+		app.AppKeepers.PacketForwardModule,
+		ibchooks.NewAppModule(app.AccountKeeper),
+		app.AppKeepers.TokenFactoryModule,
 		app.AppKeepers.IcaModule,
 		app.AppKeepers.InterchainQueriesModule,
 		app.AppKeepers.InterchainTxsModule,
@@ -180,12 +208,13 @@ func simulationModules(
 	return []module.AppModuleSimulation{
 		authzmodule.NewAppModule(appCodec, *app.AuthzKeeper, app.AccountKeeper, app.BankKeeper, encodingConfig.InterfaceRegistry),
 		auth.NewAppModule(appCodec, *app.AccountKeeper, authsims.RandomGenesisAccounts, app.GetSubspace(authtypes.ModuleName)),
-		bank.NewAppModule(appCodec, *app.BankKeeper, *app.AccountKeeper, app.GetSubspace(banktypes.ModuleName)),
+		bank.NewAppModule(appCodec, app.BankKeeper.BaseKeeper, *app.AccountKeeper, app.GetSubspace(banktypes.ModuleName)),
 		capability.NewAppModule(appCodec, *app.CapabilityKeeper, false),
 		feegrantmodule.NewAppModule(appCodec, app.AccountKeeper, app.BankKeeper, *app.FeegrantKeeper, app.interfaceRegistry),
 		gov.NewAppModule(appCodec, app.GovKeeper, app.AccountKeeper, app.BankKeeper, app.GetSubspace(govtypes.ModuleName)),
 		mint.NewAppModule(appCodec, *app.MintKeeper, app.AccountKeeper, app.GetSubspace(minttypes.ModuleName)),
 		tax.NewAppModule(appCodec, *app.TaxKeeper, app.AccountKeeper, app.BankKeeper, app.GetSubspace(taxmoduletypes.ModuleName)),
+		app.AppKeepers.VestingsModule,
 		staking.NewAppModule(appCodec, app.StakingKeeper, app.AccountKeeper, app.BankKeeper, app.GetSubspace(stakingtypes.ModuleName)),
 		distribution.NewAppModule(appCodec, *app.DistrKeeper, app.AccountKeeper, app.BankKeeper, app.StakingKeeper, app.GetSubspace(distrtypes.ModuleName)),
 		slashing.NewAppModule(appCodec, *app.SlashingKeeper, app.AccountKeeper, app.BankKeeper, app.StakingKeeper, app.GetSubspace(slashingtypes.ModuleName)),
@@ -239,6 +268,12 @@ func orderBeginBlockers() []string {
 		interchainqueriestypes.ModuleName,
 		contractmanagermoduletypes.ModuleName,
 		wasmtypes.ModuleName,
+		crontypes.ModuleName,
+		msgfiltertypes.ModuleName,
+		ratelimittypes.ModuleName,
+		packetforwardtypes.ModuleName,
+		ibchookstypes.ModuleName,
+		tokenfactorytypes.ModuleName,
 		feetypes.ModuleName,
 	}
 }
@@ -270,6 +305,12 @@ func orderEndBlockers() []string {
 		interchainqueriestypes.ModuleName,
 		contractmanagermoduletypes.ModuleName,
 		wasmtypes.ModuleName,
+		crontypes.ModuleName,
+		msgfiltertypes.ModuleName,
+		ratelimittypes.ModuleName,
+		packetforwardtypes.ModuleName,
+		ibchookstypes.ModuleName,
+		tokenfactorytypes.ModuleName,
 		feetypes.ModuleName,
 	}
 }
@@ -310,6 +351,12 @@ func orderInitBlockers() []string {
 		contractmanagermoduletypes.ModuleName,
 		// wasm after ibc transfer
 		wasmtypes.ModuleName,
+		crontypes.ModuleName,
+		msgfiltertypes.ModuleName,
+		ratelimittypes.ModuleName,
+		packetforwardtypes.ModuleName,
+		ibchookstypes.ModuleName,
+		tokenfactorytypes.ModuleName,
 		feetypes.ModuleName,
 		consensusparamtypes.ModuleName,
 	}
@@ -0,0 +1,441 @@
+package app_test
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/suite"
+
+	packetforwardtypes "github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v7/packetforward/types"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+	ibctesting "github.com/cosmos/ibc-go/v7/testing"
+
+	contractmanagerkeeper "github.com/neutron-org/neutron/x/contractmanager/keeper"
+	feerefundertypes "github.com/neutron-org/neutron/x/feerefunder/types"
+	neutrontransfertypes "github.com/neutron-org/neutron/x/transfer/types"
+
+	nolusapp "github.com/Nolus-Protocol/nolus-core/app"
+	"github.com/Nolus-Protocol/nolus-core/app/keepers"
+	"github.com/Nolus-Protocol/nolus-core/app/params"
+	"github.com/Nolus-Protocol/nolus-core/testutil/simapp"
+	ratelimittypes "github.com/Nolus-Protocol/nolus-core/x/ratelimit/types"
+)
+
+// fee is the smallest fee a contract locks to send a transfer. The bond denom is read on use
+// as the simulations override it.
+func fee() sdk.Coins {
+	return sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000))
+}
+
+// PacketForwardTestSuite forwards transfers from chain A through chain B to chain C.
+type PacketForwardTestSuite struct {
+	suite.Suite
+
+	coordinator *ibctesting.Coordinator
+	chainA      *ibctesting.TestChain
+	chainB      *ibctesting.TestChain
+	chainC      *ibctesting.TestChain
+	pathAB      *ibctesting.Path
+	pathBC      *ibctesting.Path
+}
+
+func TestPacketForwardTestSuite(t *testing.T) {
+	suite.Run(t, new(PacketForwardTestSuite))
+}
+
+func (s *PacketForwardTestSuite) SetupTest() {
+	_ = params.SetAddressPrefixes()
+	ibctesting.DefaultTestingAppInit = simapp.SetupTestingApp
+
+	s.coordinator = ibctesting.NewCoordinator(s.T(), 3)
+	s.chainA = s.coordinator.GetChain(ibctesting.GetChainID(1))
+	s.chainB = s.coordinator.GetChain(ibctesting.GetChainID(2))
+	s.chainC = s.coordinator.GetChain(ibctesting.GetChainID(3))
+
+	s.pathAB = newTransferPath(s.chainA, s.chainB)
+	s.coordinator.Setup(s.pathAB)
+	s.pathBC = newTransferPath(s.chainB, s.chainC)
+	s.coordinator.Setup(s.pathBC)
+}
+
+func newTransferPath(chainA, chainB *ibctesting.TestChain) *ibctesting.Path {
+	path := ibctesting.NewPath(chainA, chainB)
+	path.EndpointA.ChannelConfig.PortID = ibctesting.TransferPort
+	path.EndpointB.ChannelConfig.PortID = ibctesting.TransferPort
+	path.EndpointA.ChannelConfig.Version = transfertypes.Version
+	path.EndpointB.ChannelConfig.Version = transfertypes.Version
+
+	return path
+}
+
+func nolus(chain *ibctesting.TestChain) *nolusapp.App {
+	return chain.App.(*nolusapp.App)
+}
+
+func (s *PacketForwardTestSuite) balance(chain *ibctesting.TestChain, denom string) sdkmath.Int {
+	return nolus(chain).BankKeeper.GetBalance(chain.GetContext(), chain.SenderAccount.GetAddress(), denom).Amount
+}
+
+// voucher returns the denom of the base denom of chain A once received over the given endpoints.
+func voucher(endpoints ...*ibctesting.Endpoint) string {
+	denom := sdk.DefaultBondDenom
+	for _, endpoint := range endpoints {
+		denom = transfertypes.GetPrefixedDenom(endpoint.ChannelConfig.PortID, endpoint.ChannelID, denom)
+	}
+
+	return transfertypes.ParseDenomTrace(denom).IBCDenom()
+}
+
+// forwardMemo asks chain B to forward the received tokens to the receiver on chain C.
+func (s *PacketForwardTestSuite) forwardMemo(receiver string, timeout time.Duration, retries uint8) string {
+	return s.forwardMemoRetries(receiver, timeout, &retries)
+}
+
+// forwardMemoRetries asks chain B to forward the received tokens to the receiver on chain C,
+// retrying the default number of times if retries is nil.
+func (s *PacketForwardTestSuite) forwardMemoRetries(receiver string, timeout time.Duration, retries *uint8) string {
+	memo, err := json.Marshal(packetforwardtypes.PacketMetadata{
+		Forward: &packetforwardtypes.ForwardMetadata{
+			Receiver: receiver,
+			Port:     s.pathBC.EndpointA.ChannelConfig.PortID,
+			Channel:  s.pathBC.EndpointA.ChannelID,
+			Timeout:  packetforwardtypes.Duration(timeout),
+			Retries:  retries,
+		},
+	})
+	s.Require().NoError(err)
+
+	return string(memo)
+}
+
+// transfer sends the coin from chain A to chain B and returns the packet.
+func (s *PacketForwardTestSuite) transfer(coin sdk.Coin, memo string) channeltypes.Packet {
+	endpoint := s.pathAB.EndpointA
+	msg := transfertypes.NewMsgTransfer(endpoint.ChannelConfig.PortID, endpoint.ChannelID, coin,
+		s.chainA.SenderAccount.GetAddress().String(), s.chainB.SenderAccount.GetAddress().String(),
+		clienttypes.NewHeight(1, 110), 0, memo)
+	res, err := s.chainA.SendMsgs(msg)
+	s.Require().NoError(err)
+
+	packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	s.Require().NoError(err)
+
+	return packet
+}
+
+// recv receives the packet on the endpoint and returns the events of the transaction.
+func (s *PacketForwardTestSuite) recv(endpoint *ibctesting.Endpoint, packet channeltypes.Packet) sdk.Events {
+	s.Require().NoError(endpoint.UpdateClient())
+	res, err := endpoint.RecvPacketWithResult(packet)
+	s.Require().NoError(err)
+
+	return res.GetEvents()
+}
+
+// acknowledge acknowledges the packet on the endpoint and returns the events of the transaction.
+func (s *PacketForwardTestSuite) acknowledge(endpoint *ibctesting.Endpoint, packet channeltypes.Packet, ack []byte) sdk.Events {
+	s.Require().NoError(endpoint.UpdateClient())
+	key := host.PacketAcknowledgementKey(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+	proof, proofHeight := endpoint.Counterparty.QueryProof(key)
+
+	res, err := endpoint.Chain.SendMsgs(channeltypes.NewMsgAcknowledgement(packet, ack, proof, proofHeight, endpoint.Chain.SenderAccount.GetAddress().String()))
+	s.Require().NoError(err)
+	s.Require().NoError(endpoint.Counterparty.UpdateClient())
+
+	return res.GetEvents()
+}
+
+// timeout times the packet out on the endpoint and returns the events of the transaction.
+func (s *PacketForwardTestSuite) timeout(endpoint *ibctesting.Endpoint, packet channeltypes.Packet) sdk.Events {
+	s.Require().NoError(endpoint.UpdateClient())
+	counterparty := endpoint.Counterparty
+	key := host.PacketReceiptKey(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+	proof, proofHeight := counterparty.QueryProof(key)
+	nextSeqRecv, found := counterparty.Chain.App.GetIBCKeeper().ChannelKeeper.GetNextSequenceRecv(counterparty.Chain.GetContext(), counterparty.ChannelConfig.PortID, counterparty.ChannelID)
+	s.Require().True(found)
+
+	res, err := endpoint.Chain.SendMsgs(channeltypes.NewMsgTimeout(packet, nextSeqRecv, proof, proofHeight, endpoint.Chain.SenderAccount.GetAddress().String()))
+	s.Require().NoError(err)
+	s.Require().NoError(counterparty.UpdateClient())
+
+	return res.GetEvents()
+}
+
+func (s *PacketForwardTestSuite) parsePacket(events sdk.Events) channeltypes.Packet {
+	packet, err := ibctesting.ParsePacketFromEvents(events)
+	s.Require().NoError(err)
+
+	return packet
+}
+
+func (s *PacketForwardTestSuite) parseAck(events sdk.Events) []byte {
+	ack, err := ibctesting.ParseAckFromEvents(events)
+	s.Require().NoError(err)
+
+	return ack
+}
+
+func (s *PacketForwardTestSuite) requireAck(ack []byte, success bool) {
+	var acknowledgement channeltypes.Acknowledgement
+	s.Require().NoError(channeltypes.SubModuleCdc.UnmarshalJSON(ack, &acknowledgement))
+	s.Require().Equal(success, acknowledgement.Success())
+}
+
+// forward relays the packet of chain A to chain B and returns the packet forwarded to chain C.
+func (s *PacketForwardTestSuite) forward(packet channeltypes.Packet) channeltypes.Packet {
+	events := s.recv(s.pathAB.EndpointB, packet)
+
+	// chain B acknowledges the packet once the forwarded packet is acknowledged
+	_, err := ibctesting.ParseAckFromEvents(events)
+	s.Require().Error(err)
+
+	return s.parsePacket(events)
+}
+
+// complete relays the forwarded packet to chain C and relays the resulting acknowledgement
+// of chain B back to chain A.
+func (s *PacketForwardTestSuite) complete(packet, forwarded channeltypes.Packet) []byte {
+	ack := s.parseAck(s.recv(s.pathBC.EndpointB, forwarded))
+	ack = s.parseAck(s.acknowledge(s.pathBC.EndpointA, forwarded, ack))
+	s.acknowledge(s.pathAB.EndpointA, packet, ack)
+
+	return ack
+}
+
+// instantiateReflect instantiates the reflect contract on chain A owned by its sender and funds
+// it with the coin and the fees of a transfer.
+func (s *PacketForwardTestSuite) instantiateReflect(coin sdk.Coin) sdk.AccAddress {
+	wasmCode, err := os.ReadFile("../x/ibchooks/testdata/reflect.wasm")
+	s.Require().NoError(err)
+
+	app := nolus(s.chainA)
+	ctx := s.chainA.GetContext()
+	owner := s.chainA.SenderAccount.GetAddress()
+	minFee := feerefundertypes.Fee{AckFee: fee(), TimeoutFee: fee()}
+	s.Require().NoError(app.FeeRefunderKeeper.SetParams(ctx, feerefundertypes.NewParams(minFee)))
+
+	contractKeeper := wasmkeeper.NewDefaultPermissionKeeper(app.WasmKeeper)
+	codeID, _, err := contractKeeper.Create(ctx, owner, wasmCode, nil)
+	s.Require().NoError(err)
+
+	contract, _, err := contractKeeper.Instantiate(ctx, codeID, owner, owner, []byte("{}"), "reflect", nil)
+	s.Require().NoError(err)
+
+	s.Require().NoError(app.BankKeeper.SendCoins(ctx, owner, contract, fee().Add(fee()...).Add(coin)))
+	s.coordinator.CommitBlock(s.chainA)
+
+	return contract
+}
+
+// contractTransfer makes the contract send the coin from chain A to chain B through the transfer
+// wrapper, which calls the contract back with the outcome, and returns the packet.
+func (s *PacketForwardTestSuite) contractTransfer(contract sdk.AccAddress, coin sdk.Coin, memo string) channeltypes.Packet {
+	endpoint := s.pathAB.EndpointA
+	msg := &neutrontransfertypes.MsgTransfer{
+		SourcePort:    endpoint.ChannelConfig.PortID,
+		SourceChannel: endpoint.ChannelID,
+		Token:         coin,
+		Sender:        contract.String(),
+		Receiver:      s.chainB.SenderAccount.GetAddress().String(),
+		TimeoutHeight: clienttypes.NewHeight(1, 110),
+		Memo:          memo,
+		Fee:           feerefundertypes.Fee{AckFee: fee(), TimeoutFee: fee()},
+	}
+	bz, err := nolus(s.chainA).AppCodec().Marshal(msg)
+	s.Require().NoError(err)
+
+	res, err := s.chainA.SendMsgs(&wasmtypes.MsgExecuteContract{
+		Sender:   s.chainA.SenderAccount.GetAddress().String(),
+		Contract: contract.String(),
+		Msg:      []byte(fmt.Sprintf(`{"reflect_msg":{"msgs":[{"stargate":{"type_url":"/neutron.transfer.MsgTransfer","value":"%s"}}]}}`, base64.StdEncoding.EncodeToString(bz))),
+	})
+	s.Require().NoError(err)
+
+	return s.parsePacket(res.GetEvents())
+}
+
+// callbacks returns the sudo payloads of the callbacks of the contracts on the chain, which the
+// reflect contract fails as it has no sudo entry point.
+func (s *PacketForwardTestSuite) callbacks(chain *ibctesting.TestChain) map[string][][]byte {
+	callbacks := make(map[string][][]byte)
+	for _, failure := range nolus(chain).ContractManagerKeeper.GetAllFailures(chain.GetContext()) {
+		callbacks[failure.Address] = append(callbacks[failure.Address], failure.SudoPayload)
+	}
+
+	return callbacks
+}
+
+// requireCallback checks that the contract is called back only on chain A, with the
+// acknowledgement chain B writes once the forward completes.
+func (s *PacketForwardTestSuite) requireCallback(contract sdk.AccAddress, packet channeltypes.Packet, ack []byte) {
+	var acknowledgement channeltypes.Acknowledgement
+	s.Require().NoError(channeltypes.SubModuleCdc.UnmarshalJSON(ack, &acknowledgement))
+	payload, err := contractmanagerkeeper.PrepareSudoCallbackMessage(packet, &acknowledgement)
+	s.Require().NoError(err)
+
+	s.Require().Equal(map[string][][]byte{contract.String(): {payload}}, s.callbacks(s.chainA))
+	s.Require().Empty(s.callbacks(s.chainB))
+}
+
+func (s *PacketForwardTestSuite) TestForward() {
+	amount := sdkmath.NewInt(100)
+	balance := s.balance(s.chainA, sdk.DefaultBondDenom)
+
+	memo := s.forwardMemo(s.chainC.SenderAccount.GetAddress().String(), time.Hour, 0)
+	packet := s.transfer(sdk.NewCoin(sdk.DefaultBondDenom, amount), memo)
+	forwarded := s.forward(packet)
+	s.Require().Equal(s.pathBC.EndpointA.ChannelID, forwarded.GetSourceChannel())
+
+	s.requireAck(s.complete(packet, forwarded), true)
+	s.Require().Equal(balance.Sub(amount), s.balance(s.chainA, sdk.DefaultBondDenom))
+	s.Require().Equal(amount, s.balance(s.chainC, voucher(s.pathAB.EndpointB, s.pathBC.EndpointB)))
+
+	// the vouchers of chain B are escrowed on the channel to chain C
+	s.Require().True(s.balance(s.chainB, voucher(s.pathAB.EndpointB)).IsZero())
+	escrow := transfertypes.GetEscrowAddress(s.pathBC.EndpointA.ChannelConfig.PortID, s.pathBC.EndpointA.ChannelID)
+	s.Require().Equal(amount, nolus(s.chainB).BankKeeper.GetBalance(s.chainB.GetContext(), escrow, voucher(s.pathAB.EndpointB)).Amount)
+}
+
+func (s *PacketForwardTestSuite) TestForwardRefund() {
+	amount := sdkmath.NewInt(100)
+	balance := s.balance(s.chainA, sdk.DefaultBondDenom)
+
+	// chain C fails to receive the tokens of an invalid receiver
+	packet := s.transfer(sdk.NewCoin(sdk.DefaultBondDenom, amount), s.forwardMemo("invalid", time.Hour, 0))
+	forwarded := s.forward(packet)
+
+	s.requireAck(s.complete(packet, forwarded), false)
+	s.Require().Equal(balance, s.balance(s.chainA, sdk.DefaultBondDenom))
+	s.Require().True(nolus(s.chainB).BankKeeper.GetSupply(s.chainB.GetContext(), voucher(s.pathAB.EndpointB)).Amount.IsZero())
+}
+
+func (s *PacketForwardTestSuite) TestForwardRetryOnTimeout() {
+	amount := sdkmath.NewInt(100)
+
+	memo := s.forwardMemo(s.chainC.SenderAccount.GetAddress().String(), time.Minute, 1)
+	packet := s.transfer(sdk.NewCoin(sdk.DefaultBondDenom, amount), memo)
+	forwarded := s.forward(packet)
+
+	// chain B sends the packet again once the forwarded packet times out
+	s.coordinator.IncrementTimeBy(2 * time.Minute)
+	s.coordinator.CommitBlock(s.chainC)
+	retried := s.parsePacket(s.timeout(s.pathBC.EndpointA, forwarded))
+	s.Require().Equal(forwarded.GetSequence()+1, retried.GetSequence())
+	s.Require().Equal(forwarded.GetData(), retried.GetData())
+
+	s.requireAck(s.complete(packet, retried), true)
+	s.Require().Equal(amount, s.balance(s.chainC, voucher(s.pathAB.EndpointB, s.pathBC.EndpointB)))
+}
+
+func (s *PacketForwardTestSuite) TestForwardDefaultRetriesOnTimeout() {
+	amount := sdkmath.NewInt(100)
+	balance := s.balance(s.chainA, sdk.DefaultBondDenom)
+
+	memo := s.forwardMemoRetries(s.chainC.SenderAccount.GetAddress().String(), time.Minute, nil)
+	packet := s.transfer(sdk.NewCoin(sdk.DefaultBondDenom, amount), memo)
+	forwarded := s.forward(packet)
+
+	// chain B sends the packet again on every timeout until it runs out of retries
+	s.Require().NotZero(keepers.PacketForwardRetriesOnTimeout)
+	for i := uint8(0); i < keepers.PacketForwardRetriesOnTimeout; i++ {
+		s.coordinator.IncrementTimeBy(2 * time.Minute)
+		s.coordinator.CommitBlock(s.chainC)
+		retried := s.parsePacket(s.timeout(s.pathBC.EndpointA, forwarded))
+		s.Require().Equal(forwarded.GetSequence()+1, retried.GetSequence())
+		forwarded = retried
+	}
+
+	s.coordinator.IncrementTimeBy(2 * time.Minute)
+	s.coordinator.CommitBlock(s.chainC)
+	ack := s.parseAck(s.timeout(s.pathBC.EndpointA, forwarded))
+	s.requireAck(ack, false)
+
+	s.acknowledge(s.pathAB.EndpointA, packet, ack)
+	s.Require().Equal(balance, s.balance(s.chainA, sdk.DefaultBondDenom))
+}
+
+func (s *PacketForwardTestSuite) TestForwardTimeoutRefund() {
+	amount := sdkmath.NewInt(100)
+	balance := s.balance(s.chainA, sdk.DefaultBondDenom)
+
+	memo := s.forwardMemo(s.chainC.SenderAccount.GetAddress().String(), time.Minute, 0)
+	packet := s.transfer(sdk.NewCoin(sdk.DefaultBondDenom, amount), memo)
+	forwarded := s.forward(packet)
+
+	// chain B gives up on the forwarded packet and acknowledges the packet with an error
+	s.coordinator.IncrementTimeBy(2 * time.Minute)
+	s.coordinator.CommitBlock(s.chainC)
+	ack := s.parseAck(s.timeout(s.pathBC.EndpointA, forwarded))
+	s.requireAck(ack, false)
+
+	s.acknowledge(s.pathAB.EndpointA, packet, ack)
+	s.Require().Equal(balance, s.balance(s.chainA, sdk.DefaultBondDenom))
+	s.Require().True(nolus(s.chainB).BankKeeper.GetSupply(s.chainB.GetContext(), voucher(s.pathAB.EndpointB)).Amount.IsZero())
+}
+
+func (s *PacketForwardTestSuite) TestForwardRateLimited() {
+	// the vouchers need a supply on chain B to be limited
+	packet := s.transfer(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000), "")
+	s.Require().NoError(s.pathAB.RelayPacket(packet))
+
+	path := ratelimittypes.NewPath(voucher(s.pathAB.EndpointB), s.pathBC.EndpointA.ChannelID)
+	quota := ratelimittypes.NewQuota(sdkmath.LegacyNewDec(1), sdkmath.LegacyNewDec(1), time.Hour)
+	s.Require().NoError(nolus(s.chainB).RateLimitKeeper.AddRateLimit(s.chainB.GetContext(), path, quota))
+	s.coordinator.CommitBlock(s.chainB)
+
+	// the forward exceeding the quota of chain B is acknowledged with an error and refunded
+	balance := s.balance(s.chainA, sdk.DefaultBondDenom)
+	packet = s.transfer(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100), s.forwardMemo(s.chainC.SenderAccount.GetAddress().String(), time.Hour, 0))
+	ack := s.parseAck(s.recv(s.pathAB.EndpointB, packet))
+	s.requireAck(ack, false)
+
+	s.acknowledge(s.pathAB.EndpointA, packet, ack)
+	s.Require().Equal(balance, s.balance(s.chainA, sdk.DefaultBondDenom))
+	s.Require().Equal(sdkmath.NewInt(1000), nolus(s.chainB).BankKeeper.GetSupply(s.chainB.GetContext(), voucher(s.pathAB.EndpointB)).Amount)
+}
+
+func (s *PacketForwardTestSuite) TestForwardContractCallback() {
+	coin := sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)
+	contract := s.instantiateReflect(coin)
+
+	memo := s.forwardMemo(s.chainC.SenderAccount.GetAddress().String(), time.Hour, 0)
+	packet := s.contractTransfer(contract, coin, memo)
+	forwarded := s.forward(packet)
+
+	ack := s.complete(packet, forwarded)
+	s.requireAck(ack, true)
+	s.requireCallback(contract, packet, ack)
+	s.Require().Equal(coin.Amount, s.balance(s.chainC, voucher(s.pathAB.EndpointB, s.pathBC.EndpointB)))
+}
+
+func (s *PacketForwardTestSuite) TestForwardContractCallbackOnTimeout() {
+	coin := sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)
+	contract := s.instantiateReflect(coin)
+
+	memo := s.forwardMemo(s.chainC.SenderAccount.GetAddress().String(), time.Minute, 0)
+	packet := s.contractTransfer(contract, coin, memo)
+	forwarded := s.forward(packet)
+
+	// the timeout of the forwarded packet reaches the contract as an error acknowledgement
+	s.coordinator.IncrementTimeBy(2 * time.Minute)
+	s.coordinator.CommitBlock(s.chainC)
+	ack := s.parseAck(s.timeout(s.pathBC.EndpointA, forwarded))
+	s.requireAck(ack, false)
+
+	s.acknowledge(s.pathAB.EndpointA, packet, ack)
+	s.requireCallback(contract, packet, ack)
+
+	// the contract is refunded the coin and the unused timeout fee
+	s.Require().Equal(coin.Add(fee()[0]), nolus(s.chainA).BankKeeper.GetBalance(s.chainA.GetContext(), contract, sdk.DefaultBondDenom))
+}
@@ -47,6 +47,7 @@ import (
 	"github.com/Nolus-Protocol/nolus-core/app/params"
 	minttypes "github.com/Nolus-Protocol/nolus-core/x/mint/types"
 	taxmoduletypes "github.com/Nolus-Protocol/nolus-core/x/tax/types"
+	vestingstypes "github.com/Nolus-Protocol/nolus-core/x/vestings/types"
 
 	contractmanagermoduletypes "github.com/neutron-org/neutron/x/contractmanager/types"
 	feetypes "github.com/neutron-org/neutron/x/feerefunder/types"
@@ -297,6 +298,7 @@ func TestAppImportExport(t *testing.T) {
 		{keys[feetypes.StoreKey], newKeys[feetypes.StoreKey], [][]byte{}},
 		{keys[minttypes.StoreKey], newKeys[minttypes.StoreKey], [][]byte{}},
 		{keys[taxmoduletypes.StoreKey], newKeys[taxmoduletypes.StoreKey], [][]byte{}},
+		{keys[vestingstypes.StoreKey], newKeys[vestingstypes.StoreKey], [][]byte{}},
 		{keys[interchaintxstypes.StoreKey], newKeys[interchaintxstypes.StoreKey], [][]byte{}},
 		{keys[contractmanagermoduletypes.StoreKey], newKeys[contractmanagermoduletypes.StoreKey], [][]byte{}},
 		{keys[interchainqueriestypes.StoreKey], newKeys[interchainqueriestypes.StoreKey], [][]byte{}},
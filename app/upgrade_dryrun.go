@@ -0,0 +1,165 @@
+package app
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/cosmos-sdk/store/cachemulti"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+
+	"github.com/Nolus-Protocol/nolus-core/app/upgrades"
+)
+
+// StoreChange summarizes the keys an upgrade wrote to or deleted from a single store.
+type StoreChange struct {
+	Store   string `json:"store"`
+	Writes  int    `json:"writes"`
+	Deletes int    `json:"deletes"`
+}
+
+// UpgradeDryRunResult is the outcome of executing an upgrade handler without committing its changes.
+type UpgradeDryRunResult struct {
+	Name             string            `json:"name"`
+	Height           int64             `json:"height"`
+	FromVersions     module.VersionMap `json:"from_versions"`
+	ToVersions       module.VersionMap `json:"to_versions"`
+	StoreChanges     []StoreChange     `json:"store_changes"`
+	BrokenInvariants []string          `json:"broken_invariants"`
+}
+
+// GetUpgrade returns the registered upgrade with the given name.
+func GetUpgrade(name string) (upgrades.Upgrade, bool) {
+	for _, upgrade := range Upgrades {
+		if upgrade.UpgradeName == name {
+			return upgrade, true
+		}
+	}
+
+	return upgrades.Upgrade{}, false
+}
+
+// DryRunUpgrade executes the handler of the named upgrade on a branch of the latest
+// committed state, asserts all registered invariants and summarizes the resulting
+// store changes. Nothing is written back to the commit multistore.
+//
+// The store upgrades of the upgrade must already be applied by the store loader,
+// see upgradetypes.UpgradeStoreLoader.
+func (app *App) DryRunUpgrade(name string, header tmproto.Header) (*UpgradeDryRunResult, error) {
+	upgrade, found := GetUpgrade(name)
+	if !found {
+		return nil, fmt.Errorf("unknown upgrade %q", name)
+	}
+
+	cms, ok := app.CommitMultiStore().CacheMultiStore().(cachemulti.Store)
+	if !ok {
+		return nil, fmt.Errorf("unexpected cache multistore type %T", app.CommitMultiStore().CacheMultiStore())
+	}
+
+	// Writes are traced only when the upgrade branch is flushed into the parent branch,
+	// so the recorder sees every key touched by the upgrade exactly once.
+	recorder := &storeChangeRecorder{changes: make(map[string]*StoreChange)}
+	traced := cms.SetTracer(recorder).CacheMultiStore()
+	ctx := sdk.NewContext(traced, header, false, app.Logger())
+
+	fromVM := app.UpgradeKeeper.GetModuleVersionMap(ctx)
+	plan := upgradetypes.Plan{Name: upgrade.UpgradeName, Height: header.Height}
+	handler := upgrade.CreateUpgradeHandler(app.mm, app.configurator, &app.AppKeepers, app.appCodec)
+
+	toVM, err := handler(ctx, plan, fromVM)
+	if err != nil {
+		return nil, fmt.Errorf("upgrade handler %s failed: %w", name, err)
+	}
+	// mirror x/upgrade ApplyUpgrade, which persists the new module versions after the handler
+	app.UpgradeKeeper.SetModuleVersionMap(ctx, toVM)
+
+	var broken []string
+	for _, route := range app.CrisisKeeper.Routes() {
+		if res, stop := route.Invar(ctx); stop {
+			broken = append(broken, fmt.Sprintf("%s: %s", route.FullRoute(), res))
+		}
+	}
+
+	recorder.enabled = true
+	traced.Write()
+	if recorder.err != nil {
+		return nil, recorder.err
+	}
+
+	return &UpgradeDryRunResult{
+		Name:             name,
+		Height:           header.Height,
+		FromVersions:     fromVM,
+		ToVersions:       toVM,
+		StoreChanges:     recorder.sorted(),
+		BrokenInvariants: broken,
+	}, nil
+}
+
+// storeChangeRecorder consumes the JSON lines emitted by traced KV stores and counts
+// the write and delete operations per store.
+type storeChangeRecorder struct {
+	enabled bool
+	buf     []byte
+	changes map[string]*StoreChange
+	err     error
+}
+
+type traceOperation struct {
+	Operation string            `json:"operation"`
+	Metadata  map[string]string `json:"metadata"`
+}
+
+func (r *storeChangeRecorder) Write(p []byte) (int, error) {
+	if !r.enabled {
+		return len(p), nil
+	}
+
+	r.buf = append(r.buf, p...)
+	for {
+		i := bytes.IndexByte(r.buf, '\n')
+		if i < 0 {
+			return len(p), nil
+		}
+		r.record(r.buf[:i])
+		r.buf = r.buf[i+1:]
+	}
+}
+
+func (r *storeChangeRecorder) record(line []byte) {
+	var op traceOperation
+	if err := json.Unmarshal(line, &op); err != nil {
+		if r.err == nil {
+			r.err = fmt.Errorf("failed to decode store trace: %w", err)
+		}
+		return
+	}
+
+	store := op.Metadata["store_name"]
+	change, found := r.changes[store]
+	if !found {
+		change = &StoreChange{Store: store}
+		r.changes[store] = change
+	}
+
+	switch op.Operation {
+	case "write":
+		change.Writes++
+	case "delete":
+		change.Deletes++
+	}
+}
+
+func (r *storeChangeRecorder) sorted() []StoreChange {
+	changes := make([]StoreChange, 0, len(r.changes))
+	for _, change := range r.changes {
+		changes = append(changes, *change)
+	}
+	sort.Slice(changes, func(i, j int) bool { return changes[i].Store < changes[j].Store })
+
+	return changes
+}
@@ -0,0 +1,48 @@
+package app_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+
+	"github.com/Nolus-Protocol/nolus-core/app"
+	v06 "github.com/Nolus-Protocol/nolus-core/app/upgrades/v06"
+	"github.com/Nolus-Protocol/nolus-core/testutil/simapp"
+	crontypes "github.com/Nolus-Protocol/nolus-core/x/cron/types"
+)
+
+func TestDryRunUpgrade(t *testing.T) {
+	nolusApp, err := simapp.TestSetup(t)
+	require.NoError(t, err)
+
+	header := tmproto.Header{Height: nolusApp.LastBlockHeight() + 1}
+
+	// pretend the cron module is not yet known to the chain so that the upgrade initializes it
+	ctx := nolusApp.NewUncachedContext(false, header)
+	versions := prefix.NewStore(ctx.KVStore(nolusApp.GetKey(upgradetypes.StoreKey)), []byte{upgradetypes.VersionMapByte})
+	versions.Delete([]byte(crontypes.ModuleName))
+
+	_, err = nolusApp.DryRunUpgrade("unknown", header)
+	require.Error(t, err)
+
+	res, err := nolusApp.DryRunUpgrade(v06.UpgradeName, header)
+	require.NoError(t, err)
+	require.Equal(t, v06.UpgradeName, res.Name)
+	require.NotContains(t, res.FromVersions, crontypes.ModuleName)
+	require.Equal(t, uint64(1), res.ToVersions[crontypes.ModuleName])
+	require.Empty(t, res.BrokenInvariants)
+
+	changes := make(map[string]app.StoreChange)
+	for _, change := range res.StoreChanges {
+		changes[change.Store] = change
+	}
+	require.Positive(t, changes[crontypes.StoreKey].Writes)
+	require.Positive(t, changes[upgradetypes.StoreKey].Writes)
+
+	// the changes are discarded
+	require.NotContains(t, nolusApp.UpgradeKeeper.GetModuleVersionMap(ctx), crontypes.ModuleName)
+}
@@ -0,0 +1,88 @@
+// Package fixes contains state corrections that are commonly needed by the BeginForkLogic of height-gated forks.
+package fixes
+
+import (
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/Nolus-Protocol/nolus-core/app/keepers"
+	minttypes "github.com/Nolus-Protocol/nolus-core/x/mint/types"
+	taxtypes "github.com/Nolus-Protocol/nolus-core/x/tax/types"
+)
+
+// ResetMintParams overwrites the x/mint parameters.
+func ResetMintParams(ctx sdk.Context, keepers *keepers.AppKeepers, params minttypes.Params) error {
+	if err := keepers.MintKeeper.SetParams(ctx, params); err != nil {
+		return err
+	}
+
+	ctx.Logger().Info("fork: mint params reset", "params", params.String())
+	return nil
+}
+
+// ResetTaxParams overwrites the x/tax parameters.
+func ResetTaxParams(ctx sdk.Context, keepers *keepers.AppKeepers, params taxtypes.Params) error {
+	if err := keepers.TaxKeeper.SetParams(ctx, params); err != nil {
+		return err
+	}
+
+	ctx.Logger().Info("fork: tax params reset", "params", params.String())
+	return nil
+}
+
+// CorrectMinter overwrites the x/mint minter state after validating it.
+func CorrectMinter(ctx sdk.Context, keepers *keepers.AppKeepers, minter minttypes.Minter) error {
+	if err := minttypes.ValidateMinter(minter); err != nil {
+		return err
+	}
+
+	keepers.MintKeeper.SetMinter(ctx, minter)
+
+	ctx.Logger().Info("fork: minter corrected", "minter", minter.String())
+	return nil
+}
+
+// SetContractAdmin changes the admin of a contract with governance permissions.
+func SetContractAdmin(ctx sdk.Context, keepers *keepers.AppKeepers, contract, admin string) error {
+	contractAddr, err := sdk.AccAddressFromBech32(contract)
+	if err != nil {
+		return err
+	}
+
+	adminAddr, err := sdk.AccAddressFromBech32(admin)
+	if err != nil {
+		return err
+	}
+
+	if err := govContractKeeper(keepers).UpdateContractAdmin(ctx, contractAddr, govAddress(), adminAddr); err != nil {
+		return err
+	}
+
+	ctx.Logger().Info("fork: contract admin changed", "contract", contract, "admin", admin)
+	return nil
+}
+
+// ClearContractAdmin removes the admin of a contract with governance permissions, making it immutable.
+func ClearContractAdmin(ctx sdk.Context, keepers *keepers.AppKeepers, contract string) error {
+	contractAddr, err := sdk.AccAddressFromBech32(contract)
+	if err != nil {
+		return err
+	}
+
+	if err := govContractKeeper(keepers).ClearContractAdmin(ctx, contractAddr, govAddress()); err != nil {
+		return err
+	}
+
+	ctx.Logger().Info("fork: contract admin cleared", "contract", contract)
+	return nil
+}
+
+func govContractKeeper(keepers *keepers.AppKeepers) *wasmkeeper.PermissionedKeeper {
+	return wasmkeeper.NewGovPermissionKeeper(keepers.WasmKeeper)
+}
+
+func govAddress() sdk.AccAddress {
+	return authtypes.NewModuleAddress(govtypes.ModuleName)
+}
@@ -0,0 +1,103 @@
+package fixes_test
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/suite"
+
+	nolusapp "github.com/Nolus-Protocol/nolus-core/app"
+	"github.com/Nolus-Protocol/nolus-core/app/params"
+	"github.com/Nolus-Protocol/nolus-core/app/upgrades/fixes"
+	simulationapp "github.com/Nolus-Protocol/nolus-core/testutil/simapp"
+	minttypes "github.com/Nolus-Protocol/nolus-core/x/mint/types"
+	taxtypes "github.com/Nolus-Protocol/nolus-core/x/tax/types"
+)
+
+type FixesTestSuite struct {
+	suite.Suite
+	ctx sdk.Context
+	app *nolusapp.App
+}
+
+func (s *FixesTestSuite) SetupTest() {
+	var err error
+	_ = params.SetAddressPrefixes()
+	s.app, err = simulationapp.TestSetup(s.T())
+	s.Require().NoError(err)
+
+	header := tmproto.Header{Height: s.app.LastBlockHeight() + 1}
+	s.ctx = s.app.BaseApp.NewContext(false, header).WithBlockTime(time.Now())
+}
+
+// instantiateReflectContract stores and instantiates the reflect contract with the given admin.
+func (s *FixesTestSuite) instantiateReflectContract(admin sdk.AccAddress) sdk.AccAddress {
+	wasmCode, err := os.ReadFile("../../../wasmbinding/testdata/reflect.wasm")
+	s.Require().NoError(err)
+
+	contractKeeper := wasmkeeper.NewDefaultPermissionKeeper(s.app.WasmKeeper)
+	codeID, _, err := contractKeeper.Create(s.ctx, admin, wasmCode, nil)
+	s.Require().NoError(err)
+
+	contract, _, err := contractKeeper.Instantiate(s.ctx, codeID, admin, admin, []byte("{}"), "reflect", nil)
+	s.Require().NoError(err)
+
+	return contract
+}
+
+func (s *FixesTestSuite) TestResetMintParams() {
+	params := minttypes.NewParams("unls", sdkmath.NewUint(120000000000))
+	s.Require().NoError(fixes.ResetMintParams(s.ctx, &s.app.AppKeepers, params))
+	s.Require().Equal(params, s.app.MintKeeper.GetParams(s.ctx))
+
+	invalid := minttypes.NewParams("", sdkmath.NewUint(1))
+	s.Require().Error(fixes.ResetMintParams(s.ctx, &s.app.AppKeepers, invalid))
+	s.Require().Equal(params, s.app.MintKeeper.GetParams(s.ctx))
+}
+
+func (s *FixesTestSuite) TestResetTaxParams() {
+	params := taxtypes.DefaultParams()
+	params.FeeRate = 10
+	s.Require().NoError(fixes.ResetTaxParams(s.ctx, &s.app.AppKeepers, params))
+	s.Require().Equal(int32(10), s.app.TaxKeeper.FeeRate(s.ctx))
+
+	params.FeeRate = -1
+	s.Require().Error(fixes.ResetTaxParams(s.ctx, &s.app.AppKeepers, params))
+	s.Require().Equal(int32(10), s.app.TaxKeeper.FeeRate(s.ctx))
+}
+
+func (s *FixesTestSuite) TestCorrectMinter() {
+	minter := minttypes.NewMinter(sdkmath.LegacyMustNewDecFromStr("2.5"), sdkmath.NewUint(7580455156510), sdkmath.NewUint(10), sdkmath.NewUint(100))
+	s.Require().NoError(fixes.CorrectMinter(s.ctx, &s.app.AppKeepers, minter))
+	s.Require().Equal(minter, s.app.MintKeeper.GetMinter(s.ctx))
+
+	invalid := minter
+	invalid.NormTimePassed = sdkmath.LegacyMustNewDecFromStr("-1")
+	s.Require().Error(fixes.CorrectMinter(s.ctx, &s.app.AppKeepers, invalid))
+	s.Require().Equal(minter, s.app.MintKeeper.GetMinter(s.ctx))
+}
+
+func (s *FixesTestSuite) TestContractAdmin() {
+	admin := wasmkeeper.RandomAccountAddress(s.T())
+	newAdmin := wasmkeeper.RandomAccountAddress(s.T())
+	contract := s.instantiateReflectContract(admin)
+
+	s.Require().NoError(fixes.SetContractAdmin(s.ctx, &s.app.AppKeepers, contract.String(), newAdmin.String()))
+	s.Require().Equal(newAdmin.String(), s.app.WasmKeeper.GetContractInfo(s.ctx, contract).Admin)
+
+	s.Require().NoError(fixes.ClearContractAdmin(s.ctx, &s.app.AppKeepers, contract.String()))
+	s.Require().Empty(s.app.WasmKeeper.GetContractInfo(s.ctx, contract).Admin)
+
+	unknown := wasmkeeper.RandomAccountAddress(s.T())
+	s.Require().Error(fixes.SetContractAdmin(s.ctx, &s.app.AppKeepers, unknown.String(), newAdmin.String()))
+	s.Require().Error(fixes.SetContractAdmin(s.ctx, &s.app.AppKeepers, contract.String(), "invalid"))
+}
+
+func TestFixesTestSuite(t *testing.T) {
+	suite.Run(t, new(FixesTestSuite))
+}
@@ -0,0 +1,32 @@
+package v06
+
+import (
+	"github.com/Nolus-Protocol/nolus-core/app/upgrades"
+	store "github.com/cosmos/cosmos-sdk/store/types"
+
+	packetforwardtypes "github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v7/packetforward/types"
+
+	crontypes "github.com/Nolus-Protocol/nolus-core/x/cron/types"
+	msgfiltertypes "github.com/Nolus-Protocol/nolus-core/x/msgfilter/types"
+	ratelimittypes "github.com/Nolus-Protocol/nolus-core/x/ratelimit/types"
+	tokenfactorytypes "github.com/Nolus-Protocol/nolus-core/x/tokenfactory/types"
+)
+
+const (
+	// UpgradeName defines the on-chain upgrades name.
+	UpgradeName = "v0.6.0"
+)
+
+var Upgrade = upgrades.Upgrade{
+	UpgradeName:          UpgradeName,
+	CreateUpgradeHandler: CreateUpgradeHandler,
+	StoreUpgrades: store.StoreUpgrades{
+		Added: []string{
+			crontypes.StoreKey,
+			msgfiltertypes.StoreKey,
+			ratelimittypes.StoreKey,
+			packetforwardtypes.StoreKey,
+			tokenfactorytypes.StoreKey,
+		},
+	},
+}
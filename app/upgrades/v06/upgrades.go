@@ -0,0 +1,39 @@
+package v06
+
+import (
+	"github.com/Nolus-Protocol/nolus-core/app/keepers"
+	taxtypes "github.com/Nolus-Protocol/nolus-core/x/tax/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+)
+
+func CreateUpgradeHandler(
+	mm *module.Manager,
+	configurator module.Configurator,
+	keepers *keepers.AppKeepers,
+	codec codec.Codec,
+) upgradetypes.UpgradeHandler {
+	return func(ctx sdk.Context, plan upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+		ctx.Logger().Info(`v0.6.0 upgrade handler execution...`)
+		// the new modules are initialized with their default genesis by the migrations
+		vm, err := mm.RunMigrations(ctx, configurator, fromVM)
+		if err != nil {
+			return nil, err
+		}
+
+		ctx.Logger().Info("enabling the fee-free ibc relay transactions")
+		taxParams := keepers.TaxKeeper.GetParams(ctx)
+		taxParams.MaxRelayGasPerTx = taxtypes.DefaultMaxRelayGasPerTx
+		taxParams.MaxRelayGasPerBlock = taxtypes.DefaultMaxRelayGasPerBlock
+		// no tax is burned until governance sets a burn ratio
+		taxParams.BurnRatio = taxtypes.DefaultBurnRatio
+		if err := keepers.TaxKeeper.SetParams(ctx, taxParams); err != nil {
+			return nil, err
+		}
+
+		return vm, nil
+	}
+}
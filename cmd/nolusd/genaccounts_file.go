@@ -0,0 +1,485 @@
+package main
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	tmtypes "github.com/cometbft/cometbft/types"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	authvesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+)
+
+const flagAccountsFile = "file"
+
+// genesisAccountSpec is an entry of the accounts file of add-genesis-accounts.
+type genesisAccountSpec struct {
+	Address     string              `json:"address"`
+	Module      string              `json:"module"`
+	Permissions []string            `json:"permissions"`
+	Amount      string              `json:"amount"`
+	Vesting     *genesisVestingSpec `json:"vesting"`
+}
+
+type genesisVestingSpec struct {
+	Amount    string                     `json:"amount"`
+	StartTime unixTime                   `json:"start-time"`
+	EndTime   unixTime                   `json:"end-time"`
+	Periods   []genesisVestingPeriodSpec `json:"periods"`
+}
+
+type genesisVestingPeriodSpec struct {
+	Length int64  `json:"length"`
+	Amount string `json:"amount"`
+}
+
+// unixTime is a unix epoch in seconds, given either as a number or as an RFC 3339 datetime.
+type unixTime int64
+
+func (t *unixTime) UnmarshalJSON(bz []byte) error {
+	var s string
+	if err := json.Unmarshal(bz, &s); err != nil {
+		s = string(bz)
+	}
+
+	v, err := parseUnixTime(s)
+	if err != nil {
+		return err
+	}
+
+	*t = unixTime(v)
+	return nil
+}
+
+func parseUnixTime(s string) (int64, error) {
+	if s == "" {
+		return 0, nil
+	}
+
+	if v, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return v, nil
+	}
+
+	v, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid time %q, expected a unix epoch or an RFC 3339 datetime", s)
+	}
+
+	return v.Unix(), nil
+}
+
+// AddGenesisAccountsCmd returns add-genesis-accounts cobra Command.
+func AddGenesisAccountsCmd(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-genesis-accounts --file [accounts.json|accounts.csv]",
+		Short: "Add the genesis accounts of a file to genesis.json",
+		Long: `Add the genesis accounts listed in a JSON or CSV file to genesis.json with a single write.
+No account is added when any entry is invalid or an address is listed twice or already
+exists in the genesis.
+
+A JSON file holds an array of accounts:
+
+[
+  {"address": "nolus1...", "amount": "1000unls"},
+  {"address": "nolus1...", "amount": "1000unls",
+   "vesting": {"amount": "500unls", "start-time": "2024-01-01T00:00:00Z", "end-time": 1735689600}},
+  {"address": "nolus1...", "amount": "300unls",
+   "vesting": {"start-time": 1704067200, "periods": [{"length": 2592000, "amount": "100unls"}]}},
+  {"module": "name", "permissions": ["burner"], "amount": "1000unls"}
+]
+
+The vesting times are unix epochs or RFC 3339 datetimes and the period lengths are in seconds.
+An account vests continuously with a start and an end time, is delayed with an end time only,
+and is periodic with periods. A module account is created from its module name.
+
+A CSV file has a header row naming its columns out of address, module, permissions, amount,
+vesting_amount, vesting_start_time, vesting_end_time and vesting_periods. The permissions are
+separated by ';' and the periods are written as 'length:coins' separated by ';'.
+`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			cdc := clientCtx.Codec
+
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			config := serverCtx.Config
+
+			config.SetRoot(clientCtx.HomeDir)
+
+			file, err := cmd.Flags().GetString(flagAccountsFile)
+			if err != nil {
+				return err
+			}
+			if file == "" {
+				return fmt.Errorf("--%s is required", flagAccountsFile)
+			}
+
+			specs, err := readGenesisAccountSpecs(file)
+			if err != nil {
+				return fmt.Errorf("failed to read %s: %w", file, err)
+			}
+
+			genFile := config.GenesisFile()
+			appState, genDoc, err := genutiltypes.GenesisStateFromGenFile(genFile)
+			if err != nil {
+				return fmt.Errorf("failed to unmarshal genesis state: %w", err)
+			}
+
+			authGenState := authtypes.GetGenesisStateFromAppState(cdc, appState)
+			accs, err := authtypes.UnpackAccounts(authGenState.Accounts)
+			if err != nil {
+				return fmt.Errorf("failed to get accounts from any: %w", err)
+			}
+			bankGenState := banktypes.GetGenesisStateFromAppState(cdc, appState)
+
+			existing := make(map[string]bool, len(accs)+len(bankGenState.Balances))
+			for _, acc := range accs {
+				existing[acc.GetAddress().String()] = true
+			}
+			for _, balance := range bankGenState.Balances {
+				existing[balance.Address] = true
+			}
+
+			var (
+				errs     []error
+				balances = make([]banktypes.Balance, 0, len(specs))
+				added    = make(map[string]int, len(specs))
+				total    sdk.Coins
+				vesting  int
+				modules  int
+			)
+			for i, spec := range specs {
+				genAccount, balance, err := spec.build()
+				if err != nil {
+					errs = append(errs, fmt.Errorf("entry %d: %w", i+1, err))
+					continue
+				}
+
+				addr := balance.Address
+				if prev, found := added[addr]; found {
+					errs = append(errs, fmt.Errorf("entry %d: address %s is already listed by entry %d", i+1, addr, prev))
+					continue
+				}
+				if existing[addr] {
+					errs = append(errs, fmt.Errorf("entry %d: cannot add account at existing address %s", i+1, addr))
+					continue
+				}
+				added[addr] = i + 1
+
+				switch genAccount.(type) {
+				case authtypes.ModuleAccountI:
+					modules++
+				case *authvesting.ContinuousVestingAccount, *authvesting.DelayedVestingAccount, *authvesting.PeriodicVestingAccount:
+					vesting++
+				}
+
+				accs = append(accs, genAccount)
+				balances = append(balances, balance)
+				total = total.Add(balance.Coins...)
+			}
+			if len(errs) != 0 {
+				return fmt.Errorf("no account added, %d invalid entries:\n%w", len(errs), errors.Join(errs...))
+			}
+
+			// Add the new accounts to the set of genesis accounts and sanitize the
+			// accounts afterwards.
+			accs = authtypes.SanitizeGenesisAccounts(accs)
+			genAccs, err := authtypes.PackAccounts(accs)
+			if err != nil {
+				return fmt.Errorf("failed to convert accounts into any's: %w", err)
+			}
+			authGenState.Accounts = genAccs
+
+			authGenStateBz, err := cdc.MarshalJSON(&authGenState)
+			if err != nil {
+				return fmt.Errorf("failed to marshal auth genesis state: %w", err)
+			}
+			appState[authtypes.ModuleName] = authGenStateBz
+
+			bankGenState.Balances = append(bankGenState.Balances, balances...)
+			bankGenState.Balances = banktypes.SanitizeGenesisBalances(bankGenState.Balances)
+			// an explicit supply has to account for the new balances, an empty one is computed from the balances
+			if !bankGenState.Supply.Empty() {
+				bankGenState.Supply = bankGenState.Supply.Add(total...)
+			}
+
+			bankGenStateBz, err := cdc.MarshalJSON(bankGenState)
+			if err != nil {
+				return fmt.Errorf("failed to marshal bank genesis state: %w", err)
+			}
+			appState[banktypes.ModuleName] = bankGenStateBz
+
+			appStateJSON, err := json.Marshal(appState)
+			if err != nil {
+				return fmt.Errorf("failed to marshal application genesis state: %w", err)
+			}
+
+			genDoc.AppState = appStateJSON
+			if err := exportGenesisFileAtomically(genDoc, genFile); err != nil {
+				return err
+			}
+
+			supply := bankGenState.Supply
+			if supply.Empty() {
+				for _, balance := range bankGenState.Balances {
+					supply = supply.Add(balance.Coins...)
+				}
+			}
+
+			cmd.Printf("added %d accounts, %d vesting and %d module accounts\n", len(balances), vesting, modules)
+			cmd.Printf("added balances: %s\n", total)
+			cmd.Printf("total supply: %s\n", supply)
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flagAccountsFile, "", "JSON or CSV file listing the genesis accounts")
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+
+	return cmd
+}
+
+// build creates the genesis account and balance of the entry.
+func (s genesisAccountSpec) build() (authtypes.GenesisAccount, banktypes.Balance, error) {
+	coins, err := sdk.ParseCoinsNormalized(s.Amount)
+	if err != nil {
+		return nil, banktypes.Balance{}, fmt.Errorf("failed to parse coins: %w", err)
+	}
+
+	var genAccount authtypes.GenesisAccount
+	switch {
+	case s.Module != "":
+		if s.Vesting != nil {
+			return nil, banktypes.Balance{}, fmt.Errorf("module account %s cannot vest", s.Module)
+		}
+
+		moduleAccount := authtypes.NewEmptyModuleAccount(s.Module, s.Permissions...)
+		if s.Address != "" && s.Address != moduleAccount.Address {
+			return nil, banktypes.Balance{}, fmt.Errorf("address %s is not the address %s of module %s", s.Address, moduleAccount.Address, s.Module)
+		}
+		genAccount = moduleAccount
+
+	default:
+		if len(s.Permissions) != 0 {
+			return nil, banktypes.Balance{}, errors.New("permissions are only allowed for module accounts")
+		}
+
+		addr, err := sdk.AccAddressFromBech32(s.Address)
+		if err != nil {
+			return nil, banktypes.Balance{}, fmt.Errorf("invalid address %q: %w", s.Address, err)
+		}
+
+		baseAccount := authtypes.NewBaseAccount(addr, nil, 0, 0)
+		if s.Vesting == nil {
+			genAccount = baseAccount
+		} else {
+			genAccount, err = s.Vesting.build(baseAccount, coins)
+			if err != nil {
+				return nil, banktypes.Balance{}, err
+			}
+		}
+	}
+
+	if err := genAccount.Validate(); err != nil {
+		return nil, banktypes.Balance{}, fmt.Errorf("failed to validate new genesis account: %w", err)
+	}
+
+	return genAccount, banktypes.Balance{Address: genAccount.GetAddress().String(), Coins: coins.Sort()}, nil
+}
+
+func (s genesisVestingSpec) build(baseAccount *authtypes.BaseAccount, coins sdk.Coins) (authtypes.GenesisAccount, error) {
+	var (
+		vestingAmt sdk.Coins
+		err        error
+	)
+	if s.Amount != "" {
+		if vestingAmt, err = sdk.ParseCoinsNormalized(s.Amount); err != nil {
+			return nil, fmt.Errorf("failed to parse vesting amount: %w", err)
+		}
+	}
+
+	var periods authvesting.Periods
+	for i, p := range s.Periods {
+		amount, err := sdk.ParseCoinsNormalized(p.Amount)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse the amount of vesting period %d: %w", i+1, err)
+		}
+		if p.Length <= 0 || amount.IsZero() {
+			return nil, fmt.Errorf("vesting period %d must have a positive length and amount", i+1)
+		}
+		periods = append(periods, authvesting.Period{Length: p.Length, Amount: amount})
+	}
+
+	if len(periods) != 0 {
+		if !vestingAmt.IsZero() && !vestingAmt.IsEqual(periods.TotalAmount()) {
+			return nil, fmt.Errorf("vesting amount %s differs from the total %s of the vesting periods", vestingAmt, periods.TotalAmount())
+		}
+		vestingAmt = periods.TotalAmount()
+	}
+
+	if vestingAmt.IsZero() {
+		return nil, errors.New("vesting amount must be positive")
+	}
+	if !coins.IsAllGTE(vestingAmt) {
+		return nil, errors.New("vesting amount cannot be greater than total amount")
+	}
+
+	start, end := int64(s.StartTime), int64(s.EndTime)
+	switch {
+	case len(periods) != 0:
+		if start == 0 {
+			return nil, errors.New("invalid vesting parameters; periodic vesting requires a start time")
+		}
+		return authvesting.NewPeriodicVestingAccount(baseAccount, vestingAmt.Sort(), start, periods), nil
+
+	case start != 0 && end != 0:
+		return authvesting.NewContinuousVestingAccount(baseAccount, vestingAmt.Sort(), start, end), nil
+
+	case end != 0:
+		return authvesting.NewDelayedVestingAccount(baseAccount, vestingAmt.Sort(), end), nil
+
+	default:
+		return nil, errors.New("invalid vesting parameters; must supply start and end time, end time or periods")
+	}
+}
+
+func readGenesisAccountSpecs(file string) ([]genesisAccountSpec, error) {
+	bz, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+
+	switch strings.ToLower(filepath.Ext(file)) {
+	case ".json":
+		var specs []genesisAccountSpec
+		decoder := json.NewDecoder(bytes.NewReader(bz))
+		decoder.DisallowUnknownFields()
+		if err := decoder.Decode(&specs); err != nil {
+			return nil, err
+		}
+		return specs, nil
+
+	case ".csv":
+		return readGenesisAccountSpecsCSV(bytes.NewReader(bz))
+
+	default:
+		return nil, fmt.Errorf("unsupported file extension %q, expected .json or .csv", filepath.Ext(file))
+	}
+}
+
+func readGenesisAccountSpecsCSV(r io.Reader) ([]genesisAccountSpec, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the header: %w", err)
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, column := range header {
+		column = strings.TrimSpace(column)
+		switch column {
+		case "address", "module", "permissions", "amount", "vesting_amount", "vesting_start_time", "vesting_end_time", "vesting_periods":
+		default:
+			return nil, fmt.Errorf("unknown column %q", column)
+		}
+		columns[column] = i
+	}
+
+	var specs []genesisAccountSpec
+	for line := 2; ; line++ {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return specs, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		field := func(column string) string {
+			if i, found := columns[column]; found {
+				return strings.TrimSpace(record[i])
+			}
+			return ""
+		}
+
+		spec := genesisAccountSpec{
+			Address:     field("address"),
+			Module:      field("module"),
+			Permissions: splitList(field("permissions")),
+			Amount:      field("amount"),
+		}
+
+		vestingAmount, vestingStart, vestingEnd, vestingPeriods := field("vesting_amount"), field("vesting_start_time"), field("vesting_end_time"), field("vesting_periods")
+		if vestingAmount != "" || vestingStart != "" || vestingEnd != "" || vestingPeriods != "" {
+			vesting := &genesisVestingSpec{Amount: vestingAmount}
+
+			start, err := parseUnixTime(vestingStart)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %w", line, err)
+			}
+			end, err := parseUnixTime(vestingEnd)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %w", line, err)
+			}
+			vesting.StartTime, vesting.EndTime = unixTime(start), unixTime(end)
+
+			for _, period := range splitList(vestingPeriods) {
+				length, amount, found := strings.Cut(period, ":")
+				if !found {
+					return nil, fmt.Errorf("line %d: invalid vesting period %q, expected length:coins", line, period)
+				}
+				seconds, err := strconv.ParseInt(length, 10, 64)
+				if err != nil {
+					return nil, fmt.Errorf("line %d: invalid vesting period length %q", line, length)
+				}
+				vesting.Periods = append(vesting.Periods, genesisVestingPeriodSpec{Length: seconds, Amount: amount})
+			}
+
+			spec.Vesting = vesting
+		}
+
+		specs = append(specs, spec)
+	}
+}
+
+func splitList(s string) []string {
+	var items []string
+	for _, item := range strings.Split(s, ";") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+
+	return items
+}
+
+// exportGenesisFileAtomically writes the genesis to a temporary file next to genFile and
+// renames it over genFile, so an interrupted write never leaves a partial genesis behind.
+func exportGenesisFileAtomically(genDoc *tmtypes.GenesisDoc, genFile string) error {
+	tmpFile := genFile + ".tmp"
+	if err := genutil.ExportGenesisFile(genDoc, tmpFile); err != nil {
+		_ = os.Remove(tmpFile)
+		return err
+	}
+
+	return os.Rename(tmpFile, genFile)
+}
@@ -0,0 +1,355 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting"
+	authvesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+	genutiltest "github.com/cosmos/cosmos-sdk/x/genutil/client/testutil"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+
+	"github.com/Nolus-Protocol/nolus-core/app/params"
+)
+
+var testMbm = module.NewBasicManager(
+	genutil.AppModuleBasic{},
+	auth.AppModuleBasic{},
+	vesting.AppModuleBasic{},
+	bank.AppModuleBasic{},
+)
+
+func testAddress(name string) string {
+	_ = params.SetAddressPrefixes()
+	return sdk.AccAddress(fmt.Sprintf("%-20s", name)).String()
+}
+
+func TestReadGenesisAccountSpecsCSV(t *testing.T) {
+	addr := testAddress("addr")
+
+	tests := []struct {
+		name     string
+		csv      string
+		expected []genesisAccountSpec
+		err      string
+	}{
+		{
+			name: "base and module accounts",
+			csv: "address, module, permissions, amount\n" +
+				addr + ",,,1000unls\n" +
+				",fees,burner; minter,5unls\n",
+			expected: []genesisAccountSpec{
+				{Address: addr, Amount: "1000unls"},
+				{Module: "fees", Permissions: []string{"burner", "minter"}, Amount: "5unls"},
+			},
+		},
+		{
+			name: "continuous vesting with RFC 3339 and epoch times",
+			csv: "address,amount,vesting_amount,vesting_start_time,vesting_end_time\n" +
+				addr + ",1000unls,500unls,2024-01-01T00:00:00Z,1735689600\n",
+			expected: []genesisAccountSpec{{
+				Address: addr,
+				Amount:  "1000unls",
+				Vesting: &genesisVestingSpec{Amount: "500unls", StartTime: 1704067200, EndTime: 1735689600},
+			}},
+		},
+		{
+			name: "periodic vesting",
+			csv: "address,amount,vesting_start_time,vesting_periods\n" +
+				addr + ",300unls,1704067200,10:100unls; 20:200unls\n",
+			expected: []genesisAccountSpec{{
+				Address: addr,
+				Amount:  "300unls",
+				Vesting: &genesisVestingSpec{
+					StartTime: 1704067200,
+					Periods:   []genesisVestingPeriodSpec{{Length: 10, Amount: "100unls"}, {Length: 20, Amount: "200unls"}},
+				},
+			}},
+		},
+		{
+			name: "unknown column",
+			csv:  "address,balance\n" + addr + ",1000unls\n",
+			err:  `unknown column "balance"`,
+		},
+		{
+			name: "invalid time",
+			csv:  "address,amount,vesting_end_time\n" + addr + ",1000unls,tomorrow\n",
+			err:  `line 2: invalid time "tomorrow"`,
+		},
+		{
+			name: "period without length",
+			csv:  "address,amount,vesting_start_time,vesting_periods\n" + addr + ",1000unls,1,100unls\n",
+			err:  `line 2: invalid vesting period "100unls"`,
+		},
+		{
+			name: "invalid period length",
+			csv:  "address,amount,vesting_start_time,vesting_periods\n" + addr + ",1000unls,1,month:100unls\n",
+			err:  `line 2: invalid vesting period length "month"`,
+		},
+		{
+			name: "missing fields",
+			csv:  "address,amount\n" + addr + "\n",
+			err:  "wrong number of fields",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			specs, err := readGenesisAccountSpecsCSV(strings.NewReader(tc.csv))
+			if tc.err != "" {
+				require.ErrorContains(t, err, tc.err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, specs)
+		})
+	}
+}
+
+func TestGenesisVestingSpecBuild(t *testing.T) {
+	baseAccount := authtypes.NewBaseAccountWithAddress(sdk.MustAccAddressFromBech32(testAddress("addr")))
+	coins := sdk.NewCoins(sdk.NewInt64Coin("unls", 1000))
+	periods := []genesisVestingPeriodSpec{{Length: 10, Amount: "100unls"}, {Length: 20, Amount: "200unls"}}
+
+	tests := []struct {
+		name     string
+		spec     genesisVestingSpec
+		expected authtypes.GenesisAccount
+		err      string
+	}{
+		{
+			name:     "continuous",
+			spec:     genesisVestingSpec{Amount: "500unls", StartTime: 100, EndTime: 200},
+			expected: authvesting.NewContinuousVestingAccount(baseAccount, sdk.NewCoins(sdk.NewInt64Coin("unls", 500)), 100, 200),
+		},
+		{
+			name:     "delayed",
+			spec:     genesisVestingSpec{Amount: "500unls", EndTime: 200},
+			expected: authvesting.NewDelayedVestingAccount(baseAccount, sdk.NewCoins(sdk.NewInt64Coin("unls", 500)), 200),
+		},
+		{
+			name: "periodic vesting the total of its periods",
+			spec: genesisVestingSpec{StartTime: 100, Periods: periods},
+			expected: authvesting.NewPeriodicVestingAccount(baseAccount, sdk.NewCoins(sdk.NewInt64Coin("unls", 300)), 100, authvesting.Periods{
+				{Length: 10, Amount: sdk.NewCoins(sdk.NewInt64Coin("unls", 100))},
+				{Length: 20, Amount: sdk.NewCoins(sdk.NewInt64Coin("unls", 200))},
+			}),
+		},
+		{
+			name: "period total mismatch",
+			spec: genesisVestingSpec{Amount: "400unls", StartTime: 100, Periods: periods},
+			err:  "vesting amount 400unls differs from the total 300unls of the vesting periods",
+		},
+		{
+			name: "periodic without start",
+			spec: genesisVestingSpec{Periods: periods},
+			err:  "periodic vesting requires a start time",
+		},
+		{
+			name: "empty period",
+			spec: genesisVestingSpec{StartTime: 100, Periods: []genesisVestingPeriodSpec{{Length: 0, Amount: "100unls"}}},
+			err:  "vesting period 1 must have a positive length and amount",
+		},
+		{
+			name: "amount greater than the balance",
+			spec: genesisVestingSpec{Amount: "1001unls", StartTime: 100, EndTime: 200},
+			err:  "vesting amount cannot be greater than total amount",
+		},
+		{
+			name: "no amount",
+			spec: genesisVestingSpec{StartTime: 100, EndTime: 200},
+			err:  "vesting amount must be positive",
+		},
+		{
+			name: "no times",
+			spec: genesisVestingSpec{Amount: "500unls"},
+			err:  "must supply start and end time, end time or periods",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			acc, err := tc.spec.build(baseAccount, coins)
+			if tc.err != "" {
+				require.ErrorContains(t, err, tc.err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, acc)
+		})
+	}
+}
+
+// initGenesis creates a genesis in a new home directory and returns the home directory along
+// with the context to execute the commands with.
+func initGenesis(t *testing.T, cdc codec.Codec) (string, context.Context) {
+	home := t.TempDir()
+	cfg, err := genutiltest.CreateDefaultTendermintConfig(home)
+	require.NoError(t, err)
+	require.NoError(t, genutiltest.ExecInitCmd(testMbm, home, cdc))
+
+	serverCtx := server.NewContext(viper.New(), cfg, log.NewNopLogger())
+	clientCtx := client.Context{}.WithCodec(cdc).WithHomeDir(home)
+
+	ctx := context.Background()
+	ctx = context.WithValue(ctx, client.ClientContextKey, &clientCtx)
+	ctx = context.WithValue(ctx, server.ServerContextKey, serverCtx)
+
+	return home, ctx
+}
+
+// updateBankGenesis applies the update to the bank genesis state of the genesis file.
+func updateBankGenesis(t *testing.T, cdc codec.Codec, genFile string, update func(*banktypes.GenesisState)) {
+	appState, genDoc, err := genutiltypes.GenesisStateFromGenFile(genFile)
+	require.NoError(t, err)
+
+	bankGenState := banktypes.GetGenesisStateFromAppState(cdc, appState)
+	update(bankGenState)
+	appState[banktypes.ModuleName] = cdc.MustMarshalJSON(bankGenState)
+
+	genDoc.AppState, err = json.Marshal(appState)
+	require.NoError(t, err)
+	require.NoError(t, genutil.ExportGenesisFile(genDoc, genFile))
+}
+
+func TestAddGenesisAccountsCmd(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig(auth.AppModuleBasic{}, vesting.AppModuleBasic{}, bank.AppModuleBasic{}).Codec
+	existing, addr1, addr2 := testAddress("existing"), testAddress("addr1"), testAddress("addr2")
+
+	tests := []struct {
+		name     string
+		accounts string
+		supply   sdk.Coins
+		err      string
+		// balances are the balances of the genesis after the command, by address
+		balances map[string]sdk.Coins
+	}{
+		{
+			name: "accounts added",
+			accounts: fmt.Sprintf(`[
+				{"address": %q, "amount": "100unls"},
+				{"address": %q, "amount": "300unls", "vesting": {"start-time": 100, "periods": [{"length": 10, "amount": "300unls"}]}}
+			]`, addr1, addr2),
+			balances: map[string]sdk.Coins{
+				existing: sdk.NewCoins(sdk.NewInt64Coin("unls", 10)),
+				addr1:    sdk.NewCoins(sdk.NewInt64Coin("unls", 100)),
+				addr2:    sdk.NewCoins(sdk.NewInt64Coin("unls", 300)),
+			},
+		},
+		{
+			name: "explicit supply updated",
+			accounts: fmt.Sprintf(`[
+				{"address": %q, "amount": "100unls"},
+				{"address": %q, "amount": "5uatom"}
+			]`, addr1, addr2),
+			supply: sdk.NewCoins(sdk.NewInt64Coin("unls", 10)),
+			balances: map[string]sdk.Coins{
+				existing: sdk.NewCoins(sdk.NewInt64Coin("unls", 10)),
+				addr1:    sdk.NewCoins(sdk.NewInt64Coin("unls", 100)),
+				addr2:    sdk.NewCoins(sdk.NewInt64Coin("uatom", 5)),
+			},
+		},
+		{
+			name: "duplicate address",
+			accounts: fmt.Sprintf(`[
+				{"address": %q, "amount": "100unls"},
+				{"address": %q, "amount": "200unls"}
+			]`, addr1, addr1),
+			err: fmt.Sprintf("entry 2: address %s is already listed by entry 1", addr1),
+		},
+		{
+			name: "existing address",
+			accounts: fmt.Sprintf(`[
+				{"address": %q, "amount": "100unls"},
+				{"address": %q, "amount": "200unls"}
+			]`, addr1, existing),
+			err: fmt.Sprintf("entry 2: cannot add account at existing address %s", existing),
+		},
+		{
+			name: "invalid entry",
+			accounts: fmt.Sprintf(`[
+				{"address": %q, "amount": "100unls", "vesting": {"amount": "200unls", "end-time": 100}}
+			]`, addr1),
+			err: "entry 1: vesting amount cannot be greater than total amount",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			home, ctx := initGenesis(t, cdc)
+			genFile := filepath.Join(home, "config", "genesis.json")
+			updateBankGenesis(t, cdc, genFile, func(bankGenState *banktypes.GenesisState) {
+				bankGenState.Balances = []banktypes.Balance{{Address: existing, Coins: sdk.NewCoins(sdk.NewInt64Coin("unls", 10))}}
+				bankGenState.Supply = tc.supply
+			})
+			before, err := os.ReadFile(genFile)
+			require.NoError(t, err)
+
+			file := filepath.Join(t.TempDir(), "accounts.json")
+			require.NoError(t, os.WriteFile(file, []byte(tc.accounts), 0o600))
+
+			cmd := AddGenesisAccountsCmd(home)
+			_, _ = testutil.ApplyMockIO(cmd)
+			cmd.SetArgs([]string{fmt.Sprintf("--%s=%s", flagAccountsFile, file)})
+			err = cmd.ExecuteContext(ctx)
+
+			if tc.err != "" {
+				require.ErrorContains(t, err, "no account added")
+				require.ErrorContains(t, err, tc.err)
+
+				// the genesis is left untouched
+				after, err := os.ReadFile(genFile)
+				require.NoError(t, err)
+				require.Equal(t, before, after)
+				return
+			}
+			require.NoError(t, err)
+
+			appState, _, err := genutiltypes.GenesisStateFromGenFile(genFile)
+			require.NoError(t, err)
+
+			bankGenState := banktypes.GetGenesisStateFromAppState(cdc, appState)
+			balances := make(map[string]sdk.Coins, len(bankGenState.Balances))
+			total := sdk.NewCoins()
+			for _, balance := range bankGenState.Balances {
+				balances[balance.Address] = balance.Coins
+				total = total.Add(balance.Coins...)
+			}
+			require.Equal(t, tc.balances, balances)
+
+			// an explicit supply accounts for the new balances, an empty one stays empty
+			if tc.supply.Empty() {
+				require.True(t, bankGenState.Supply.Empty())
+			} else {
+				require.Equal(t, total, bankGenState.Supply)
+			}
+
+			accs, err := authtypes.UnpackAccounts(authtypes.GetGenesisStateFromAppState(cdc, appState).Accounts)
+			require.NoError(t, err)
+			require.Len(t, accs, len(tc.balances)-1)
+		})
+	}
+}
@@ -0,0 +1,76 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	"github.com/spf13/cobra"
+
+	"github.com/Nolus-Protocol/nolus-core/app"
+)
+
+// GenesisCmd returns the nolus specific genesis commands.
+func GenesisCmd(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "genesis",
+		Short:                      "Nolus genesis file tooling",
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(ValidateNolusGenesisCmd(defaultNodeHome))
+
+	return cmd
+}
+
+// ValidateNolusGenesisCmd returns a command that performs the cross-module checks of a genesis file.
+func ValidateNolusGenesisCmd(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validate-nolus [file]",
+		Short: "Validate the cross-module consistency of a genesis file",
+		Long: `Check that the x/tax treasury, oracle and profit contracts are instantiated by the wasm
+genesis state, including its gen_msgs, that the accepted fee denoms are valid, that the
+x/mint minter conforms to the minting schedule and the chain denoms, and that every
+vesting account holds the coins it vests.
+
+The genesis file of the node home is validated when no file is given. Run validate-genesis
+for the per-module validation.`,
+		Args: cobra.RangeArgs(0, 1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			config := serverCtx.Config
+			homeDir, _ := cmd.Flags().GetString(flags.FlagHome)
+			config.SetRoot(homeDir)
+
+			genFile := config.GenesisFile()
+			if len(args) == 1 {
+				genFile = args[0]
+			}
+
+			appState, _, err := genutiltypes.GenesisStateFromGenFile(genFile)
+			if err != nil {
+				return fmt.Errorf("failed to read the genesis file %s: %w", genFile, err)
+			}
+
+			errs := app.ValidateNolusGenesis(clientCtx.Codec, appState)
+			if len(errs) == 0 {
+				cmd.Printf("File at %s is a valid nolus genesis file\n", genFile)
+				return nil
+			}
+
+			for _, err := range errs {
+				cmd.PrintErrln(err)
+			}
+
+			return fmt.Errorf("genesis file %s failed %d nolus check(s)", genFile, len(errs))
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+
+	return cmd
+}
@@ -206,7 +206,10 @@ func initRootCmd(
 		),
 		genutilcli.ValidateGenesisCmd(moduleBasics),
 		AddGenesisAccountCmd(defaultNodeHome),
+		AddGenesisAccountsCmd(defaultNodeHome),
 		AddGenesisWasmMsgCmd(defaultNodeHome),
+		GenesisCmd(defaultNodeHome),
+		UpgradeCmd(encodingConfig, defaultNodeHome),
 		tmcli.NewCompletionCmd(rootCmd, true),
 		debug.Cmd(),
 		config.Cmd(),
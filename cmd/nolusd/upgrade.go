@@ -0,0 +1,150 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+
+	dbm "github.com/cometbft/cometbft-db"
+	tmstore "github.com/cometbft/cometbft/store"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	"github.com/spf13/cobra"
+
+	"github.com/Nolus-Protocol/nolus-core/app"
+)
+
+// UpgradeCmd returns the upgrade related node commands.
+func UpgradeCmd(encodingConfig app.EncodingConfig, defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "upgrade",
+		Short: "Upgrade handler tooling",
+	}
+
+	cmd.AddCommand(UpgradeDryRunCmd(encodingConfig, defaultNodeHome))
+
+	return cmd
+}
+
+// UpgradeDryRunCmd returns a command that executes a registered upgrade handler against
+// the latest committed state of a stopped node without persisting any changes.
+func UpgradeDryRunCmd(encodingConfig app.EncodingConfig, defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "dry-run [name]",
+		Short: "Execute a registered upgrade handler against the node state and discard the changes",
+		Long: `Load the application at its latest height, apply the store upgrades and execute the
+handler of the named upgrade on a cached multistore, run all registered invariants and
+print a per-module summary of the state changes. Nothing is committed to the database.
+
+The node must be stopped while the command runs.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			name := args[0]
+			upgrade, found := app.GetUpgrade(name)
+			if !found {
+				return fmt.Errorf("unknown upgrade %q", name)
+			}
+
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			config := serverCtx.Config
+			homeDir, _ := cmd.Flags().GetString(flags.FlagHome)
+			config.SetRoot(homeDir)
+
+			db, err := dbm.NewDB("application", server.GetAppDBBackend(serverCtx.Viper), config.DBDir())
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			blockStoreDB, err := dbm.NewDB("blockstore", dbm.BackendType(config.DBBackend), config.DBDir())
+			if err != nil {
+				return err
+			}
+			defer blockStoreDB.Close()
+
+			nolusApp := app.New(
+				serverCtx.Logger,
+				db,
+				nil,
+				false,
+				map[int64]bool{},
+				homeDir,
+				0,
+				encodingConfig,
+				serverCtx.Viper,
+			)
+
+			// the store upgrades are applied when loading the version right before the upgrade height
+			latest := rootmulti.GetLatestVersion(db)
+			if latest == 0 {
+				return fmt.Errorf("no committed state found in %s", config.DBDir())
+			}
+			nolusApp.SetStoreLoader(upgradetypes.UpgradeStoreLoader(latest+1, &upgrade.StoreUpgrades))
+			if err := nolusApp.LoadLatestVersion(); err != nil {
+				return fmt.Errorf("failed to apply the store upgrades of %s: %w", name, err)
+			}
+
+			meta := tmstore.NewBlockStore(blockStoreDB).LoadBlockMeta(latest)
+			if meta == nil {
+				return fmt.Errorf("block %d not found in the block store", latest)
+			}
+			header := meta.Header.ToProto()
+			header.Height = latest + 1
+
+			res, err := nolusApp.DryRunUpgrade(name, *header)
+			if err != nil {
+				return err
+			}
+
+			printDryRunResult(cmd, res)
+			if len(res.BrokenInvariants) > 0 {
+				return fmt.Errorf("%d invariant(s) broken after upgrade %s", len(res.BrokenInvariants), name)
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+
+	return cmd
+}
+
+func printDryRunResult(cmd *cobra.Command, res *app.UpgradeDryRunResult) {
+	cmd.Printf("upgrade %s executed at height %d\n", res.Name, res.Height)
+
+	modules := make([]string, 0, len(res.ToVersions))
+	for module := range res.ToVersions {
+		modules = append(modules, module)
+	}
+	sort.Strings(modules)
+
+	cmd.Println("module versions:")
+	for _, module := range modules {
+		from, found := res.FromVersions[module]
+		to := res.ToVersions[module]
+		switch {
+		case !found:
+			cmd.Printf("  %s: new -> %d\n", module, to)
+		case from != to:
+			cmd.Printf("  %s: %d -> %d\n", module, from, to)
+		}
+	}
+
+	cmd.Println("store changes:")
+	for _, change := range res.StoreChanges {
+		cmd.Printf("  %s: %d writes, %d deletes\n", change.Store, change.Writes, change.Deletes)
+	}
+
+	if len(res.BrokenInvariants) == 0 {
+		cmd.Println("invariants: ok")
+	} else {
+		cmd.Println("broken invariants:")
+		for _, invariant := range res.BrokenInvariants {
+			cmd.Printf("  %s\n", invariant)
+		}
+	}
+
+	cmd.Println("all changes discarded")
+}
@@ -82,7 +82,7 @@ generate_genesis() {
 }
 
 #
-# Takes a json object and creates a genesis account
+# Takes a json array of account specifications and adds them as genesis accounts
 #
 # JSON specification object:
 # "address" - mandatory string
@@ -93,32 +93,16 @@ generate_genesis() {
 # "vesting.end-time" - mandatory string representing a datetime in ISO 8601 format with max precision in seconds,
 #                         for example "2022-01-30T15:15:59-06:00"
 # "vesting.amount" - mandatory number in native currency, e.g. 100 means "100 unls"
-add_genesis_account() {
+add_genesis_accounts() {
   local specification="$1"
   local currency="$2"
-  # TBD remove the following argument once the periodic vesting testing is deleted
   local home_dir="$3"
 
-  local address
-  address=$(echo "$specification" | jq -r '.address')
-  local amount
-  amount=$(echo "$specification" | jq -r '.amount')
-  if [[ "$(echo "$specification" | jq -r '.vesting')" != 'null' ]]; then
-    local vesting_start_time=""
-    if [[ "$(echo "$specification" | jq -r '.vesting."start-time"')" != 'null' ]]; then
-      vesting_start_time="--vesting-start-time $(__read_unix_time "$specification" start-time)"
-    fi
-
-    local vesting_end_time
-    vesting_end_time=$(echo "$specification" | jq -r '.vesting."end-time"' | __as_unix_time )
-    local vesting_amount
-    vesting_amount=$(echo "$specification" | jq -r '.vesting.amount')
-    run_cmd "$home_dir" add-genesis-account "$address" "$amount" \
-                --vesting-amount "$vesting_amount$currency" \
-                --vesting-end-time "$vesting_end_time" "$vesting_start_time"
-  else
-    run_cmd "$home_dir" add-genesis-account "$address" "$amount"
-  fi
+  local -r accounts_file="$home_dir/genesis-accounts.json"
+  echo "$specification" | jq --arg currency "$currency" \
+    'map(if .vesting then .vesting.amount = (.vesting.amount | tostring) + $currency else . end)' > "$accounts_file"
+  run_cmd "$home_dir" add-genesis-accounts --file "$accounts_file"
+  rm "$accounts_file"
 }
 
 #####################
@@ -151,9 +135,7 @@ __generate_proto_genesis_no_wasm() {
   __modify_slashing_and_staking_params "$genesis_file" "$staking_max_validators"
   __modify_neutron_modules_params "$genesis_file" "$feerefunder_ack_fee_min" "$feerefunder_timeout_fee_min" "$native_currency"
 
-  while IFS= read -r account_spec ; do
-    add_genesis_account "$account_spec" "$native_currency" "$genesis_home_dir"
-  done <<< "$(echo "$accounts_spec" | jq -c '.[]')"
+  add_genesis_accounts "$accounts_spec" "$native_currency" "$genesis_home_dir"
 
   __add_bank_balances "$genesis_file" "$admin_contract_addr" "$treasury_init_tokens" "$native_currency"
 }
@@ -24,11 +24,13 @@ import (
 func TaxKeeper(t testing.TB, isCheckTx bool, gasPrices sdk.DecCoins) (*keeper.Keeper, sdk.Context, *mock_types.MockWasmKeeper) {
 	storeKey := sdk.NewKVStoreKey(types.StoreKey)
 	memStoreKey := storetypes.NewMemoryStoreKey(types.MemStoreKey)
+	tStoreKey := storetypes.NewTransientStoreKey(types.TStoreKey)
 
 	db := tmdb.NewMemDB()
 	stateStore := store.NewCommitMultiStore(db)
 	stateStore.MountStoreWithDB(storeKey, sdktypes.StoreTypeIAVL, db)
 	stateStore.MountStoreWithDB(memStoreKey, sdktypes.StoreTypeMemory, nil)
+	stateStore.MountStoreWithDB(tStoreKey, sdktypes.StoreTypeTransient, nil)
 	require.NoError(t, stateStore.LoadLatestVersion())
 
 	registry := codectypes.NewInterfaceRegistry()
@@ -41,6 +43,7 @@ func TaxKeeper(t testing.TB, isCheckTx bool, gasPrices sdk.DecCoins) (*keeper.Ke
 		cdc,
 		storeKey,
 		memStoreKey,
+		tStoreKey,
 		mockWasmKeeper,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
@@ -1,6 +1,8 @@
 package simapp
 
 import (
+	stdjson "encoding/json"
+	"os"
 	"testing"
 	"time"
 
@@ -26,11 +28,14 @@ import (
 	"github.com/cosmos/cosmos-sdk/testutil/network"
 	"github.com/cosmos/cosmos-sdk/testutil/sims"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	ibctesting "github.com/cosmos/ibc-go/v7/testing"
 
 	"github.com/Nolus-Protocol/nolus-core/app"
+	minttypes "github.com/Nolus-Protocol/nolus-core/x/mint/types"
 )
 
 // New creates application instance with in-memory database and disabled logging.
@@ -181,6 +186,46 @@ func TestSetup(t *testing.T) (*app.App, error) {
 	return nolusApp, nil
 }
 
+// SetupTestingApp creates an application for the chains of the ibc-go testing package.
+// It is set as ibctesting.DefaultTestingAppInit by the tests relaying packets between
+// in-process chains.
+func SetupTestingApp() (ibctesting.TestingApp, map[string]stdjson.RawMessage) {
+	homePath, err := os.MkdirTemp("", "nolus-ibctesting")
+	if err != nil {
+		panic(err)
+	}
+
+	encoding := app.MakeEncodingConfig(app.ModuleBasics)
+	a := app.New(log.NewNopLogger(), tmdb.NewMemDB(), nil, false, map[int64]bool{}, homePath, 0, encoding,
+		sims.EmptyAppOptions{})
+
+	// ibctesting signs the transactions with a zero fee, which the tax decorator rejects,
+	// thus the testing chains use the default ante handler of the sdk
+	anteHandler, err := ante.NewAnteHandler(ante.HandlerOptions{
+		AccountKeeper:   a.AccountKeeper,
+		BankKeeper:      a.BankKeeper,
+		FeegrantKeeper:  a.FeegrantKeeper,
+		SignModeHandler: encoding.TxConfig.SignModeHandler(),
+		SigGasConsumer:  ante.DefaultSigVerificationGasConsumer,
+	})
+	if err != nil {
+		panic(err)
+	}
+	a.SetAnteHandler(anteHandler)
+	if err := a.LoadLatestVersion(); err != nil {
+		panic(err)
+	}
+
+	// ibctesting begins the first block with a zero time, which the minter rejects,
+	// thus the minting is disabled by starting the testing chains at the minting cap
+	genesisState := NewDefaultGenesisState(encoding.Marshaler)
+	mintGenesis := minttypes.DefaultGenesisState()
+	mintGenesis.Minter.TotalMinted = minttypes.MintingCap
+	genesisState[minttypes.ModuleName] = encoding.Marshaler.MustMarshalJSON(mintGenesis)
+
+	return a, genesisState
+}
+
 // NewDefaultGenesisState generates the default state for the application.
 func NewDefaultGenesisState(cdc codec.JSONCodec) app.GenesisState {
 	return app.ModuleBasics.DefaultGenesis(cdc)
@@ -2,7 +2,9 @@
 package bindings
 
 import (
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	paramChange "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
 
 	feetypes "github.com/neutron-org/neutron/x/feerefunder/types"
@@ -30,6 +32,28 @@ type NeutronMsg struct {
 	// Contractmanager types
 	/// A contract that has failed acknowledgement can resubmit it
 	ResubmitFailure *ResubmitFailure `json:"resubmit_failure,omitempty"`
+
+	// Governance types
+	/// A contract can submit a governance proposal with itself as the proposer
+	SubmitAdminProposal *SubmitAdminProposal `json:"submit_admin_proposal,omitempty"`
+
+	// Vestings types
+	/// A contract can fund vesting accounts
+	CreateVestingAccount *CreateVestingAccount `json:"create_vesting_account,omitempty"`
+
+	// Cron types
+	/// A contract set as the cron security address can manage the schedules
+	AddSchedule    *AddSchedule    `json:"add_schedule,omitempty"`
+	RemoveSchedule *RemoveSchedule `json:"remove_schedule,omitempty"`
+
+	// Token factory types
+	/// A contract can create denoms and manage the denoms it is the admin of
+	CreateDenom       *CreateDenom       `json:"create_denom,omitempty"`
+	ChangeAdmin       *ChangeAdmin       `json:"change_admin,omitempty"`
+	MintTokens        *MintTokens        `json:"mint_tokens,omitempty"`
+	BurnTokens        *BurnTokens        `json:"burn_tokens,omitempty"`
+	SetBeforeSendHook *SetBeforeSendHook `json:"set_before_send_hook,omitempty"`
+	SetDenomMetadata  *SetDenomMetadata  `json:"set_denom_metadata,omitempty"`
 }
 
 // SubmitTx submits interchain transaction on a remote chain.
@@ -69,6 +93,144 @@ type RegisterInterchainQuery struct {
 	UpdatePeriod       uint64            `json:"update_period"`
 }
 
+// CreateVestingAccount creates a vesting account funded by the contract,
+// or adds the schedule to an existing one if merge is set. The contract may
+// only merge into its own account.
+type CreateVestingAccount struct {
+	ToAddress string    `json:"to_address"`
+	Amount    sdk.Coins `json:"amount"`
+	StartTime int64     `json:"start_time"`
+	EndTime   int64     `json:"end_time"`
+	Delayed   bool      `json:"delayed,omitempty"`
+	Merge     bool      `json:"merge,omitempty"`
+}
+
+// CreateVestingAccountResponse holds response from CreateVestingAccount.
+type CreateVestingAccountResponse struct {
+	// Address is the address of the vesting account
+	Address string `json:"address"`
+	// Vested is the amount of vested coins of the account
+	Vested sdk.Coins `json:"vested"`
+	// Unvested is the amount of coins still vesting on the account
+	Unvested sdk.Coins `json:"unvested"`
+}
+
+// AddSchedule adds a cron schedule executing a contract every period blocks.
+// Sudo schedules are added by governance only, so a contract may not set sudo.
+type AddSchedule struct {
+	Name     string `json:"name"`
+	Period   uint64 `json:"period"`
+	Contract string `json:"contract"`
+	// Msg is the JSON encoded message passed to the contract
+	Msg      string `json:"msg"`
+	Sudo     bool   `json:"sudo,omitempty"`
+	GasLimit uint64 `json:"gas_limit"`
+	// ExecutionStage is EXECUTION_STAGE_BEGIN_BLOCKER or EXECUTION_STAGE_END_BLOCKER,
+	// the end blocker if empty
+	ExecutionStage string `json:"execution_stage,omitempty"`
+}
+
+// AddScheduleResponse holds response from AddSchedule.
+type AddScheduleResponse struct{}
+
+// RemoveSchedule removes a cron schedule.
+type RemoveSchedule struct {
+	Name string `json:"name"`
+}
+
+// RemoveScheduleResponse holds response from RemoveSchedule.
+type RemoveScheduleResponse struct{}
+
+// CreateDenom creates the denom factory/{contract}/{subdenom} with the contract as its admin.
+// The contract pays the denom creation fee.
+type CreateDenom struct {
+	Subdenom string `json:"subdenom"`
+}
+
+// CreateDenomResponse holds response from CreateDenom.
+type CreateDenomResponse struct {
+	// Denom is the full name of the created denom
+	Denom string `json:"denom"`
+}
+
+// ChangeAdmin hands the admin of a denom over to the new admin, or renounces it if empty.
+type ChangeAdmin struct {
+	Denom           string `json:"denom"`
+	NewAdminAddress string `json:"new_admin_address"`
+}
+
+// ChangeAdminResponse holds response from ChangeAdmin.
+type ChangeAdminResponse struct{}
+
+// MintTokens mints tokens of a denom the contract is the admin of.
+type MintTokens struct {
+	Denom  string      `json:"denom"`
+	Amount sdkmath.Int `json:"amount"`
+	// MintToAddress receives the minted tokens, the contract if empty
+	MintToAddress string `json:"mint_to_address,omitempty"`
+}
+
+// MintTokensResponse holds response from MintTokens.
+type MintTokensResponse struct{}
+
+// BurnTokens burns tokens of the contract of a denom it is the admin of.
+type BurnTokens struct {
+	Denom  string      `json:"denom"`
+	Amount sdkmath.Int `json:"amount"`
+}
+
+// BurnTokensResponse holds response from BurnTokens.
+type BurnTokensResponse struct{}
+
+// SetBeforeSendHook sets the contract called before a denom the contract is the admin of is
+// sent, or removes the hook if empty.
+type SetBeforeSendHook struct {
+	Denom        string `json:"denom"`
+	ContractAddr string `json:"contract_addr"`
+}
+
+// SetBeforeSendHookResponse holds response from SetBeforeSendHook.
+type SetBeforeSendHookResponse struct{}
+
+// SetDenomMetadata sets the bank metadata of the denom the contract is the admin of, named by
+// the base of the metadata.
+type SetDenomMetadata struct {
+	banktypes.Metadata
+}
+
+// SetDenomMetadataResponse holds response from SetDenomMetadata.
+type SetDenomMetadataResponse struct{}
+
+// SubmitAdminProposal submits a governance proposal with the contract as the proposer.
+type SubmitAdminProposal struct {
+	AdminProposal AdminProposal `json:"admin_proposal"`
+	// InitialDeposit is deposited for the proposal from the contract balance
+	InitialDeposit sdk.Coins `json:"initial_deposit,omitempty"`
+	Metadata       string    `json:"metadata,omitempty"`
+}
+
+// SubmitAdminProposalResponse holds response from SubmitAdminProposal.
+type SubmitAdminProposalResponse struct {
+	ProposalId uint64 `json:"proposal_id"`
+}
+
+// AdminProposal holds exactly one of the proposals a contract can submit.
+type AdminProposal struct {
+	ParamChangeProposal           *ParamChangeProposal           `json:"param_change_proposal,omitempty"`
+	SoftwareUpgradeProposal       *SoftwareUpgradeProposal       `json:"software_upgrade_proposal,omitempty"`
+	CancelSoftwareUpgradeProposal *CancelSoftwareUpgradeProposal `json:"cancel_software_upgrade_proposal,omitempty"`
+	ProposalExecuteMessage        *ProposalExecuteMessage        `json:"proposal_execute_message,omitempty"`
+}
+
+// ProposalExecuteMessage executes a message which requires the governance authority,
+// like MsgUpdateParams of the tax and mint modules.
+type ProposalExecuteMessage struct {
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	// Message is the JSON encoded message, including its "@type"
+	Message string `json:"message"`
+}
+
 type ParamChangeProposal struct {
 	Title        string                    `json:"title"`
 	Description  string                    `json:"description"`
@@ -0,0 +1,150 @@
+package bindings
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+)
+
+//go:generate go run ./schema/gen -src nolus_query.go -out schema
+
+// NolusQuerySchemaVersion is the version of the JSON schemas of the nolus custom queries.
+// The schemas are generated from this file into schema/<version> by go generate. A change
+// which breaks the contracts decoding the responses has to bump the version.
+const NolusQuerySchemaVersion = "v1"
+
+// NolusQuery contains nolus custom queries, sent by contracts as `QueryRequest::Custom`.
+type NolusQuery struct {
+	// State of the mint module minter
+	MintState *QueryMintStateRequest `json:"mint_state,omitempty"`
+	// Parameters of the tax module
+	TaxParams *QueryTaxParamsRequest `json:"tax_params,omitempty"`
+	// Tax deducted from a transaction fee and the address receiving it
+	FeeEstimate *QueryFeeEstimateRequest `json:"fee_estimate,omitempty"`
+	// Full name of a token factory denom
+	FullDenom *QueryFullDenomRequest `json:"full_denom,omitempty"`
+	// Admin of a token factory denom
+	DenomAdmin *QueryDenomAdminRequest `json:"denom_admin,omitempty"`
+	// Token factory denoms created by an address
+	DenomsFromCreator *QueryDenomsFromCreatorRequest `json:"denoms_from_creator,omitempty"`
+	// Contract called before a token factory denom is sent
+	BeforeSendHook *QueryBeforeSendHookRequest `json:"before_send_hook,omitempty"`
+	// Fee paid to create a token factory denom
+	DenomCreationFee *QueryDenomCreationFeeRequest `json:"denom_creation_fee,omitempty"`
+}
+
+// IsEmpty returns true if none of the nolus queries is set.
+func (q NolusQuery) IsEmpty() bool {
+	return q.MintState == nil && q.TaxParams == nil && q.FeeEstimate == nil &&
+		q.FullDenom == nil && q.DenomAdmin == nil && q.DenomsFromCreator == nil &&
+		q.BeforeSendHook == nil && q.DenomCreationFee == nil
+}
+
+/* Requests */
+
+type QueryMintStateRequest struct{}
+
+type QueryTaxParamsRequest struct{}
+
+type QueryFeeEstimateRequest struct {
+	// Fee paid by the transaction, in a single denom
+	Fee sdktypes.Coin `json:"fee"`
+}
+
+type QueryFullDenomRequest struct {
+	// Address of the creator of the denom
+	CreatorAddr string `json:"creator_addr"`
+	// Subdenom given by the creator
+	Subdenom string `json:"subdenom"`
+}
+
+type QueryDenomAdminRequest struct {
+	// Full name of the denom
+	Denom string `json:"denom"`
+}
+
+type QueryDenomsFromCreatorRequest struct {
+	// Address of the creator of the denoms
+	Creator string `json:"creator"`
+}
+
+type QueryBeforeSendHookRequest struct {
+	// Full name of the denom
+	Denom string `json:"denom"`
+}
+
+type QueryDenomCreationFeeRequest struct{}
+
+/* Responses */
+
+type QueryMintStateResponse struct {
+	// Total amount of minted tokens
+	TotalMinted sdkmath.Uint `json:"total_minted"`
+	// Tokens minted in the current year
+	AnnualInflation sdkmath.Uint `json:"annual_inflation"`
+	// Normalized time passed since the start of minting, in months
+	NormTimePassed sdkmath.LegacyDec `json:"norm_time_passed"`
+	// Whether the minting is paused by governance
+	Paused bool `json:"paused"`
+}
+
+type QueryTaxParamsResponse struct {
+	// Percentage of the transaction fees deducted as tax
+	FeeRate int32 `json:"fee_rate"`
+	// Share of the transaction fees deducted as tax
+	TaxRate sdkmath.LegacyDec `json:"tax_rate"`
+	// Denom of the fees whose tax goes to the treasury
+	BaseDenom string `json:"base_denom"`
+	// Address of the treasury contract receiving the tax of the fees paid in the base denom
+	TreasuryAddress string `json:"treasury_address"`
+	// Parameters of the fees paid in other denoms
+	FeeParams []FeeParam `json:"fee_params"`
+	// Share of the tax of the fees paid in the base denom which is burned
+	BurnRatio sdkmath.LegacyDec `json:"burn_ratio"`
+}
+
+type FeeParam struct {
+	OracleAddress  string        `json:"oracle_address"`
+	ProfitAddress  string        `json:"profit_address"`
+	AcceptedDenoms []DenomTicker `json:"accepted_denoms"`
+}
+
+type DenomTicker struct {
+	Denom  string `json:"denom"`
+	Ticker string `json:"ticker"`
+}
+
+type QueryFeeEstimateResponse struct {
+	// Tax deducted from the fee
+	Tax sdktypes.Coin `json:"tax"`
+	// Share of the tax which is burned instead of sent to the recipient
+	Burned sdktypes.Coin `json:"burned"`
+	// Address receiving the tax less the burned share, the treasury or the profit contract of the fee denom
+	Recipient string `json:"recipient"`
+	// Fee remaining for the validators after the tax is deducted
+	FeeAfterTax sdktypes.Coin `json:"fee_after_tax"`
+}
+
+type QueryFullDenomResponse struct {
+	// Full name of the denom, factory/{creator_addr}/{subdenom}
+	Denom string `json:"denom"`
+}
+
+type QueryDenomAdminResponse struct {
+	// Address of the admin of the denom, empty if the admin is renounced
+	Admin string `json:"admin"`
+}
+
+type QueryDenomsFromCreatorResponse struct {
+	// Full names of the denoms
+	Denoms []string `json:"denoms"`
+}
+
+type QueryBeforeSendHookResponse struct {
+	// Address of the contract, empty if the denom has no before send hook
+	ContractAddr string `json:"contract_addr"`
+}
+
+type QueryDenomCreationFeeResponse struct {
+	// Fee paid to the treasury to create a denom
+	Fee []sdktypes.Coin `json:"fee"`
+}
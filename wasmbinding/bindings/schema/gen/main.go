@@ -0,0 +1,408 @@
+// Command gen generates the JSON schemas of the nolus custom queries and their responses
+// from the Go bindings, using the doc comments of the types and fields as descriptions.
+//
+// It is run by go generate in the bindings package, which writes the schemas into
+// schema/<NolusQuerySchemaVersion>.
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/Nolus-Protocol/nolus-core/wasmbinding/bindings"
+)
+
+const (
+	draft07 = "http://json-schema.org/draft-07/schema#"
+
+	queryTypeName = "NolusQuery"
+)
+
+// scalars are the definitions of the external types, encoded by contracts as strings or objects.
+var scalars = map[string]struct {
+	name       string
+	definition func() *schema
+}{
+	"sdkmath.Uint": {"Uint128", func() *schema {
+		return &schema{Description: "An unsigned integer encoded as a string.", Type: "string"}
+	}},
+	"sdkmath.Int": {"Int128", func() *schema {
+		return &schema{Description: "A signed integer encoded as a string.", Type: "string"}
+	}},
+	"sdkmath.LegacyDec": {"Decimal", func() *schema {
+		return &schema{Description: "A decimal with 18 fractional digits encoded as a string.", Type: "string"}
+	}},
+	"sdktypes.Coin": {"Coin", func() *schema {
+		return &schema{
+			Type:     "object",
+			Required: []string{"amount", "denom"},
+			Properties: &properties{
+				names: []string{"amount", "denom"},
+				values: map[string]*schema{
+					"amount": {Ref: ref("Uint128")},
+					"denom":  {Type: "string"},
+				},
+			},
+		}
+	}},
+}
+
+// dependencies are the definitions the definition of a scalar refers to.
+var dependencies = map[string][]string{
+	"Coin": {"sdkmath.Uint"},
+}
+
+var responseName = regexp.MustCompile(`^Query(\w+)Response$`)
+
+type schema struct {
+	Schema               string             `json:"$schema,omitempty"`
+	Title                string             `json:"title,omitempty"`
+	Description          string             `json:"description,omitempty"`
+	Ref                  string             `json:"$ref,omitempty"`
+	Type                 string             `json:"type,omitempty"`
+	Format               string             `json:"format,omitempty"`
+	Required             []string           `json:"required,omitempty"`
+	Properties           *properties        `json:"properties,omitempty"`
+	Items                *schema            `json:"items,omitempty"`
+	AdditionalProperties *bool              `json:"additionalProperties,omitempty"`
+	OneOf                []*schema          `json:"oneOf,omitempty"`
+	Definitions          map[string]*schema `json:"definitions,omitempty"`
+}
+
+// properties keeps the properties of an object in the order of the struct fields.
+type properties struct {
+	names  []string
+	values map[string]*schema
+}
+
+func (p *properties) add(name string, value *schema) {
+	if p.values == nil {
+		p.values = make(map[string]*schema)
+	}
+	p.names = append(p.names, name)
+	p.values[name] = value
+}
+
+func (p properties) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, name := range p.names {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		key, err := json.Marshal(name)
+		if err != nil {
+			return nil, err
+		}
+		value, err := json.Marshal(p.values[name])
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(key)
+		buf.WriteByte(':')
+		buf.Write(value)
+	}
+	buf.WriteByte('}')
+
+	return buf.Bytes(), nil
+}
+
+type typeDecl struct {
+	doc    string
+	fields *ast.StructType
+}
+
+// generator builds the schemas of the struct types declared in a file.
+type generator struct {
+	types map[string]typeDecl
+	order []string
+}
+
+func newGenerator(src string) (*generator, error) {
+	file, err := parser.ParseFile(token.NewFileSet(), src, nil, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+
+	g := &generator{types: make(map[string]typeDecl)}
+	for _, decl := range file.Decls {
+		genDecl, ok := decl.(*ast.GenDecl)
+		if !ok || genDecl.Tok != token.TYPE {
+			continue
+		}
+
+		for _, spec := range genDecl.Specs {
+			typeSpec := spec.(*ast.TypeSpec)
+			structType, ok := typeSpec.Type.(*ast.StructType)
+			if !ok {
+				continue
+			}
+
+			doc := typeSpec.Doc
+			if doc == nil && len(genDecl.Specs) == 1 {
+				doc = genDecl.Doc
+			}
+			g.types[typeSpec.Name.Name] = typeDecl{doc: description(doc), fields: structType}
+			g.order = append(g.order, typeSpec.Name.Name)
+		}
+	}
+
+	return g, nil
+}
+
+// schemas returns the schemas of the query and of every response by file name.
+func (g *generator) schemas() (map[string]*schema, error) {
+	schemas := make(map[string]*schema)
+
+	query, err := g.querySchema()
+	if err != nil {
+		return nil, err
+	}
+	schemas[fileName(queryTypeName)] = query
+
+	for _, name := range g.order {
+		if !responseName.MatchString(name) {
+			continue
+		}
+
+		definitions := make(map[string]*schema)
+		response, err := g.objectSchema(g.types[name].fields, definitions)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+		response.Schema = draft07
+		response.Title = name
+		response.Description = g.types[name].doc
+		if len(definitions) != 0 {
+			response.Definitions = definitions
+		}
+
+		schemas[fileName(responseName.FindStringSubmatch(name)[1]+"Response")] = response
+	}
+
+	return schemas, nil
+}
+
+// querySchema returns the schema of the query, one of its fields set to the request.
+func (g *generator) querySchema() (*schema, error) {
+	query, found := g.types[queryTypeName]
+	if !found {
+		return nil, fmt.Errorf("type %s not found", queryTypeName)
+	}
+
+	definitions := make(map[string]*schema)
+	result := &schema{
+		Schema:      draft07,
+		Title:       queryTypeName,
+		Description: query.doc,
+	}
+
+	for _, field := range query.fields.Fields.List {
+		name, _ := jsonName(field)
+		request, ok := field.Type.(*ast.StarExpr)
+		if !ok {
+			return nil, fmt.Errorf("%s: query %s is not a pointer", queryTypeName, name)
+		}
+		requestType, ok := request.X.(*ast.Ident)
+		if !ok || g.types[requestType.Name].fields == nil {
+			return nil, fmt.Errorf("%s: request of query %s is not a struct", queryTypeName, name)
+		}
+
+		requestSchema, err := g.objectSchema(g.types[requestType.Name].fields, definitions)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", requestType.Name, err)
+		}
+		requestSchema.AdditionalProperties = new(bool)
+
+		variant := &schema{
+			Description:          description(field.Doc),
+			Type:                 "object",
+			Required:             []string{name},
+			Properties:           &properties{},
+			AdditionalProperties: new(bool),
+		}
+		variant.Properties.add(name, requestSchema)
+		result.OneOf = append(result.OneOf, variant)
+	}
+
+	if len(definitions) != 0 {
+		result.Definitions = definitions
+	}
+
+	return result, nil
+}
+
+// objectSchema returns the schema of a struct, adding the types it refers to to the definitions.
+func (g *generator) objectSchema(structType *ast.StructType, definitions map[string]*schema) (*schema, error) {
+	result := &schema{Type: "object"}
+
+	for _, field := range structType.Fields.List {
+		name, required := jsonName(field)
+		fieldSchema, err := g.fieldSchema(field.Type, definitions)
+		if err != nil {
+			return nil, fmt.Errorf("field %s: %w", name, err)
+		}
+		fieldSchema.Description = description(field.Doc)
+
+		if result.Properties == nil {
+			result.Properties = &properties{}
+		}
+		result.Properties.add(name, fieldSchema)
+		if required {
+			result.Required = append(result.Required, name)
+		}
+	}
+	sort.Strings(result.Required)
+
+	return result, nil
+}
+
+func (g *generator) fieldSchema(expr ast.Expr, definitions map[string]*schema) (*schema, error) {
+	switch expr := expr.(type) {
+	case *ast.Ident:
+		switch expr.Name {
+		case "string":
+			return &schema{Type: "string"}, nil
+		case "bool":
+			return &schema{Type: "boolean"}, nil
+		case "int32", "int64", "uint32", "uint64":
+			return &schema{Type: "integer", Format: expr.Name}, nil
+		}
+
+		decl, found := g.types[expr.Name]
+		if !found {
+			return nil, fmt.Errorf("unsupported type %s", expr.Name)
+		}
+		if _, defined := definitions[expr.Name]; !defined {
+			// reserve the name before descending, in case the type refers to itself
+			definitions[expr.Name] = nil
+			definition, err := g.objectSchema(decl.fields, definitions)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", expr.Name, err)
+			}
+			definition.Description = decl.doc
+			definitions[expr.Name] = definition
+		}
+
+		return &schema{Ref: ref(expr.Name)}, nil
+	case *ast.SelectorExpr:
+		qualified := fmt.Sprintf("%s.%s", expr.X, expr.Sel.Name)
+		if _, found := scalars[qualified]; !found {
+			return nil, fmt.Errorf("unsupported type %s", qualified)
+		}
+
+		addScalar(qualified, definitions)
+		return &schema{Ref: ref(scalars[qualified].name)}, nil
+	case *ast.ArrayType:
+		items, err := g.fieldSchema(expr.Elt, definitions)
+		if err != nil {
+			return nil, err
+		}
+
+		return &schema{Type: "array", Items: items}, nil
+	default:
+		return nil, fmt.Errorf("unsupported type %T", expr)
+	}
+}
+
+func addScalar(qualified string, definitions map[string]*schema) {
+	scalar := scalars[qualified]
+	definitions[scalar.name] = scalar.definition()
+	for _, dependency := range dependencies[scalar.name] {
+		addScalar(dependency, definitions)
+	}
+}
+
+func ref(name string) string {
+	return "#/definitions/" + name
+}
+
+// jsonName returns the JSON name of a field and whether the field is required.
+func jsonName(field *ast.Field) (string, bool) {
+	tag := reflect.StructTag(strings.Trim(field.Tag.Value, "`")).Get("json")
+	name, options, _ := strings.Cut(tag, ",")
+
+	return name, options != "omitempty"
+}
+
+// description turns a doc comment into a sentence.
+func description(doc *ast.CommentGroup) string {
+	text := strings.Join(strings.Fields(doc.Text()), " ")
+	if text != "" && !strings.HasSuffix(text, ".") {
+		text += "."
+	}
+
+	return text
+}
+
+// fileName returns the snake case JSON file name of a type.
+func fileName(typeName string) string {
+	var name strings.Builder
+	for i, r := range typeName {
+		if i > 0 && r >= 'A' && r <= 'Z' {
+			name.WriteByte('_')
+		}
+		name.WriteString(strings.ToLower(string(r)))
+	}
+
+	return name.String() + ".json"
+}
+
+// generate returns the content of the schema files by file name.
+func generate(src string) (map[string][]byte, error) {
+	g, err := newGenerator(src)
+	if err != nil {
+		return nil, err
+	}
+
+	schemas, err := g.schemas()
+	if err != nil {
+		return nil, err
+	}
+
+	files := make(map[string][]byte, len(schemas))
+	for name, s := range schemas {
+		bz, err := json.MarshalIndent(s, "", "  ")
+		if err != nil {
+			return nil, err
+		}
+		files[name] = append(bz, '\n')
+	}
+
+	return files, nil
+}
+
+func main() {
+	src := flag.String("src", "nolus_query.go", "Go file declaring the query and the responses")
+	out := flag.String("out", "schema", "directory the versioned schemas are written to")
+	flag.Parse()
+
+	files, err := generate(*src)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	dir := filepath.Join(*out, bindings.NolusQuerySchemaVersion)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	for name, bz := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), bz, 0o600); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+	}
+}
@@ -0,0 +1,34 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Nolus-Protocol/nolus-core/wasmbinding/bindings"
+)
+
+// TestSchemasUpToDate tests that the committed schemas are the ones generated from the bindings.
+// Run go generate in wasmbinding/bindings after changing the nolus queries.
+func TestSchemasUpToDate(t *testing.T) {
+	files, err := generate(filepath.Join("..", "..", "nolus_query.go"))
+	require.NoError(t, err)
+
+	dir := filepath.Join("..", bindings.NolusQuerySchemaVersion)
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	require.Len(t, entries, len(files))
+
+	for name, expected := range files {
+		actual, err := os.ReadFile(filepath.Join(dir, name))
+		require.NoError(t, err, name)
+		require.Equal(t, string(expected), string(actual), name)
+	}
+}
+
+func TestFileName(t *testing.T) {
+	require.Equal(t, "nolus_query.json", fileName("NolusQuery"))
+	require.Equal(t, "mint_state_response.json", fileName("MintStateResponse"))
+}
@@ -13,6 +13,14 @@ import (
 // CustomQuerier returns a function that is an implementation of custom querier mechanism for specific messages.
 func CustomQuerier(qp *QueryPlugin) func(ctx sdk.Context, request json.RawMessage) ([]byte, error) {
 	return func(ctx sdk.Context, request json.RawMessage) ([]byte, error) {
+		var nolusQuery bindings.NolusQuery
+		if err := json.Unmarshal(request, &nolusQuery); err != nil {
+			return nil, errors.Wrapf(err, "failed to unmarshal nolus query: %v", err)
+		}
+		if !nolusQuery.IsEmpty() {
+			return nolusQuerier(qp, ctx, nolusQuery)
+		}
+
 		var contractQuery bindings.NeutronQuery
 		if err := json.Unmarshal(request, &contractQuery); err != nil {
 			return nil, errors.Wrapf(err, "failed to unmarshal neutron query: %v", err)
@@ -100,3 +108,107 @@ func CustomQuerier(qp *QueryPlugin) func(ctx sdk.Context, request json.RawMessag
 		}
 	}
 }
+
+// nolusQuerier handles the custom queries of the nolus modules.
+func nolusQuerier(qp *QueryPlugin, ctx sdk.Context, contractQuery bindings.NolusQuery) ([]byte, error) {
+	switch {
+	case contractQuery.MintState != nil:
+		mintState, err := qp.GetMintState(ctx, contractQuery.MintState)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get mint state: %v", err)
+		}
+
+		bz, err := json.Marshal(mintState)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to marshal mint state response: %v", err)
+		}
+
+		return bz, nil
+	case contractQuery.TaxParams != nil:
+		taxParams, err := qp.GetTaxParams(ctx, contractQuery.TaxParams)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get tax params: %v", err)
+		}
+
+		bz, err := json.Marshal(taxParams)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to marshal tax params response: %v", err)
+		}
+
+		return bz, nil
+	case contractQuery.FeeEstimate != nil:
+		feeEstimate, err := qp.GetFeeEstimate(ctx, contractQuery.FeeEstimate)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get fee estimate: %v", err)
+		}
+
+		bz, err := json.Marshal(feeEstimate)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to marshal fee estimate response: %v", err)
+		}
+
+		return bz, nil
+	case contractQuery.FullDenom != nil:
+		fullDenom, err := qp.GetFullDenom(ctx, contractQuery.FullDenom)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get full denom: %v", err)
+		}
+
+		bz, err := json.Marshal(fullDenom)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to marshal full denom response: %v", err)
+		}
+
+		return bz, nil
+	case contractQuery.DenomAdmin != nil:
+		denomAdmin, err := qp.GetDenomAdmin(ctx, contractQuery.DenomAdmin)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get denom admin: %v", err)
+		}
+
+		bz, err := json.Marshal(denomAdmin)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to marshal denom admin response: %v", err)
+		}
+
+		return bz, nil
+	case contractQuery.DenomsFromCreator != nil:
+		denomsFromCreator, err := qp.GetDenomsFromCreator(ctx, contractQuery.DenomsFromCreator)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get denoms from creator: %v", err)
+		}
+
+		bz, err := json.Marshal(denomsFromCreator)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to marshal denoms from creator response: %v", err)
+		}
+
+		return bz, nil
+	case contractQuery.BeforeSendHook != nil:
+		beforeSendHook, err := qp.GetBeforeSendHook(ctx, contractQuery.BeforeSendHook)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get before send hook: %v", err)
+		}
+
+		bz, err := json.Marshal(beforeSendHook)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to marshal before send hook response: %v", err)
+		}
+
+		return bz, nil
+	case contractQuery.DenomCreationFee != nil:
+		denomCreationFee, err := qp.GetDenomCreationFee(ctx, contractQuery.DenomCreationFee)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get denom creation fee: %v", err)
+		}
+
+		bz, err := json.Marshal(denomCreationFee)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to marshal denom creation fee response: %v", err)
+		}
+
+		return bz, nil
+	default:
+		return nil, wasmvmtypes.UnsupportedRequest{Kind: "unknown nolus query type"}
+	}
+}
@@ -6,11 +6,25 @@ import (
 	"cosmossdk.io/errors"
 	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
 	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govkeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	paramproposal "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 
 	"github.com/Nolus-Protocol/nolus-core/wasmbinding/bindings"
+	cronkeeper "github.com/Nolus-Protocol/nolus-core/x/cron/keeper"
+	crontypes "github.com/Nolus-Protocol/nolus-core/x/cron/types"
+	msgfilterkeeper "github.com/Nolus-Protocol/nolus-core/x/msgfilter/keeper"
+	tokenfactorykeeper "github.com/Nolus-Protocol/nolus-core/x/tokenfactory/keeper"
+	tokenfactorytypes "github.com/Nolus-Protocol/nolus-core/x/tokenfactory/types"
+	vestingskeeper "github.com/Nolus-Protocol/nolus-core/x/vestings/keeper"
+	vestingstypes "github.com/Nolus-Protocol/nolus-core/x/vestings/types"
 
 	contractmanagerkeeper "github.com/neutron-org/neutron/x/contractmanager/keeper"
 	icqkeeper "github.com/neutron-org/neutron/x/interchainqueries/keeper"
@@ -26,6 +40,12 @@ func CustomMessageDecorator(
 	icq *icqkeeper.Keeper,
 	transferKeeper transferwrapperkeeper.KeeperTransferWrapper,
 	contractmanagerKeeper *contractmanagerkeeper.Keeper,
+	govKeeper *govkeeper.Keeper,
+	vestingsKeeper *vestingskeeper.Keeper,
+	cronKeeper *cronkeeper.Keeper,
+	msgFilterKeeper *msgfilterkeeper.Keeper,
+	tokenFactoryKeeper *tokenfactorykeeper.Keeper,
+	cdc codec.Codec,
 ) func(messenger wasmkeeper.Messenger) wasmkeeper.Messenger {
 	return func(old wasmkeeper.Messenger) wasmkeeper.Messenger {
 		return &CustomMessenger{
@@ -35,6 +55,13 @@ func CustomMessageDecorator(
 			Icqmsgserver:          icqkeeper.NewMsgServerImpl(*icq),
 			transferKeeper:        transferKeeper,
 			ContractmanagerKeeper: contractmanagerKeeper,
+			Govmsgserver:          govkeeper.NewMsgServerImpl(govKeeper),
+			Vestingsmsgserver:     vestingskeeper.NewMsgServerImpl(*vestingsKeeper),
+			VestingsKeeper:        vestingsKeeper,
+			Cronmsgserver:         cronkeeper.NewMsgServerImpl(*cronKeeper),
+			MsgFilterKeeper:       msgFilterKeeper,
+			Tokenfactorymsgserver: tokenfactorykeeper.NewMsgServerImpl(*tokenFactoryKeeper),
+			Cdc:                   cdc,
 		}
 	}
 }
@@ -46,6 +73,13 @@ type CustomMessenger struct {
 	Icqmsgserver          icqtypes.MsgServer
 	transferKeeper        transferwrapperkeeper.KeeperTransferWrapper
 	ContractmanagerKeeper *contractmanagerkeeper.Keeper
+	Govmsgserver          govv1.MsgServer
+	Vestingsmsgserver     vestingstypes.MsgServer
+	VestingsKeeper        *vestingskeeper.Keeper
+	Cronmsgserver         crontypes.MsgServer
+	MsgFilterKeeper       *msgfilterkeeper.Keeper
+	Tokenfactorymsgserver tokenfactorytypes.MsgServer
+	Cdc                   codec.Codec
 }
 
 var _ wasmkeeper.Messenger = (*CustomMessenger)(nil)
@@ -83,6 +117,36 @@ func (m *CustomMessenger) DispatchMsg(ctx sdk.Context, contractAddr sdk.AccAddre
 		if contractMsg.ResubmitFailure != nil {
 			return m.resubmitFailure(ctx, contractAddr, contractMsg.ResubmitFailure)
 		}
+		if contractMsg.SubmitAdminProposal != nil {
+			return m.submitAdminProposal(ctx, contractAddr, contractMsg.SubmitAdminProposal)
+		}
+		if contractMsg.CreateVestingAccount != nil {
+			return m.createVestingAccount(ctx, contractAddr, contractMsg.CreateVestingAccount)
+		}
+		if contractMsg.AddSchedule != nil {
+			return m.addSchedule(ctx, contractAddr, contractMsg.AddSchedule)
+		}
+		if contractMsg.RemoveSchedule != nil {
+			return m.removeSchedule(ctx, contractAddr, contractMsg.RemoveSchedule)
+		}
+		if contractMsg.CreateDenom != nil {
+			return m.createDenom(ctx, contractAddr, contractMsg.CreateDenom)
+		}
+		if contractMsg.ChangeAdmin != nil {
+			return m.changeAdmin(ctx, contractAddr, contractMsg.ChangeAdmin)
+		}
+		if contractMsg.MintTokens != nil {
+			return m.mintTokens(ctx, contractAddr, contractMsg.MintTokens)
+		}
+		if contractMsg.BurnTokens != nil {
+			return m.burnTokens(ctx, contractAddr, contractMsg.BurnTokens)
+		}
+		if contractMsg.SetBeforeSendHook != nil {
+			return m.setBeforeSendHook(ctx, contractAddr, contractMsg.SetBeforeSendHook)
+		}
+		if contractMsg.SetDenomMetadata != nil {
+			return m.setDenomMetadata(ctx, contractAddr, contractMsg.SetDenomMetadata)
+		}
 	}
 
 	return m.Wrapped.DispatchMsg(ctx, contractAddr, contractIBCPortID, msg)
@@ -95,6 +159,10 @@ func (m *CustomMessenger) ibcTransfer(ctx sdk.Context, contractAddr sdk.AccAddre
 		return nil, nil, errors.Wrap(err, "failed to validate ibcTransferMsg")
 	}
 
+	if err := m.checkMsg(ctx, &ibcTransferMsg); err != nil {
+		return nil, nil, errors.Wrap(err, "failed to execute IBCTransfer")
+	}
+
 	response, err := m.transferKeeper.Transfer(sdk.WrapSDKContext(ctx), &ibcTransferMsg)
 	if err != nil {
 		ctx.Logger().Debug("transferServer.Transfer: failed to transfer",
@@ -163,6 +231,10 @@ func (m *CustomMessenger) performUpdateInterchainQuery(ctx sdk.Context, contract
 		return nil, errors.Wrap(err, "failed to validate incoming UpdateInterchainQuery message")
 	}
 
+	if err := m.checkMsg(ctx, &msg); err != nil {
+		return nil, err
+	}
+
 	response, err := m.Icqmsgserver.UpdateInterchainQuery(sdk.WrapSDKContext(ctx), &msg)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to update interchain query")
@@ -209,6 +281,10 @@ func (m *CustomMessenger) performRemoveInterchainQuery(ctx sdk.Context, contract
 		return nil, errors.Wrap(err, "failed to validate incoming RemoveInterchainQuery message")
 	}
 
+	if err := m.checkMsg(ctx, &msg); err != nil {
+		return nil, err
+	}
+
 	response, err := m.Icqmsgserver.RemoveInterchainQuery(sdk.WrapSDKContext(ctx), &msg)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to remove interchain query")
@@ -268,6 +344,10 @@ func (m *CustomMessenger) performSubmitTx(ctx sdk.Context, contractAddr sdk.AccA
 		return nil, errors.Wrap(err, "failed to validate incoming SubmitTx message")
 	}
 
+	if err := m.checkMsg(ctx, &tx); err != nil {
+		return nil, err
+	}
+
 	response, err := m.Ictxmsgserver.SubmitTx(sdk.WrapSDKContext(ctx), &tx)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to submit interchain transaction")
@@ -318,6 +398,10 @@ func (m *CustomMessenger) performRegisterInterchainAccount(ctx sdk.Context, cont
 		return nil, errors.Wrap(err, "failed to validate incoming RegisterInterchainAccount message")
 	}
 
+	if err := m.checkMsg(ctx, &msg); err != nil {
+		return nil, err
+	}
+
 	response, err := m.Ictxmsgserver.RegisterInterchainAccount(sdk.WrapSDKContext(ctx), &msg)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to register interchain account")
@@ -379,6 +463,10 @@ func (m *CustomMessenger) performRegisterInterchainQuery(ctx sdk.Context, contra
 		return nil, errors.Wrap(err, "failed to validate incoming RegisterInterchainQuery message")
 	}
 
+	if err := m.checkMsg(ctx, &msg); err != nil {
+		return nil, err
+	}
+
 	response, err := m.Icqmsgserver.RegisterInterchainQuery(sdk.WrapSDKContext(ctx), &msg)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to register interchain query")
@@ -416,6 +504,601 @@ func (m *CustomMessenger) resubmitFailure(ctx sdk.Context, contractAddr sdk.AccA
 	return nil, [][]byte{data}, nil
 }
 
+func (m *CustomMessenger) submitAdminProposal(ctx sdk.Context, contractAddr sdk.AccAddress, submitAdminProposal *bindings.SubmitAdminProposal) ([]sdk.Event, [][]byte, error) {
+	response, err := m.performSubmitAdminProposal(ctx, contractAddr, submitAdminProposal)
+	if err != nil {
+		ctx.Logger().Debug("performSubmitAdminProposal: failed to submit proposal",
+			"from_address", contractAddr.String(),
+			"error", err,
+		)
+		return nil, nil, errors.Wrap(err, "failed to submit proposal")
+	}
+
+	data, err := json.Marshal(response)
+	if err != nil {
+		ctx.Logger().Error("json.Marshal: failed to marshal submitAdminProposal response to JSON",
+			"from_address", contractAddr.String(),
+			"error", err,
+		)
+		return nil, nil, errors.Wrap(err, "marshal json failed")
+	}
+
+	ctx.Logger().Debug("proposal submitted",
+		"from_address", contractAddr.String(),
+		"proposal_id", response.ProposalId,
+	)
+	return nil, [][]byte{data}, nil
+}
+
+func (m *CustomMessenger) performSubmitAdminProposal(ctx sdk.Context, contractAddr sdk.AccAddress, submitAdminProposal *bindings.SubmitAdminProposal) (*bindings.SubmitAdminProposalResponse, error) {
+	msg, err := m.createSubmitProposalMsg(contractAddr, submitAdminProposal)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create MsgSubmitProposal")
+	}
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, errors.Wrap(err, "failed to validate incoming SubmitAdminProposal message")
+	}
+
+	if err := m.checkMsg(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	response, err := m.Govmsgserver.SubmitProposal(sdk.WrapSDKContext(ctx), msg)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to submit proposal")
+	}
+
+	return &bindings.SubmitAdminProposalResponse{ProposalId: response.ProposalId}, nil
+}
+
+// createSubmitProposalMsg wraps the proposal of the contract into a gov MsgSubmitProposal
+// whose messages are executed with the governance authority.
+func (m *CustomMessenger) createSubmitProposalMsg(contractAddr sdk.AccAddress, submitAdminProposal *bindings.SubmitAdminProposal) (*govv1.MsgSubmitProposal, error) {
+	var (
+		proposal  = submitAdminProposal.AdminProposal
+		authority = authtypes.NewModuleAddress(govtypes.ModuleName).String()
+		count     int
+		title     string
+		summary   string
+		msg       sdk.Msg
+	)
+
+	if proposal.ParamChangeProposal != nil {
+		count++
+		p := proposal.ParamChangeProposal
+		title, summary = p.Title, p.Description
+
+		content := paramproposal.NewParameterChangeProposal(p.Title, p.Description, p.ParamChanges)
+		legacyContent, err := govv1.NewLegacyContent(content, authority)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to wrap param change proposal")
+		}
+		msg = legacyContent
+	}
+	if proposal.SoftwareUpgradeProposal != nil {
+		count++
+		p := proposal.SoftwareUpgradeProposal
+		title, summary = p.Title, p.Description
+
+		msg = &upgradetypes.MsgSoftwareUpgrade{
+			Authority: authority,
+			Plan: upgradetypes.Plan{
+				Name:   p.Plan.Name,
+				Height: p.Plan.Height,
+				Info:   p.Plan.Info,
+			},
+		}
+	}
+	if proposal.CancelSoftwareUpgradeProposal != nil {
+		count++
+		p := proposal.CancelSoftwareUpgradeProposal
+		title, summary = p.Title, p.Description
+
+		msg = &upgradetypes.MsgCancelUpgrade{Authority: authority}
+	}
+	if proposal.ProposalExecuteMessage != nil {
+		count++
+		p := proposal.ProposalExecuteMessage
+		title, summary = p.Title, p.Description
+
+		if err := m.Cdc.UnmarshalInterfaceJSON([]byte(p.Message), &msg); err != nil {
+			return nil, errors.Wrap(err, "failed to unmarshal proposal message")
+		}
+	}
+
+	if count != 1 {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "admin proposal must have exactly one proposal, got %d", count)
+	}
+
+	return govv1.NewMsgSubmitProposal(
+		[]sdk.Msg{msg},
+		submitAdminProposal.InitialDeposit,
+		contractAddr.String(),
+		submitAdminProposal.Metadata,
+		title,
+		summary,
+	)
+}
+
+func (m *CustomMessenger) createVestingAccount(ctx sdk.Context, contractAddr sdk.AccAddress, createVestingAccount *bindings.CreateVestingAccount) ([]sdk.Event, [][]byte, error) {
+	response, err := m.performCreateVestingAccount(ctx, contractAddr, createVestingAccount)
+	if err != nil {
+		ctx.Logger().Debug("performCreateVestingAccount: failed to create vesting account",
+			"from_address", contractAddr.String(),
+			"to_address", createVestingAccount.ToAddress,
+			"error", err,
+		)
+		return nil, nil, errors.Wrap(err, "failed to create vesting account")
+	}
+
+	data, err := json.Marshal(response)
+	if err != nil {
+		ctx.Logger().Error("json.Marshal: failed to marshal createVestingAccount response to JSON",
+			"from_address", contractAddr.String(),
+			"to_address", createVestingAccount.ToAddress,
+			"error", err,
+		)
+		return nil, nil, errors.Wrap(err, "marshal json failed")
+	}
+
+	ctx.Logger().Debug("vesting account created",
+		"from_address", contractAddr.String(),
+		"to_address", createVestingAccount.ToAddress,
+	)
+	return nil, [][]byte{data}, nil
+}
+
+func (m *CustomMessenger) performCreateVestingAccount(ctx sdk.Context, contractAddr sdk.AccAddress, createVestingAccount *bindings.CreateVestingAccount) (*bindings.CreateVestingAccountResponse, error) {
+	msg := vestingstypes.MsgCreateVestingAccount{
+		FromAddress: contractAddr.String(),
+		ToAddress:   createVestingAccount.ToAddress,
+		Amount:      createVestingAccount.Amount,
+		StartTime:   createVestingAccount.StartTime,
+		EndTime:     createVestingAccount.EndTime,
+		Delayed:     createVestingAccount.Delayed,
+		Merge:       createVestingAccount.Merge,
+	}
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, errors.Wrap(err, "failed to validate incoming CreateVestingAccount message")
+	}
+
+	if err := m.checkMsg(ctx, &msg); err != nil {
+		return nil, err
+	}
+
+	// a merge is signed by the owner of the destination as well, and the
+	// contract signs for itself only, so it may merge only into its own account
+	if msg.Merge && msg.ToAddress != msg.FromAddress {
+		return nil, errors.Wrapf(sdkerrors.ErrUnauthorized, "contract may not merge into account %s", msg.ToAddress)
+	}
+
+	if _, err := m.Vestingsmsgserver.CreateVestingAccount(sdk.WrapSDKContext(ctx), &msg); err != nil {
+		return nil, errors.Wrap(err, "failed to create vesting account")
+	}
+
+	balances, err := m.VestingsKeeper.Balances(sdk.WrapSDKContext(ctx), &vestingstypes.QueryBalancesRequest{Address: msg.ToAddress})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to query vesting account balances")
+	}
+
+	return &bindings.CreateVestingAccountResponse{
+		Address:  msg.ToAddress,
+		Vested:   balances.Vested,
+		Unvested: balances.Unvested,
+	}, nil
+}
+
+func (m *CustomMessenger) addSchedule(ctx sdk.Context, contractAddr sdk.AccAddress, addSchedule *bindings.AddSchedule) ([]sdk.Event, [][]byte, error) {
+	response, err := m.performAddSchedule(ctx, contractAddr, addSchedule)
+	if err != nil {
+		ctx.Logger().Debug("performAddSchedule: failed to add schedule",
+			"from_address", contractAddr.String(),
+			"name", addSchedule.Name,
+			"error", err,
+		)
+		return nil, nil, errors.Wrap(err, "failed to add schedule")
+	}
+
+	data, err := json.Marshal(response)
+	if err != nil {
+		ctx.Logger().Error("json.Marshal: failed to marshal addSchedule response to JSON",
+			"from_address", contractAddr.String(),
+			"name", addSchedule.Name,
+			"error", err,
+		)
+		return nil, nil, errors.Wrap(err, "marshal json failed")
+	}
+
+	ctx.Logger().Debug("schedule added",
+		"from_address", contractAddr.String(),
+		"name", addSchedule.Name,
+	)
+	return nil, [][]byte{data}, nil
+}
+
+func (m *CustomMessenger) performAddSchedule(ctx sdk.Context, contractAddr sdk.AccAddress, addSchedule *bindings.AddSchedule) (*bindings.AddScheduleResponse, error) {
+	// only the governance authority may add sudo schedules, never a contract
+	if addSchedule.Sudo {
+		return nil, errors.Wrap(sdkerrors.ErrUnauthorized, "contract may not add sudo schedules")
+	}
+
+	executionStage := crontypes.EXECUTION_STAGE_END_BLOCKER
+	if addSchedule.ExecutionStage != "" {
+		stage, ok := crontypes.ExecutionStage_value[addSchedule.ExecutionStage]
+		if !ok {
+			return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid execution stage %s", addSchedule.ExecutionStage)
+		}
+		executionStage = crontypes.ExecutionStage(stage)
+	}
+
+	msg := crontypes.NewMsgAddSchedule(contractAddr, crontypes.Schedule{
+		Name:           addSchedule.Name,
+		Period:         addSchedule.Period,
+		Contract:       addSchedule.Contract,
+		Msg:            addSchedule.Msg,
+		Sudo:           addSchedule.Sudo,
+		GasLimit:       addSchedule.GasLimit,
+		ExecutionStage: executionStage,
+	})
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, errors.Wrap(err, "failed to validate incoming AddSchedule message")
+	}
+
+	if err := m.checkMsg(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	if _, err := m.Cronmsgserver.AddSchedule(sdk.WrapSDKContext(ctx), msg); err != nil {
+		return nil, errors.Wrap(err, "failed to add schedule")
+	}
+
+	return &bindings.AddScheduleResponse{}, nil
+}
+
+func (m *CustomMessenger) removeSchedule(ctx sdk.Context, contractAddr sdk.AccAddress, removeSchedule *bindings.RemoveSchedule) ([]sdk.Event, [][]byte, error) {
+	response, err := m.performRemoveSchedule(ctx, contractAddr, removeSchedule)
+	if err != nil {
+		ctx.Logger().Debug("performRemoveSchedule: failed to remove schedule",
+			"from_address", contractAddr.String(),
+			"name", removeSchedule.Name,
+			"error", err,
+		)
+		return nil, nil, errors.Wrap(err, "failed to remove schedule")
+	}
+
+	data, err := json.Marshal(response)
+	if err != nil {
+		ctx.Logger().Error("json.Marshal: failed to marshal removeSchedule response to JSON",
+			"from_address", contractAddr.String(),
+			"name", removeSchedule.Name,
+			"error", err,
+		)
+		return nil, nil, errors.Wrap(err, "marshal json failed")
+	}
+
+	ctx.Logger().Debug("schedule removed",
+		"from_address", contractAddr.String(),
+		"name", removeSchedule.Name,
+	)
+	return nil, [][]byte{data}, nil
+}
+
+func (m *CustomMessenger) performRemoveSchedule(ctx sdk.Context, contractAddr sdk.AccAddress, removeSchedule *bindings.RemoveSchedule) (*bindings.RemoveScheduleResponse, error) {
+	msg := crontypes.NewMsgRemoveSchedule(contractAddr, removeSchedule.Name)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, errors.Wrap(err, "failed to validate incoming RemoveSchedule message")
+	}
+
+	if err := m.checkMsg(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	if _, err := m.Cronmsgserver.RemoveSchedule(sdk.WrapSDKContext(ctx), msg); err != nil {
+		return nil, errors.Wrap(err, "failed to remove schedule")
+	}
+
+	return &bindings.RemoveScheduleResponse{}, nil
+}
+
+func (m *CustomMessenger) createDenom(ctx sdk.Context, contractAddr sdk.AccAddress, createDenom *bindings.CreateDenom) ([]sdk.Event, [][]byte, error) {
+	response, err := m.performCreateDenom(ctx, contractAddr, createDenom)
+	if err != nil {
+		ctx.Logger().Debug("performCreateDenom: failed to create denom",
+			"from_address", contractAddr.String(),
+			"subdenom", createDenom.Subdenom,
+			"error", err,
+		)
+		return nil, nil, errors.Wrap(err, "failed to create denom")
+	}
+
+	data, err := json.Marshal(response)
+	if err != nil {
+		ctx.Logger().Error("json.Marshal: failed to marshal createDenom response to JSON",
+			"from_address", contractAddr.String(),
+			"subdenom", createDenom.Subdenom,
+			"error", err,
+		)
+		return nil, nil, errors.Wrap(err, "marshal json failed")
+	}
+
+	ctx.Logger().Debug("denom created",
+		"from_address", contractAddr.String(),
+		"subdenom", createDenom.Subdenom,
+	)
+	return nil, [][]byte{data}, nil
+}
+
+func (m *CustomMessenger) performCreateDenom(ctx sdk.Context, contractAddr sdk.AccAddress, createDenom *bindings.CreateDenom) (*bindings.CreateDenomResponse, error) {
+	msg := tokenfactorytypes.NewMsgCreateDenom(contractAddr.String(), createDenom.Subdenom)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, errors.Wrap(err, "failed to validate incoming CreateDenom message")
+	}
+
+	if err := m.checkMsg(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	response, err := m.Tokenfactorymsgserver.CreateDenom(sdk.WrapSDKContext(ctx), msg)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create denom")
+	}
+
+	return &bindings.CreateDenomResponse{Denom: response.NewTokenDenom}, nil
+}
+
+func (m *CustomMessenger) changeAdmin(ctx sdk.Context, contractAddr sdk.AccAddress, changeAdmin *bindings.ChangeAdmin) ([]sdk.Event, [][]byte, error) {
+	response, err := m.performChangeAdmin(ctx, contractAddr, changeAdmin)
+	if err != nil {
+		ctx.Logger().Debug("performChangeAdmin: failed to change admin",
+			"from_address", contractAddr.String(),
+			"denom", changeAdmin.Denom,
+			"error", err,
+		)
+		return nil, nil, errors.Wrap(err, "failed to change admin")
+	}
+
+	data, err := json.Marshal(response)
+	if err != nil {
+		ctx.Logger().Error("json.Marshal: failed to marshal changeAdmin response to JSON",
+			"from_address", contractAddr.String(),
+			"denom", changeAdmin.Denom,
+			"error", err,
+		)
+		return nil, nil, errors.Wrap(err, "marshal json failed")
+	}
+
+	ctx.Logger().Debug("admin changed",
+		"from_address", contractAddr.String(),
+		"denom", changeAdmin.Denom,
+	)
+	return nil, [][]byte{data}, nil
+}
+
+func (m *CustomMessenger) performChangeAdmin(ctx sdk.Context, contractAddr sdk.AccAddress, changeAdmin *bindings.ChangeAdmin) (*bindings.ChangeAdminResponse, error) {
+	msg := tokenfactorytypes.NewMsgChangeAdmin(contractAddr.String(), changeAdmin.Denom, changeAdmin.NewAdminAddress)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, errors.Wrap(err, "failed to validate incoming ChangeAdmin message")
+	}
+
+	if err := m.checkMsg(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	if _, err := m.Tokenfactorymsgserver.ChangeAdmin(sdk.WrapSDKContext(ctx), msg); err != nil {
+		return nil, errors.Wrap(err, "failed to change admin")
+	}
+
+	return &bindings.ChangeAdminResponse{}, nil
+}
+
+func (m *CustomMessenger) mintTokens(ctx sdk.Context, contractAddr sdk.AccAddress, mintTokens *bindings.MintTokens) ([]sdk.Event, [][]byte, error) {
+	response, err := m.performMintTokens(ctx, contractAddr, mintTokens)
+	if err != nil {
+		ctx.Logger().Debug("performMintTokens: failed to mint tokens",
+			"from_address", contractAddr.String(),
+			"denom", mintTokens.Denom,
+			"error", err,
+		)
+		return nil, nil, errors.Wrap(err, "failed to mint tokens")
+	}
+
+	data, err := json.Marshal(response)
+	if err != nil {
+		ctx.Logger().Error("json.Marshal: failed to marshal mintTokens response to JSON",
+			"from_address", contractAddr.String(),
+			"denom", mintTokens.Denom,
+			"error", err,
+		)
+		return nil, nil, errors.Wrap(err, "marshal json failed")
+	}
+
+	ctx.Logger().Debug("tokens minted",
+		"from_address", contractAddr.String(),
+		"denom", mintTokens.Denom,
+	)
+	return nil, [][]byte{data}, nil
+}
+
+func (m *CustomMessenger) performMintTokens(ctx sdk.Context, contractAddr sdk.AccAddress, mintTokens *bindings.MintTokens) (*bindings.MintTokensResponse, error) {
+	if mintTokens.Amount.IsNil() {
+		return nil, errors.Wrap(sdkerrors.ErrInvalidCoins, "amount is required")
+	}
+
+	msg := tokenfactorytypes.NewMsgMint(contractAddr.String(), sdk.Coin{Denom: mintTokens.Denom, Amount: mintTokens.Amount}, mintTokens.MintToAddress)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, errors.Wrap(err, "failed to validate incoming MintTokens message")
+	}
+
+	if err := m.checkMsg(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	if _, err := m.Tokenfactorymsgserver.Mint(sdk.WrapSDKContext(ctx), msg); err != nil {
+		return nil, errors.Wrap(err, "failed to mint tokens")
+	}
+
+	return &bindings.MintTokensResponse{}, nil
+}
+
+func (m *CustomMessenger) burnTokens(ctx sdk.Context, contractAddr sdk.AccAddress, burnTokens *bindings.BurnTokens) ([]sdk.Event, [][]byte, error) {
+	response, err := m.performBurnTokens(ctx, contractAddr, burnTokens)
+	if err != nil {
+		ctx.Logger().Debug("performBurnTokens: failed to burn tokens",
+			"from_address", contractAddr.String(),
+			"denom", burnTokens.Denom,
+			"error", err,
+		)
+		return nil, nil, errors.Wrap(err, "failed to burn tokens")
+	}
+
+	data, err := json.Marshal(response)
+	if err != nil {
+		ctx.Logger().Error("json.Marshal: failed to marshal burnTokens response to JSON",
+			"from_address", contractAddr.String(),
+			"denom", burnTokens.Denom,
+			"error", err,
+		)
+		return nil, nil, errors.Wrap(err, "marshal json failed")
+	}
+
+	ctx.Logger().Debug("tokens burned",
+		"from_address", contractAddr.String(),
+		"denom", burnTokens.Denom,
+	)
+	return nil, [][]byte{data}, nil
+}
+
+func (m *CustomMessenger) performBurnTokens(ctx sdk.Context, contractAddr sdk.AccAddress, burnTokens *bindings.BurnTokens) (*bindings.BurnTokensResponse, error) {
+	if burnTokens.Amount.IsNil() {
+		return nil, errors.Wrap(sdkerrors.ErrInvalidCoins, "amount is required")
+	}
+
+	msg := tokenfactorytypes.NewMsgBurn(contractAddr.String(), sdk.Coin{Denom: burnTokens.Denom, Amount: burnTokens.Amount})
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, errors.Wrap(err, "failed to validate incoming BurnTokens message")
+	}
+
+	if err := m.checkMsg(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	if _, err := m.Tokenfactorymsgserver.Burn(sdk.WrapSDKContext(ctx), msg); err != nil {
+		return nil, errors.Wrap(err, "failed to burn tokens")
+	}
+
+	return &bindings.BurnTokensResponse{}, nil
+}
+
+func (m *CustomMessenger) setBeforeSendHook(ctx sdk.Context, contractAddr sdk.AccAddress, setBeforeSendHook *bindings.SetBeforeSendHook) ([]sdk.Event, [][]byte, error) {
+	response, err := m.performSetBeforeSendHook(ctx, contractAddr, setBeforeSendHook)
+	if err != nil {
+		ctx.Logger().Debug("performSetBeforeSendHook: failed to set before send hook",
+			"from_address", contractAddr.String(),
+			"denom", setBeforeSendHook.Denom,
+			"error", err,
+		)
+		return nil, nil, errors.Wrap(err, "failed to set before send hook")
+	}
+
+	data, err := json.Marshal(response)
+	if err != nil {
+		ctx.Logger().Error("json.Marshal: failed to marshal setBeforeSendHook response to JSON",
+			"from_address", contractAddr.String(),
+			"denom", setBeforeSendHook.Denom,
+			"error", err,
+		)
+		return nil, nil, errors.Wrap(err, "marshal json failed")
+	}
+
+	ctx.Logger().Debug("before send hook set",
+		"from_address", contractAddr.String(),
+		"denom", setBeforeSendHook.Denom,
+	)
+	return nil, [][]byte{data}, nil
+}
+
+func (m *CustomMessenger) performSetBeforeSendHook(ctx sdk.Context, contractAddr sdk.AccAddress, setBeforeSendHook *bindings.SetBeforeSendHook) (*bindings.SetBeforeSendHookResponse, error) {
+	msg := tokenfactorytypes.NewMsgSetBeforeSendHook(contractAddr.String(), setBeforeSendHook.Denom, setBeforeSendHook.ContractAddr)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, errors.Wrap(err, "failed to validate incoming SetBeforeSendHook message")
+	}
+
+	if err := m.checkMsg(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	if _, err := m.Tokenfactorymsgserver.SetBeforeSendHook(sdk.WrapSDKContext(ctx), msg); err != nil {
+		return nil, errors.Wrap(err, "failed to set before send hook")
+	}
+
+	return &bindings.SetBeforeSendHookResponse{}, nil
+}
+
+func (m *CustomMessenger) setDenomMetadata(ctx sdk.Context, contractAddr sdk.AccAddress, setDenomMetadata *bindings.SetDenomMetadata) ([]sdk.Event, [][]byte, error) {
+	response, err := m.performSetDenomMetadata(ctx, contractAddr, setDenomMetadata)
+	if err != nil {
+		ctx.Logger().Debug("performSetDenomMetadata: failed to set denom metadata",
+			"from_address", contractAddr.String(),
+			"denom", setDenomMetadata.Base,
+			"error", err,
+		)
+		return nil, nil, errors.Wrap(err, "failed to set denom metadata")
+	}
+
+	data, err := json.Marshal(response)
+	if err != nil {
+		ctx.Logger().Error("json.Marshal: failed to marshal setDenomMetadata response to JSON",
+			"from_address", contractAddr.String(),
+			"denom", setDenomMetadata.Base,
+			"error", err,
+		)
+		return nil, nil, errors.Wrap(err, "marshal json failed")
+	}
+
+	ctx.Logger().Debug("denom metadata set",
+		"from_address", contractAddr.String(),
+		"denom", setDenomMetadata.Base,
+	)
+	return nil, [][]byte{data}, nil
+}
+
+func (m *CustomMessenger) performSetDenomMetadata(ctx sdk.Context, contractAddr sdk.AccAddress, setDenomMetadata *bindings.SetDenomMetadata) (*bindings.SetDenomMetadataResponse, error) {
+	msg := tokenfactorytypes.NewMsgSetDenomMetadata(contractAddr.String(), setDenomMetadata.Metadata)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, errors.Wrap(err, "failed to validate incoming SetDenomMetadata message")
+	}
+
+	if err := m.checkMsg(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	if _, err := m.Tokenfactorymsgserver.SetDenomMetadata(sdk.WrapSDKContext(ctx), msg); err != nil {
+		return nil, errors.Wrap(err, "failed to set denom metadata")
+	}
+
+	return &bindings.SetDenomMetadataResponse{}, nil
+}
+
+// checkMsg rejects the message built from a custom message if x/msgfilter
+// blocks it, as the custom messages do not go through the message router.
+func (m *CustomMessenger) checkMsg(ctx sdk.Context, msg sdk.Msg) error {
+	if m.MsgFilterKeeper == nil {
+		return nil
+	}
+
+	return m.MsgFilterKeeper.CheckMsgs(ctx, []sdk.Msg{msg})
+}
+
 func getRegisterFee(fee sdk.Coins) sdk.Coins {
 	if fee == nil {
 		return make(sdk.Coins, 0)
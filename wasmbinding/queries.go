@@ -2,10 +2,12 @@ package wasmbinding
 
 import (
 	"cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkquery "github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/Nolus-Protocol/nolus-core/wasmbinding/bindings"
+	tokenfactorytypes "github.com/Nolus-Protocol/nolus-core/x/tokenfactory/types"
 
 	errorsmod "cosmossdk.io/errors"
 
@@ -105,6 +107,93 @@ func (qp *QueryPlugin) GetFailures(ctx sdk.Context, address string, pagination *
 	return &bindings.FailuresResponse{Failures: res.Failures}, nil
 }
 
+func (qp *QueryPlugin) GetMintState(ctx sdk.Context, _ *bindings.QueryMintStateRequest) (*bindings.QueryMintStateResponse, error) {
+	minter := qp.mintKeeper.GetMinter(ctx)
+
+	return &bindings.QueryMintStateResponse{
+		TotalMinted:     minter.TotalMinted,
+		AnnualInflation: minter.AnnualInflation,
+		NormTimePassed:  minter.NormTimePassed,
+		Paused:          minter.Paused,
+	}, nil
+}
+
+func (qp *QueryPlugin) GetTaxParams(ctx sdk.Context, _ *bindings.QueryTaxParamsRequest) (*bindings.QueryTaxParamsResponse, error) {
+	params := qp.taxKeeper.GetParams(ctx)
+
+	feeParams := make([]bindings.FeeParam, 0, len(params.FeeParams))
+	for _, feeParam := range params.FeeParams {
+		acceptedDenoms := make([]bindings.DenomTicker, 0, len(feeParam.AcceptedDenoms))
+		for _, denom := range feeParam.AcceptedDenoms {
+			acceptedDenoms = append(acceptedDenoms, bindings.DenomTicker{Denom: denom.Denom, Ticker: denom.Ticker})
+		}
+
+		feeParams = append(feeParams, bindings.FeeParam{
+			OracleAddress:  feeParam.OracleAddress,
+			ProfitAddress:  feeParam.ProfitAddress,
+			AcceptedDenoms: acceptedDenoms,
+		})
+	}
+
+	return &bindings.QueryTaxParamsResponse{
+		FeeRate:         params.FeeRate,
+		TaxRate:         sdkmath.LegacyNewDecWithPrec(int64(params.FeeRate), 2),
+		BaseDenom:       params.BaseDenom,
+		TreasuryAddress: params.ContractAddress,
+		FeeParams:       feeParams,
+		BurnRatio:       qp.taxKeeper.BurnRatio(ctx),
+	}, nil
+}
+
+func (qp *QueryPlugin) GetFeeEstimate(ctx sdk.Context, req *bindings.QueryFeeEstimateRequest) (*bindings.QueryFeeEstimateResponse, error) {
+	tax, recipient, err := qp.taxKeeper.CalculateTax(ctx, req.Fee)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to estimate tax for fee: %s", req.Fee)
+	}
+
+	return &bindings.QueryFeeEstimateResponse{
+		Tax:         tax,
+		Burned:      qp.taxKeeper.CalculateBurn(ctx, tax),
+		Recipient:   recipient.String(),
+		FeeAfterTax: req.Fee.Sub(tax),
+	}, nil
+}
+
+func (qp *QueryPlugin) GetFullDenom(_ sdk.Context, req *bindings.QueryFullDenomRequest) (*bindings.QueryFullDenomResponse, error) {
+	denom, err := tokenfactorytypes.GetTokenDenom(req.CreatorAddr, req.Subdenom)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get full denom")
+	}
+
+	return &bindings.QueryFullDenomResponse{Denom: denom}, nil
+}
+
+func (qp *QueryPlugin) GetDenomAdmin(ctx sdk.Context, req *bindings.QueryDenomAdminRequest) (*bindings.QueryDenomAdminResponse, error) {
+	metadata, found := qp.tokenFactoryKeeper.GetAuthorityMetadata(ctx, req.Denom)
+	if !found {
+		return nil, errors.Wrapf(tokenfactorytypes.ErrDenomNotFound, "denom %s", req.Denom)
+	}
+
+	return &bindings.QueryDenomAdminResponse{Admin: metadata.Admin}, nil
+}
+
+func (qp *QueryPlugin) GetDenomsFromCreator(ctx sdk.Context, req *bindings.QueryDenomsFromCreatorRequest) (*bindings.QueryDenomsFromCreatorResponse, error) {
+	return &bindings.QueryDenomsFromCreatorResponse{Denoms: qp.tokenFactoryKeeper.GetDenomsFromCreator(ctx, req.Creator)}, nil
+}
+
+func (qp *QueryPlugin) GetBeforeSendHook(ctx sdk.Context, req *bindings.QueryBeforeSendHookRequest) (*bindings.QueryBeforeSendHookResponse, error) {
+	return &bindings.QueryBeforeSendHookResponse{ContractAddr: qp.tokenFactoryKeeper.GetBeforeSendHook(ctx, req.Denom)}, nil
+}
+
+func (qp *QueryPlugin) GetDenomCreationFee(ctx sdk.Context, _ *bindings.QueryDenomCreationFeeRequest) (*bindings.QueryDenomCreationFeeResponse, error) {
+	fee := qp.tokenFactoryKeeper.GetParams(ctx).DenomCreationFee
+	if fee == nil {
+		fee = sdk.Coins{}
+	}
+
+	return &bindings.QueryDenomCreationFeeResponse{Fee: fee}, nil
+}
+
 func mapGRPCRegisteredQueryToWasmBindings(grpcQuery types.RegisteredQuery) bindings.RegisteredQuery {
 	return bindings.RegisteredQuery{
 		ID:                              grpcQuery.GetId(),
@@ -5,6 +5,10 @@ import (
 	feerefunderkeeper "github.com/neutron-org/neutron/x/feerefunder/keeper"
 	icqkeeper "github.com/neutron-org/neutron/x/interchainqueries/keeper"
 	icacontrollerkeeper "github.com/neutron-org/neutron/x/interchaintxs/keeper"
+
+	mintkeeper "github.com/Nolus-Protocol/nolus-core/x/mint/keeper"
+	taxkeeper "github.com/Nolus-Protocol/nolus-core/x/tax/keeper"
+	tokenfactorykeeper "github.com/Nolus-Protocol/nolus-core/x/tokenfactory/keeper"
 )
 
 type QueryPlugin struct {
@@ -12,6 +16,9 @@ type QueryPlugin struct {
 	icqKeeper             *icqkeeper.Keeper
 	feeRefunderKeeper     *feerefunderkeeper.Keeper
 	contractmanagerKeeper *contractmanagerkeeper.Keeper
+	mintKeeper            *mintkeeper.Keeper
+	taxKeeper             *taxkeeper.Keeper
+	tokenFactoryKeeper    *tokenfactorykeeper.Keeper
 }
 
 // NewQueryPlugin returns a reference to a new QueryPlugin.
@@ -20,11 +27,17 @@ func NewQueryPlugin(
 	icqKeeper *icqkeeper.Keeper,
 	feeRefunderKeeper *feerefunderkeeper.Keeper,
 	contractmanagerKeeper *contractmanagerkeeper.Keeper,
+	mintKeeper *mintkeeper.Keeper,
+	taxKeeper *taxkeeper.Keeper,
+	tokenFactoryKeeper *tokenfactorykeeper.Keeper,
 ) *QueryPlugin {
 	return &QueryPlugin{
 		icaControllerKeeper:   icaControllerKeeper,
 		icqKeeper:             icqKeeper,
 		feeRefunderKeeper:     feeRefunderKeeper,
 		contractmanagerKeeper: contractmanagerKeeper,
+		mintKeeper:            mintKeeper,
+		taxKeeper:             taxKeeper,
+		tokenFactoryKeeper:    tokenFactoryKeeper,
 	}
 }
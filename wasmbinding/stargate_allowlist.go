@@ -0,0 +1,49 @@
+package wasmbinding
+
+import (
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	icacontrollertypes "github.com/cosmos/ibc-go/v7/modules/apps/27-interchain-accounts/controller/types"
+
+	interchainqueriestypes "github.com/neutron-org/neutron/x/interchainqueries/types"
+	interchaintxstypes "github.com/neutron-org/neutron/x/interchaintxs/types"
+
+	minttypes "github.com/Nolus-Protocol/nolus-core/x/mint/types"
+	taxtypes "github.com/Nolus-Protocol/nolus-core/x/tax/types"
+	vestingstypes "github.com/Nolus-Protocol/nolus-core/x/vestings/types"
+)
+
+// AcceptedStargateQueries returns the gRPC query paths contracts may call with
+// stargate queries, with the types their responses are decoded into before
+// being returned to the contract as JSON. Only queries with a deterministic
+// response may be added.
+func AcceptedStargateQueries() wasmkeeper.AcceptedStargateQueries {
+	return wasmkeeper.AcceptedStargateQueries{
+		// tax
+		"/nolus.tax.v1beta1.Query/Params":       &taxtypes.QueryParamsResponse{},
+		"/nolus.tax.v1beta1.Query/BurnedSupply": &taxtypes.QueryBurnedSupplyResponse{},
+
+		// mint
+		"/nolus.mint.v1beta1.Query/MintState":       &minttypes.QueryMintStateResponse{},
+		"/nolus.mint.v1beta1.Query/AnnualInflation": &minttypes.QueryAnnualInflationResponse{},
+
+		// vestings
+		"/nolus.vestings.v1beta1.Query/Params":          &vestingstypes.QueryParamsResponse{},
+		"/nolus.vestings.v1beta1.Query/Balances":        &vestingstypes.QueryBalancesResponse{},
+		"/nolus.vestings.v1beta1.Query/UnlockSchedule":  &vestingstypes.QueryUnlockScheduleResponse{},
+		"/nolus.vestings.v1beta1.Query/VestingAccounts": &vestingstypes.QueryVestingAccountsResponse{},
+
+		// bank
+		"/cosmos.bank.v1beta1.Query/Balance":     &banktypes.QueryBalanceResponse{},
+		"/cosmos.bank.v1beta1.Query/AllBalances": &banktypes.QueryAllBalancesResponse{},
+
+		// interchain accounts
+		"/ibc.applications.interchain_accounts.controller.v1.Query/InterchainAccount": &icacontrollertypes.QueryInterchainAccountResponse{},
+		"/neutron.interchaintxs.v1.Query/InterchainAccountAddress":                    &interchaintxstypes.QueryInterchainAccountAddressResponse{},
+
+		// interchainqueries
+		"/neutron.interchainqueries.Query/RegisteredQuery":  &interchainqueriestypes.QueryRegisteredQueryResponse{},
+		"/neutron.interchainqueries.Query/QueryResult":      &interchainqueriestypes.QueryRegisteredQueryResultResponse{},
+		"/neutron.interchainqueries.Query/LastRemoteHeight": &interchainqueriestypes.QueryLastRemoteHeightResponse{},
+	}
+}
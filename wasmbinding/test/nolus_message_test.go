@@ -0,0 +1,467 @@
+package test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	authvestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govkeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	paramproposal "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	"github.com/stretchr/testify/suite"
+
+	feerefundertypes "github.com/neutron-org/neutron/x/feerefunder/types"
+	transferwrappertypes "github.com/neutron-org/neutron/x/transfer/types"
+
+	"github.com/Nolus-Protocol/nolus-core/wasmbinding"
+	"github.com/Nolus-Protocol/nolus-core/wasmbinding/bindings"
+	cronkeeper "github.com/Nolus-Protocol/nolus-core/x/cron/keeper"
+	crontypes "github.com/Nolus-Protocol/nolus-core/x/cron/types"
+	msgfiltertypes "github.com/Nolus-Protocol/nolus-core/x/msgfilter/types"
+	taxtypes "github.com/Nolus-Protocol/nolus-core/x/tax/types"
+	tokenfactorykeeper "github.com/Nolus-Protocol/nolus-core/x/tokenfactory/keeper"
+	tokenfactorytypes "github.com/Nolus-Protocol/nolus-core/x/tokenfactory/types"
+	vestingskeeper "github.com/Nolus-Protocol/nolus-core/x/vestings/keeper"
+)
+
+type NolusMessengerTestSuite struct {
+	NolusTestSuite
+
+	messenger       *wasmbinding.CustomMessenger
+	contractAddress sdk.AccAddress
+}
+
+func (suite *NolusMessengerTestSuite) SetupTest() {
+	suite.NolusTestSuite.SetupTest()
+	suite.messenger = &wasmbinding.CustomMessenger{
+		Govmsgserver:          govkeeper.NewMsgServerImpl(suite.app.GovKeeper),
+		Vestingsmsgserver:     vestingskeeper.NewMsgServerImpl(*suite.app.VestingsKeeper),
+		VestingsKeeper:        suite.app.VestingsKeeper,
+		Cronmsgserver:         cronkeeper.NewMsgServerImpl(*suite.app.CronKeeper),
+		MsgFilterKeeper:       suite.app.MsgFilterKeeper,
+		Tokenfactorymsgserver: tokenfactorykeeper.NewMsgServerImpl(*suite.app.TokenFactoryKeeper),
+		Cdc:                   suite.app.AppCodec(),
+	}
+	suite.contractAddress = suite.instantiateReflectContract()
+}
+
+func (suite *NolusMessengerTestSuite) TestSubmitParamChangeProposal() {
+	changes := []paramproposal.ParamChange{{Subspace: "staking", Key: "MaxValidators", Value: `10`}}
+	proposal := suite.submitAdminProposal(bindings.AdminProposal{
+		ParamChangeProposal: &bindings.ParamChangeProposal{
+			Title:        "Param change",
+			Description:  "Change the max validators",
+			ParamChanges: changes,
+		},
+	})
+
+	var msg sdk.Msg
+	suite.Require().NoError(suite.app.AppCodec().UnpackAny(proposal.Messages[0], &msg))
+	legacyContent, ok := msg.(*govv1.MsgExecLegacyContent)
+	suite.Require().True(ok)
+	suite.Require().Equal(govAuthority(), legacyContent.Authority)
+
+	content, err := govv1.LegacyContentFromMessage(legacyContent)
+	suite.Require().NoError(err)
+	suite.Require().Equal(paramproposal.NewParameterChangeProposal("Param change", "Change the max validators", changes), content)
+	suite.Require().Equal("Param change", proposal.Title)
+}
+
+func (suite *NolusMessengerTestSuite) TestSubmitSoftwareUpgradeProposal() {
+	proposal := suite.submitAdminProposal(bindings.AdminProposal{
+		SoftwareUpgradeProposal: &bindings.SoftwareUpgradeProposal{
+			Title:       "Upgrade",
+			Description: "Upgrade the chain",
+			Plan:        bindings.Plan{Name: "v1.0.0", Height: 100, Info: "info"},
+		},
+	})
+
+	var msg sdk.Msg
+	suite.Require().NoError(suite.app.AppCodec().UnpackAny(proposal.Messages[0], &msg))
+	suite.Require().Equal(&upgradetypes.MsgSoftwareUpgrade{
+		Authority: govAuthority(),
+		Plan:      upgradetypes.Plan{Name: "v1.0.0", Height: 100, Info: "info"},
+	}, msg)
+	suite.Require().Equal("Upgrade the chain", proposal.Summary)
+}
+
+func (suite *NolusMessengerTestSuite) TestSubmitCancelSoftwareUpgradeProposal() {
+	proposal := suite.submitAdminProposal(bindings.AdminProposal{
+		CancelSoftwareUpgradeProposal: &bindings.CancelSoftwareUpgradeProposal{
+			Title:       "Cancel upgrade",
+			Description: "Cancel the upgrade",
+		},
+	})
+
+	var msg sdk.Msg
+	suite.Require().NoError(suite.app.AppCodec().UnpackAny(proposal.Messages[0], &msg))
+	suite.Require().Equal(&upgradetypes.MsgCancelUpgrade{Authority: govAuthority()}, msg)
+}
+
+func (suite *NolusMessengerTestSuite) TestSubmitExecuteMessageProposal() {
+	params := taxtypes.DefaultParams()
+	params.FeeRate = 10
+	updateParams := &taxtypes.MsgUpdateParams{Authority: govAuthority(), Params: params}
+	message, err := suite.app.AppCodec().MarshalInterfaceJSON(updateParams)
+	suite.Require().NoError(err)
+
+	proposal := suite.submitAdminProposal(bindings.AdminProposal{
+		ProposalExecuteMessage: &bindings.ProposalExecuteMessage{
+			Title:       "Tax params",
+			Description: "Lower the fee rate",
+			Message:     string(message),
+		},
+	})
+
+	var msg sdk.Msg
+	suite.Require().NoError(suite.app.AppCodec().UnpackAny(proposal.Messages[0], &msg))
+	suite.Require().Equal(updateParams, msg)
+
+	// the message must be executable by the governance authority
+	updateParams.Authority = suite.contractAddress.String()
+	message, err = suite.app.AppCodec().MarshalInterfaceJSON(updateParams)
+	suite.Require().NoError(err)
+
+	_, _, err = suite.dispatchSubmitAdminProposal(bindings.AdminProposal{
+		ProposalExecuteMessage: &bindings.ProposalExecuteMessage{
+			Title:       "Tax params",
+			Description: "Lower the fee rate",
+			Message:     string(message),
+		},
+	})
+	suite.Require().ErrorIs(err, govtypes.ErrInvalidSigner)
+}
+
+func (suite *NolusMessengerTestSuite) TestSubmitAdminProposalInvalid() {
+	_, _, err := suite.dispatchSubmitAdminProposal(bindings.AdminProposal{})
+	suite.Require().ErrorIs(err, sdkerrors.ErrInvalidRequest)
+
+	_, _, err = suite.dispatchSubmitAdminProposal(bindings.AdminProposal{
+		CancelSoftwareUpgradeProposal: &bindings.CancelSoftwareUpgradeProposal{Title: "Cancel", Description: "Cancel"},
+		SoftwareUpgradeProposal: &bindings.SoftwareUpgradeProposal{
+			Title:       "Upgrade",
+			Description: "Upgrade",
+			Plan:        bindings.Plan{Name: "v1.0.0", Height: 100},
+		},
+	})
+	suite.Require().ErrorIs(err, sdkerrors.ErrInvalidRequest)
+
+	_, _, err = suite.dispatchSubmitAdminProposal(bindings.AdminProposal{
+		ProposalExecuteMessage: &bindings.ProposalExecuteMessage{Title: "Invalid", Description: "Invalid", Message: "{}"},
+	})
+	suite.Require().Error(err)
+}
+
+func (suite *NolusMessengerTestSuite) TestCreateVestingAccount() {
+	toAddress := wasmkeeper.RandomAccountAddress(suite.T())
+	amount := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000))
+	now := suite.ctx.BlockTime().Unix()
+
+	// the contract is the funder of the vesting account
+	createVestingAccount := bindings.CreateVestingAccount{
+		ToAddress: toAddress.String(),
+		Amount:    amount,
+		StartTime: now - 50,
+		EndTime:   now + 50,
+	}
+	suite.fundAccount(suite.contractAddress, amount)
+	events, data, err := suite.dispatchCreateVestingAccount(createVestingAccount)
+	suite.Require().NoError(err)
+	suite.Require().Nil(events)
+
+	var response bindings.CreateVestingAccountResponse
+	suite.Require().NoError(json.Unmarshal(data[0], &response))
+	suite.Require().Equal(bindings.CreateVestingAccountResponse{
+		Address:  toAddress.String(),
+		Vested:   sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 500)),
+		Unvested: sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 500)),
+	}, response)
+
+	acc, ok := suite.app.AccountKeeper.GetAccount(suite.ctx, toAddress).(*authvestingtypes.ContinuousVestingAccount)
+	suite.Require().True(ok)
+	suite.Require().Equal(amount, acc.GetOriginalVesting())
+	suite.Require().True(suite.app.VestingsKeeper.HasVestingAccount(suite.ctx, toAddress))
+	suite.Require().True(suite.app.BankKeeper.GetAllBalances(suite.ctx, suite.contractAddress).IsZero())
+
+	// a second schedule is only added when merging
+	suite.fundAccount(suite.contractAddress, amount)
+	createVestingAccount.StartTime = now
+	createVestingAccount.EndTime = now + 100
+	createVestingAccount.Delayed = true
+	_, _, err = suite.dispatchCreateVestingAccount(createVestingAccount)
+	suite.Require().ErrorIs(err, sdkerrors.ErrInvalidRequest)
+
+	// the owner of the account signs a merge, so the contract may not merge
+	// into the account even if it funded it
+	createVestingAccount.Merge = true
+	_, _, err = suite.dispatchCreateVestingAccount(createVestingAccount)
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+	_, ok = suite.app.AccountKeeper.GetAccount(suite.ctx, toAddress).(*authvestingtypes.ContinuousVestingAccount)
+	suite.Require().True(ok)
+}
+
+func (suite *NolusMessengerTestSuite) TestCreateVestingAccountInvalid() {
+	createVestingAccount := bindings.CreateVestingAccount{
+		ToAddress: "invalid",
+		Amount:    sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)),
+		StartTime: suite.ctx.BlockTime().Unix(),
+		EndTime:   suite.ctx.BlockTime().Unix() + 100,
+	}
+	_, _, err := suite.dispatchCreateVestingAccount(createVestingAccount)
+	suite.Require().ErrorIs(err, sdkerrors.ErrInvalidAddress)
+
+	// the contract must hold the vesting coins
+	createVestingAccount.ToAddress = wasmkeeper.RandomAccountAddress(suite.T()).String()
+	_, _, err = suite.dispatchCreateVestingAccount(createVestingAccount)
+	suite.Require().ErrorIs(err, sdkerrors.ErrInsufficientFunds)
+
+	// the base account of another owner is not converted by the contract
+	owner := wasmkeeper.RandomAccountAddress(suite.T())
+	suite.fundAccount(owner, createVestingAccount.Amount)
+	suite.fundAccount(suite.contractAddress, createVestingAccount.Amount)
+	createVestingAccount.ToAddress = owner.String()
+	createVestingAccount.Merge = true
+	_, _, err = suite.dispatchCreateVestingAccount(createVestingAccount)
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+	_, ok := suite.app.AccountKeeper.GetAccount(suite.ctx, owner).(*authtypes.BaseAccount)
+	suite.Require().True(ok)
+}
+
+func (suite *NolusMessengerTestSuite) TestAddRemoveSchedule() {
+	params := crontypes.NewParams(suite.contractAddress.String(), crontypes.DefaultLimit)
+	suite.Require().NoError(suite.app.CronKeeper.SetParams(suite.ctx, params))
+
+	addSchedule := bindings.AddSchedule{
+		Name:           "tick",
+		Period:         10,
+		Contract:       suite.contractAddress.String(),
+		Msg:            `{"tick":{}}`,
+		GasLimit:       100_000,
+		ExecutionStage: "EXECUTION_STAGE_BEGIN_BLOCKER",
+	}
+	events, data, err := suite.dispatchCronMsg(bindings.NeutronMsg{AddSchedule: &addSchedule})
+	suite.Require().NoError(err)
+	suite.Require().Nil(events)
+	suite.Require().Equal([][]byte{[]byte("{}")}, data)
+
+	schedule, found := suite.app.CronKeeper.GetSchedule(suite.ctx, "tick")
+	suite.Require().True(found)
+	suite.Require().Equal(crontypes.Schedule{
+		Name:              "tick",
+		Period:            10,
+		Contract:          suite.contractAddress.String(),
+		Msg:               `{"tick":{}}`,
+		GasLimit:          100_000,
+		ExecutionStage:    crontypes.EXECUTION_STAGE_BEGIN_BLOCKER,
+		LastExecuteHeight: uint64(suite.ctx.BlockHeight()),
+	}, schedule)
+
+	// the schedules are executed at the end of the block by default
+	addSchedule.Name = "end"
+	addSchedule.ExecutionStage = ""
+	_, _, err = suite.dispatchCronMsg(bindings.NeutronMsg{AddSchedule: &addSchedule})
+	suite.Require().NoError(err)
+	schedule, _ = suite.app.CronKeeper.GetSchedule(suite.ctx, "end")
+	suite.Require().Equal(crontypes.EXECUTION_STAGE_END_BLOCKER, schedule.ExecutionStage)
+
+	_, data, err = suite.dispatchCronMsg(bindings.NeutronMsg{RemoveSchedule: &bindings.RemoveSchedule{Name: "tick"}})
+	suite.Require().NoError(err)
+	suite.Require().Equal([][]byte{[]byte("{}")}, data)
+	suite.Require().False(suite.app.CronKeeper.HasSchedule(suite.ctx, "tick"))
+}
+
+func (suite *NolusMessengerTestSuite) TestAddRemoveScheduleInvalid() {
+	addSchedule := bindings.AddSchedule{
+		Name:     "tick",
+		Period:   10,
+		Contract: suite.contractAddress.String(),
+		Msg:      `{"tick":{}}`,
+		GasLimit: 100_000,
+	}
+
+	// the contract is not the security address
+	_, _, err := suite.dispatchCronMsg(bindings.NeutronMsg{AddSchedule: &addSchedule})
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+	_, _, err = suite.dispatchCronMsg(bindings.NeutronMsg{RemoveSchedule: &bindings.RemoveSchedule{Name: "tick"}})
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	params := crontypes.NewParams(suite.contractAddress.String(), crontypes.DefaultLimit)
+	suite.Require().NoError(suite.app.CronKeeper.SetParams(suite.ctx, params))
+
+	addSchedule.ExecutionStage = "EXECUTION_STAGE_UNKNOWN"
+	_, _, err = suite.dispatchCronMsg(bindings.NeutronMsg{AddSchedule: &addSchedule})
+	suite.Require().ErrorIs(err, sdkerrors.ErrInvalidRequest)
+
+	// even the security address may not add sudo schedules
+	addSchedule.ExecutionStage = ""
+	addSchedule.Sudo = true
+	_, _, err = suite.dispatchCronMsg(bindings.NeutronMsg{AddSchedule: &addSchedule})
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+	suite.Require().False(suite.app.CronKeeper.HasSchedule(suite.ctx, "tick"))
+
+	addSchedule.Sudo = false
+	addSchedule.Msg = "not json"
+	_, _, err = suite.dispatchCronMsg(bindings.NeutronMsg{AddSchedule: &addSchedule})
+	suite.Require().ErrorIs(err, crontypes.ErrInvalidSchedule)
+
+	_, _, err = suite.dispatchCronMsg(bindings.NeutronMsg{RemoveSchedule: &bindings.RemoveSchedule{Name: "tick"}})
+	suite.Require().ErrorIs(err, crontypes.ErrScheduleNotFound)
+}
+
+func (suite *NolusMessengerTestSuite) TestTokenFactory() {
+	other := sdk.AccAddress("other_______________")
+	creationFee := suite.app.TokenFactoryKeeper.GetParams(suite.ctx).DenomCreationFee
+	suite.fundAccount(suite.contractAddress, creationFee)
+
+	events, data, err := suite.dispatchTokenFactoryMsg(bindings.NeutronMsg{CreateDenom: &bindings.CreateDenom{Subdenom: "lp"}})
+	suite.Require().NoError(err)
+	suite.Require().Nil(events)
+	denom := "factory/" + suite.contractAddress.String() + "/lp"
+	suite.Require().Equal([][]byte{[]byte(`{"denom":"` + denom + `"}`)}, data)
+
+	_, _, err = suite.dispatchTokenFactoryMsg(bindings.NeutronMsg{MintTokens: &bindings.MintTokens{Denom: denom, Amount: sdk.NewInt(100)}})
+	suite.Require().NoError(err)
+	_, _, err = suite.dispatchTokenFactoryMsg(bindings.NeutronMsg{MintTokens: &bindings.MintTokens{Denom: denom, Amount: sdk.NewInt(50), MintToAddress: other.String()}})
+	suite.Require().NoError(err)
+	_, _, err = suite.dispatchTokenFactoryMsg(bindings.NeutronMsg{BurnTokens: &bindings.BurnTokens{Denom: denom, Amount: sdk.NewInt(30)}})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt64Coin(denom, 70), suite.app.BankKeeper.GetBalance(suite.ctx, suite.contractAddress, denom))
+	suite.Require().Equal(sdk.NewInt64Coin(denom, 50), suite.app.BankKeeper.GetBalance(suite.ctx, other, denom))
+
+	metadata := bindings.SetDenomMetadata{}
+	metadata.DenomUnits = []*banktypes.DenomUnit{{Denom: denom, Exponent: 0}, {Denom: "lpt", Exponent: 6}}
+	metadata.Base = denom
+	metadata.Display = "lpt"
+	metadata.Name = "LP"
+	metadata.Symbol = "LP"
+	_, _, err = suite.dispatchTokenFactoryMsg(bindings.NeutronMsg{SetDenomMetadata: &metadata})
+	suite.Require().NoError(err)
+	bankMetadata, _ := suite.app.BankKeeper.GetDenomMetaData(suite.ctx, denom)
+	suite.Require().Equal(metadata.Metadata, bankMetadata)
+
+	// the reflect contract has no sudo entry point, so it rejects every send as a before send hook
+	_, _, err = suite.dispatchTokenFactoryMsg(bindings.NeutronMsg{SetBeforeSendHook: &bindings.SetBeforeSendHook{Denom: denom, ContractAddr: suite.contractAddress.String()}})
+	suite.Require().NoError(err)
+	_, _, err = suite.dispatchTokenFactoryMsg(bindings.NeutronMsg{MintTokens: &bindings.MintTokens{Denom: denom, Amount: sdk.NewInt(100)}})
+	suite.Require().ErrorIs(err, tokenfactorytypes.ErrBeforeSendHookRejected)
+	_, _, err = suite.dispatchTokenFactoryMsg(bindings.NeutronMsg{SetBeforeSendHook: &bindings.SetBeforeSendHook{Denom: denom}})
+	suite.Require().NoError(err)
+
+	_, _, err = suite.dispatchTokenFactoryMsg(bindings.NeutronMsg{ChangeAdmin: &bindings.ChangeAdmin{Denom: denom, NewAdminAddress: other.String()}})
+	suite.Require().NoError(err)
+	authorityMetadata, _ := suite.app.TokenFactoryKeeper.GetAuthorityMetadata(suite.ctx, denom)
+	suite.Require().Equal(other.String(), authorityMetadata.Admin)
+}
+
+func (suite *NolusMessengerTestSuite) TestTokenFactoryInvalid() {
+	denom := "factory/" + suite.contractAddress.String() + "/lp"
+
+	// the contract pays the denom creation fee
+	_, _, err := suite.dispatchTokenFactoryMsg(bindings.NeutronMsg{CreateDenom: &bindings.CreateDenom{Subdenom: "lp"}})
+	suite.Require().ErrorIs(err, sdkerrors.ErrInsufficientFunds)
+	_, _, err = suite.dispatchTokenFactoryMsg(bindings.NeutronMsg{CreateDenom: &bindings.CreateDenom{}})
+	suite.Require().ErrorIs(err, tokenfactorytypes.ErrInvalidDenom)
+
+	_, _, err = suite.dispatchTokenFactoryMsg(bindings.NeutronMsg{MintTokens: &bindings.MintTokens{Denom: denom}})
+	suite.Require().ErrorIs(err, sdkerrors.ErrInvalidCoins)
+	_, _, err = suite.dispatchTokenFactoryMsg(bindings.NeutronMsg{MintTokens: &bindings.MintTokens{Denom: denom, Amount: sdk.NewInt(100)}})
+	suite.Require().ErrorIs(err, tokenfactorytypes.ErrDenomNotFound)
+	_, _, err = suite.dispatchTokenFactoryMsg(bindings.NeutronMsg{BurnTokens: &bindings.BurnTokens{Denom: "unls", Amount: sdk.NewInt(100)}})
+	suite.Require().ErrorIs(err, tokenfactorytypes.ErrInvalidDenom)
+	_, _, err = suite.dispatchTokenFactoryMsg(bindings.NeutronMsg{ChangeAdmin: &bindings.ChangeAdmin{Denom: denom, NewAdminAddress: "invalid_address"}})
+	suite.Require().Error(err)
+}
+
+// submitAdminProposal submits the proposal from the contract and returns the stored proposal.
+func (suite *NolusMessengerTestSuite) TestCustomMessagesFiltered() {
+	blocked := []string{"/ibc.applications.transfer.v1.MsgTransfer", sdk.MsgTypeURL(&tokenfactorytypes.MsgCreateDenom{})}
+	params := msgfiltertypes.NewParams(blocked, "", msgfiltertypes.DefaultMaxEmergencyDuration)
+	suite.Require().NoError(suite.app.MsgFilterKeeper.SetParams(suite.ctx, params))
+
+	// the transfer is rejected before it reaches the transfer keeper
+	fee := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1))
+	msg, err := json.Marshal(bindings.NeutronMsg{IBCTransfer: &transferwrappertypes.MsgTransfer{
+		SourcePort:       "transfer",
+		SourceChannel:    "channel-0",
+		Token:            sdk.NewInt64Coin(sdk.DefaultBondDenom, 100),
+		Receiver:         wasmkeeper.RandomAccountAddress(suite.T()).String(),
+		TimeoutTimestamp: uint64(suite.ctx.BlockTime().Add(time.Hour).UnixNano()),
+		Fee:              feerefundertypes.Fee{AckFee: fee, TimeoutFee: fee},
+	}})
+	suite.Require().NoError(err)
+	_, _, err = suite.messenger.DispatchMsg(suite.ctx, suite.contractAddress, "", wasmvmtypes.CosmosMsg{Custom: msg})
+	suite.Require().ErrorIs(err, msgfiltertypes.ErrMessageBlocked)
+
+	_, _, err = suite.dispatchTokenFactoryMsg(bindings.NeutronMsg{CreateDenom: &bindings.CreateDenom{Subdenom: "lp"}})
+	suite.Require().ErrorIs(err, msgfiltertypes.ErrMessageBlocked)
+	suite.Require().Empty(suite.app.TokenFactoryKeeper.GetDenomsFromCreator(suite.ctx, suite.contractAddress.String()))
+}
+
+func (suite *NolusMessengerTestSuite) submitAdminProposal(adminProposal bindings.AdminProposal) govv1.Proposal {
+	events, data, err := suite.dispatchSubmitAdminProposal(adminProposal)
+	suite.Require().NoError(err)
+	suite.Require().Nil(events)
+
+	var response bindings.SubmitAdminProposalResponse
+	suite.Require().NoError(json.Unmarshal(data[0], &response))
+
+	proposal, found := suite.app.GovKeeper.GetProposal(suite.ctx, response.ProposalId)
+	suite.Require().True(found)
+	suite.Require().Equal(suite.contractAddress.String(), proposal.Proposer)
+	suite.Require().Len(proposal.Messages, 1)
+
+	return proposal
+}
+
+func (suite *NolusMessengerTestSuite) dispatchSubmitAdminProposal(adminProposal bindings.AdminProposal) ([]sdk.Event, [][]byte, error) {
+	deposit := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000))
+	suite.fundAccount(suite.contractAddress, deposit)
+
+	msg, err := json.Marshal(bindings.NeutronMsg{
+		SubmitAdminProposal: &bindings.SubmitAdminProposal{
+			AdminProposal:  adminProposal,
+			InitialDeposit: deposit,
+		},
+	})
+	suite.Require().NoError(err)
+
+	return suite.messenger.DispatchMsg(suite.ctx, suite.contractAddress, "", wasmvmtypes.CosmosMsg{Custom: msg})
+}
+
+func (suite *NolusMessengerTestSuite) dispatchCreateVestingAccount(createVestingAccount bindings.CreateVestingAccount) ([]sdk.Event, [][]byte, error) {
+	msg, err := json.Marshal(bindings.NeutronMsg{CreateVestingAccount: &createVestingAccount})
+	suite.Require().NoError(err)
+
+	return suite.messenger.DispatchMsg(suite.ctx, suite.contractAddress, "", wasmvmtypes.CosmosMsg{Custom: msg})
+}
+
+func (suite *NolusMessengerTestSuite) dispatchCronMsg(cronMsg bindings.NeutronMsg) ([]sdk.Event, [][]byte, error) {
+	msg, err := json.Marshal(cronMsg)
+	suite.Require().NoError(err)
+
+	return suite.messenger.DispatchMsg(suite.ctx, suite.contractAddress, "", wasmvmtypes.CosmosMsg{Custom: msg})
+}
+
+func (suite *NolusMessengerTestSuite) dispatchTokenFactoryMsg(tokenFactoryMsg bindings.NeutronMsg) ([]sdk.Event, [][]byte, error) {
+	msg, err := json.Marshal(tokenFactoryMsg)
+	suite.Require().NoError(err)
+
+	return suite.messenger.DispatchMsg(suite.ctx, suite.contractAddress, "", wasmvmtypes.CosmosMsg{Custom: msg})
+}
+
+func govAuthority() string {
+	return authtypes.NewModuleAddress(govtypes.ModuleName).String()
+}
+
+func TestNolusMessengerTestSuite(t *testing.T) {
+	suite.Run(t, new(NolusMessengerTestSuite))
+}
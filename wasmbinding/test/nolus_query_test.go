@@ -0,0 +1,173 @@
+package test
+
+import (
+	"encoding/json"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/Nolus-Protocol/nolus-core/wasmbinding"
+	"github.com/Nolus-Protocol/nolus-core/wasmbinding/bindings"
+	minttypes "github.com/Nolus-Protocol/nolus-core/x/mint/types"
+	taxtypes "github.com/Nolus-Protocol/nolus-core/x/tax/types"
+	tokenfactorytypes "github.com/Nolus-Protocol/nolus-core/x/tokenfactory/types"
+)
+
+type NolusQuerierTestSuite struct {
+	NolusTestSuite
+
+	querier func(ctx sdk.Context, request json.RawMessage) ([]byte, error)
+}
+
+func (suite *NolusQuerierTestSuite) SetupTest() {
+	suite.NolusTestSuite.SetupTest()
+	suite.querier = wasmbinding.CustomQuerier(wasmbinding.NewQueryPlugin(
+		suite.app.InterchainTxsKeeper,
+		suite.app.InterchainQueriesKeeper,
+		suite.app.FeeRefunderKeeper,
+		suite.app.ContractManagerKeeper,
+		suite.app.MintKeeper,
+		suite.app.TaxKeeper,
+		suite.app.TokenFactoryKeeper,
+	))
+}
+
+func (suite *NolusQuerierTestSuite) TestMintState() {
+	minter := minttypes.NewMinter(sdkmath.LegacyMustNewDecFromStr("1.5"), sdkmath.NewUint(1000), sdkmath.ZeroUint(), sdkmath.NewUint(300))
+	suite.app.MintKeeper.SetMinter(suite.ctx, minter)
+
+	var resp bindings.QueryMintStateResponse
+	suite.Require().NoError(suite.queryNolus(bindings.NolusQuery{MintState: &bindings.QueryMintStateRequest{}}, &resp))
+	suite.Require().Equal(minter.TotalMinted, resp.TotalMinted)
+	suite.Require().Equal(minter.AnnualInflation, resp.AnnualInflation)
+	suite.Require().Equal(minter.NormTimePassed, resp.NormTimePassed)
+
+	// the raw response is readable by contracts without custom decoding
+	bz, err := suite.querier(suite.ctx, []byte(`{"mint_state":{}}`))
+	suite.Require().NoError(err)
+	suite.Require().JSONEq(`{"total_minted":"1000","annual_inflation":"300","norm_time_passed":"1.500000000000000000","paused":false}`, string(bz))
+}
+
+func (suite *NolusQuerierTestSuite) TestTaxParams() {
+	var resp bindings.QueryTaxParamsResponse
+	suite.Require().NoError(suite.queryNolus(bindings.NolusQuery{TaxParams: &bindings.QueryTaxParamsRequest{}}, &resp))
+
+	expected := taxtypes.DefaultParams()
+	suite.Require().Equal(expected.FeeRate, resp.FeeRate)
+	suite.Require().Equal(sdkmath.LegacyNewDecWithPrec(int64(expected.FeeRate), 2), resp.TaxRate)
+	suite.Require().Equal(expected.BaseDenom, resp.BaseDenom)
+	suite.Require().Equal(expected.ContractAddress, resp.TreasuryAddress)
+	suite.Require().Equal(expected.BurnRatio, resp.BurnRatio)
+	suite.Require().Len(resp.FeeParams, len(expected.FeeParams))
+	for i, feeParam := range expected.FeeParams {
+		suite.Require().Equal(feeParam.OracleAddress, resp.FeeParams[i].OracleAddress)
+		suite.Require().Equal(feeParam.ProfitAddress, resp.FeeParams[i].ProfitAddress)
+		suite.Require().Len(resp.FeeParams[i].AcceptedDenoms, len(feeParam.AcceptedDenoms))
+		for j, denom := range feeParam.AcceptedDenoms {
+			suite.Require().Equal(bindings.DenomTicker{Denom: denom.Denom, Ticker: denom.Ticker}, resp.FeeParams[i].AcceptedDenoms[j])
+		}
+	}
+
+	// the tax rate is a decimal string, as the amounts of the other queries
+	bz, err := suite.querier(suite.ctx, []byte(`{"tax_params":{}}`))
+	suite.Require().NoError(err)
+	var raw map[string]interface{}
+	suite.Require().NoError(json.Unmarshal(bz, &raw))
+	suite.Require().Equal("0.400000000000000000", raw["tax_rate"])
+	suite.Require().Equal(expected.ContractAddress, raw["treasury_address"])
+	suite.Require().Equal("0.000000000000000000", raw["burn_ratio"])
+}
+
+func (suite *NolusQuerierTestSuite) TestFeeEstimate() {
+	taxParams := taxtypes.DefaultParams()
+
+	// the tax of fees paid in the base denom goes to the treasury
+	var resp bindings.QueryFeeEstimateResponse
+	fee := sdk.NewInt64Coin(taxParams.BaseDenom, 1000)
+	suite.Require().NoError(suite.queryNolus(bindings.NolusQuery{FeeEstimate: &bindings.QueryFeeEstimateRequest{Fee: fee}}, &resp))
+	suite.Require().Equal(sdk.NewInt64Coin(taxParams.BaseDenom, 400), resp.Tax)
+	suite.Require().Equal(taxParams.ContractAddress, resp.Recipient)
+	suite.Require().Equal(sdk.NewInt64Coin(taxParams.BaseDenom, 600), resp.FeeAfterTax)
+
+	// the tax of fees paid in an accepted denom goes to the profit address
+	denom := taxParams.FeeParams[0].AcceptedDenoms[0].Denom
+	fee = sdk.NewInt64Coin(denom, 1001)
+	suite.Require().NoError(suite.queryNolus(bindings.NolusQuery{FeeEstimate: &bindings.QueryFeeEstimateRequest{Fee: fee}}, &resp))
+	suite.Require().Equal(sdk.NewInt64Coin(denom, 400), resp.Tax)
+	suite.Require().Equal(taxParams.FeeParams[0].ProfitAddress, resp.Recipient)
+	suite.Require().Equal(sdk.NewInt64Coin(denom, 601), resp.FeeAfterTax)
+	suite.Require().Equal(sdk.NewInt64Coin(denom, 0), resp.Burned)
+
+	// the burned share of the base denom tax does not reach the treasury
+	taxParams.BurnRatio = sdkmath.LegacyNewDecWithPrec(25, 2)
+	suite.Require().NoError(suite.app.TaxKeeper.SetParams(suite.ctx, taxParams))
+	fee = sdk.NewInt64Coin(taxParams.BaseDenom, 1000)
+	suite.Require().NoError(suite.queryNolus(bindings.NolusQuery{FeeEstimate: &bindings.QueryFeeEstimateRequest{Fee: fee}}, &resp))
+	suite.Require().Equal(sdk.NewInt64Coin(taxParams.BaseDenom, 400), resp.Tax)
+	suite.Require().Equal(sdk.NewInt64Coin(taxParams.BaseDenom, 100), resp.Burned)
+	suite.Require().Equal(sdk.NewInt64Coin(taxParams.BaseDenom, 600), resp.FeeAfterTax)
+
+	fee = sdk.NewInt64Coin("unknown", 1000)
+	err := suite.queryNolus(bindings.NolusQuery{FeeEstimate: &bindings.QueryFeeEstimateRequest{Fee: fee}}, &resp)
+	suite.Require().ErrorIs(err, taxtypes.ErrInvalidFeeDenom)
+}
+
+func (suite *NolusQuerierTestSuite) TestTokenFactory() {
+	creator := sdk.AccAddress("creator_____________")
+	hook := sdk.AccAddress("hook________________")
+	denom := "factory/" + creator.String() + "/lp"
+	suite.Require().NoError(suite.app.TokenFactoryKeeper.InitDenom(suite.ctx, tokenfactorytypes.GenesisDenom{
+		Denom:             denom,
+		AuthorityMetadata: tokenfactorytypes.DenomAuthorityMetadata{Admin: creator.String()},
+		BeforeSendHook:    hook.String(),
+	}))
+
+	var fullDenom bindings.QueryFullDenomResponse
+	suite.Require().NoError(suite.queryNolus(bindings.NolusQuery{FullDenom: &bindings.QueryFullDenomRequest{CreatorAddr: creator.String(), Subdenom: "lp"}}, &fullDenom))
+	suite.Require().Equal(denom, fullDenom.Denom)
+
+	var admin bindings.QueryDenomAdminResponse
+	suite.Require().NoError(suite.queryNolus(bindings.NolusQuery{DenomAdmin: &bindings.QueryDenomAdminRequest{Denom: denom}}, &admin))
+	suite.Require().Equal(creator.String(), admin.Admin)
+	err := suite.queryNolus(bindings.NolusQuery{DenomAdmin: &bindings.QueryDenomAdminRequest{Denom: "factory/" + creator.String() + "/unknown"}}, &admin)
+	suite.Require().ErrorIs(err, tokenfactorytypes.ErrDenomNotFound)
+
+	var denoms bindings.QueryDenomsFromCreatorResponse
+	suite.Require().NoError(suite.queryNolus(bindings.NolusQuery{DenomsFromCreator: &bindings.QueryDenomsFromCreatorRequest{Creator: creator.String()}}, &denoms))
+	suite.Require().Equal([]string{denom}, denoms.Denoms)
+
+	var beforeSendHook bindings.QueryBeforeSendHookResponse
+	suite.Require().NoError(suite.queryNolus(bindings.NolusQuery{BeforeSendHook: &bindings.QueryBeforeSendHookRequest{Denom: denom}}, &beforeSendHook))
+	suite.Require().Equal(hook.String(), beforeSendHook.ContractAddr)
+
+	// the fee amounts are strings, as the coins of the other queries
+	bz, err := suite.querier(suite.ctx, []byte(`{"denom_creation_fee":{}}`))
+	suite.Require().NoError(err)
+	fee := suite.app.TokenFactoryKeeper.GetParams(suite.ctx).DenomCreationFee[0]
+	suite.Require().JSONEq(`{"fee":[{"denom":"`+fee.Denom+`","amount":"`+fee.Amount.String()+`"}]}`, string(bz))
+}
+
+func (suite *NolusQuerierTestSuite) TestUnknownQuery() {
+	// queries which are not nolus queries are handled as neutron queries
+	_, err := suite.querier(suite.ctx, []byte(`{"unknown":{}}`))
+	suite.Require().ErrorIs(err, wasmvmtypes.UnsupportedRequest{Kind: "unknown neutron query type"})
+}
+
+func (suite *NolusQuerierTestSuite) queryNolus(request bindings.NolusQuery, response interface{}) error {
+	requestBz, err := json.Marshal(request)
+	suite.Require().NoError(err)
+
+	responseBz, err := suite.querier(suite.ctx, requestBz)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(responseBz, response)
+}
+
+func TestNolusQuerierTestSuite(t *testing.T) {
+	suite.Run(t, new(NolusQuerierTestSuite))
+}
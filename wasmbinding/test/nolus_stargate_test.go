@@ -0,0 +1,103 @@
+package test
+
+import (
+	"encoding/json"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/Nolus-Protocol/nolus-core/wasmbinding"
+	minttypes "github.com/Nolus-Protocol/nolus-core/x/mint/types"
+	taxtypes "github.com/Nolus-Protocol/nolus-core/x/tax/types"
+	vestingstypes "github.com/Nolus-Protocol/nolus-core/x/vestings/types"
+)
+
+type NolusStargateTestSuite struct {
+	NolusTestSuite
+
+	querier func(ctx sdk.Context, request *wasmvmtypes.StargateQuery) ([]byte, error)
+}
+
+func (suite *NolusStargateTestSuite) SetupTest() {
+	suite.NolusTestSuite.SetupTest()
+	suite.querier = wasmkeeper.AcceptListStargateQuerier(wasmbinding.AcceptedStargateQueries(), suite.app.GRPCQueryRouter(), suite.app.AppCodec())
+}
+
+func (suite *NolusStargateTestSuite) TestAcceptedQueriesAreRouted() {
+	for path := range wasmbinding.AcceptedStargateQueries() {
+		suite.Require().NotNil(suite.app.GRPCQueryRouter().Route(path), path)
+	}
+}
+
+func (suite *NolusStargateTestSuite) TestTaxParams() {
+	bz := suite.queryStargate("/nolus.tax.v1beta1.Query/Params", &taxtypes.QueryParamsRequest{})
+
+	var resp taxtypes.QueryParamsResponse
+	suite.Require().NoError(suite.app.AppCodec().UnmarshalJSON(bz, &resp))
+	suite.Require().Equal(suite.app.TaxKeeper.GetParams(suite.ctx), resp.Params)
+}
+
+func (suite *NolusStargateTestSuite) TestMintState() {
+	minter := minttypes.NewMinter(sdkmath.LegacyMustNewDecFromStr("1.5"), sdkmath.NewUint(1000), sdkmath.ZeroUint(), sdkmath.NewUint(300))
+	suite.app.MintKeeper.SetMinter(suite.ctx, minter)
+
+	bz := suite.queryStargate("/nolus.mint.v1beta1.Query/MintState", &minttypes.QueryMintStateRequest{})
+	suite.Require().JSONEq(`{"norm_time_passed":"1.500000000000000000","total_minted":"1000","paused":false}`, string(bz))
+
+	bz = suite.queryStargate("/nolus.mint.v1beta1.Query/AnnualInflation", &minttypes.QueryAnnualInflationRequest{})
+	var resp minttypes.QueryAnnualInflationResponse
+	suite.Require().NoError(suite.app.AppCodec().UnmarshalJSON(bz, &resp))
+}
+
+func (suite *NolusStargateTestSuite) TestBankBalance() {
+	addr := wasmkeeper.RandomAccountAddress(suite.T())
+	suite.fundAccount(addr, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)))
+
+	bz := suite.queryStargate("/cosmos.bank.v1beta1.Query/Balance", &banktypes.QueryBalanceRequest{Address: addr.String(), Denom: sdk.DefaultBondDenom})
+	suite.Require().JSONEq(`{"balance":{"denom":"`+sdk.DefaultBondDenom+`","amount":"1000"}}`, string(bz))
+}
+
+func (suite *NolusStargateTestSuite) TestVestingsBalances() {
+	_, err := suite.querier(suite.ctx, &wasmvmtypes.StargateQuery{
+		Path: "/nolus.vestings.v1beta1.Query/Balances",
+		Data: suite.app.AppCodec().MustMarshal(&vestingstypes.QueryBalancesRequest{Address: wasmkeeper.RandomAccountAddress(suite.T()).String()}),
+	})
+	// the query is routed to the vestings module, which does not know the account
+	suite.Require().ErrorContains(err, "not found")
+
+	bz := suite.queryStargate("/nolus.vestings.v1beta1.Query/Params", &vestingstypes.QueryParamsRequest{})
+	var resp vestingstypes.QueryParamsResponse
+	suite.Require().NoError(suite.app.AppCodec().UnmarshalJSON(bz, &resp))
+	suite.Require().Equal(suite.app.VestingsKeeper.GetParams(suite.ctx).MaxScheduleLength, resp.Params.MaxScheduleLength)
+}
+
+func (suite *NolusStargateTestSuite) TestRejectedQuery() {
+	_, err := suite.querier(suite.ctx, &wasmvmtypes.StargateQuery{
+		Path: "/cosmos.staking.v1beta1.Query/Validators",
+		Data: suite.app.AppCodec().MustMarshal(&stakingtypes.QueryValidatorsRequest{}),
+	})
+	suite.Require().ErrorAs(err, &wasmvmtypes.UnsupportedRequest{})
+}
+
+// queryStargate sends the request to the path and returns the JSON response.
+func (suite *NolusStargateTestSuite) queryStargate(path string, request codec.ProtoMarshaler) json.RawMessage {
+	bz, err := suite.querier(suite.ctx, &wasmvmtypes.StargateQuery{
+		Path: path,
+		Data: suite.app.AppCodec().MustMarshal(request),
+	})
+	suite.Require().NoError(err)
+	suite.Require().True(json.Valid(bz))
+
+	return bz
+}
+
+func TestNolusStargateTestSuite(t *testing.T) {
+	suite.Run(t, new(NolusStargateTestSuite))
+}
@@ -0,0 +1,66 @@
+package test
+
+import (
+	"os"
+	"time"
+
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	"github.com/stretchr/testify/suite"
+
+	neutronapp "github.com/neutron-org/neutron/app"
+
+	"github.com/Nolus-Protocol/nolus-core/app"
+	"github.com/Nolus-Protocol/nolus-core/app/params"
+	simulationapp "github.com/Nolus-Protocol/nolus-core/testutil/simapp"
+)
+
+// NolusTestSuite runs the nolus bindings against a nolus app.
+type NolusTestSuite struct {
+	suite.Suite
+
+	app *app.App
+	ctx sdk.Context
+}
+
+func (suite *NolusTestSuite) SetupSuite() {
+	// the neutron test helpers set their own address prefixes, so the
+	// addresses cached with them may not be reused by the nolus app
+	sdk.SetAddrCacheEnabled(false)
+	params.SetAddressPrefixes()
+}
+
+func (suite *NolusTestSuite) TearDownSuite() {
+	neutronapp.GetDefaultConfig()
+	sdk.SetAddrCacheEnabled(true)
+}
+
+func (suite *NolusTestSuite) SetupTest() {
+	nolusApp, err := simulationapp.TestSetup(suite.T())
+	suite.Require().NoError(err)
+
+	suite.app = nolusApp
+	suite.ctx = nolusApp.BaseApp.NewContext(false, tmproto.Header{Height: 2, Time: time.Now()})
+}
+
+// instantiateReflectContract stores and instantiates the reflect contract.
+func (suite *NolusTestSuite) instantiateReflectContract() sdk.AccAddress {
+	owner := wasmkeeper.RandomAccountAddress(suite.T())
+	wasmCode, err := os.ReadFile("../testdata/reflect.wasm")
+	suite.Require().NoError(err)
+
+	contractKeeper := wasmkeeper.NewDefaultPermissionKeeper(suite.app.WasmKeeper)
+	codeID, _, err := contractKeeper.Create(suite.ctx, owner, wasmCode, nil)
+	suite.Require().NoError(err)
+
+	contractAddress, _, err := contractKeeper.Instantiate(suite.ctx, codeID, owner, owner, []byte("{}"), "reflect", nil)
+	suite.Require().NoError(err)
+
+	return contractAddress
+}
+
+func (suite *NolusTestSuite) fundAccount(addr sdk.AccAddress, amounts sdk.Coins) {
+	suite.Require().NoError(banktestutil.FundAccount(suite.app.BankKeeper, suite.ctx, addr, amounts))
+}
@@ -2,12 +2,21 @@ package wasmbinding
 
 import (
 	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	"github.com/cosmos/cosmos-sdk/codec"
+	govkeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
 
 	contractmanagerkeeper "github.com/neutron-org/neutron/x/contractmanager/keeper"
 	feerefunderkeeper "github.com/neutron-org/neutron/x/feerefunder/keeper"
 	interchainqueriesmodulekeeper "github.com/neutron-org/neutron/x/interchainqueries/keeper"
 	interchaintransactionsmodulekeeper "github.com/neutron-org/neutron/x/interchaintxs/keeper"
 	transfer "github.com/neutron-org/neutron/x/transfer/keeper"
+
+	cronkeeper "github.com/Nolus-Protocol/nolus-core/x/cron/keeper"
+	mintkeeper "github.com/Nolus-Protocol/nolus-core/x/mint/keeper"
+	msgfilterkeeper "github.com/Nolus-Protocol/nolus-core/x/msgfilter/keeper"
+	taxkeeper "github.com/Nolus-Protocol/nolus-core/x/tax/keeper"
+	tokenfactorykeeper "github.com/Nolus-Protocol/nolus-core/x/tokenfactory/keeper"
+	vestingskeeper "github.com/Nolus-Protocol/nolus-core/x/vestings/keeper"
 )
 
 // RegisterCustomPlugins returns wasmkeeper.Option that we can use to connect handlers for implemented custom queries and messages to the App.
@@ -17,14 +26,24 @@ func RegisterCustomPlugins(
 	transfer transfer.KeeperTransferWrapper,
 	feeRefunderKeeper *feerefunderkeeper.Keeper,
 	contractmanagerKeeper *contractmanagerkeeper.Keeper,
+	mintKeeper *mintkeeper.Keeper,
+	taxKeeper *taxkeeper.Keeper,
+	govKeeper *govkeeper.Keeper,
+	vestingsKeeper *vestingskeeper.Keeper,
+	cronKeeper *cronkeeper.Keeper,
+	msgFilterKeeper *msgfilterkeeper.Keeper,
+	tokenFactoryKeeper *tokenfactorykeeper.Keeper,
+	queryRouter wasmkeeper.GRPCQueryRouter,
+	cdc codec.Codec,
 ) []wasmkeeper.Option {
-	wasmQueryPlugin := NewQueryPlugin(ictxKeeper, icqKeeper, feeRefunderKeeper, contractmanagerKeeper)
+	wasmQueryPlugin := NewQueryPlugin(ictxKeeper, icqKeeper, feeRefunderKeeper, contractmanagerKeeper, mintKeeper, taxKeeper, tokenFactoryKeeper)
 
 	queryPluginOpt := wasmkeeper.WithQueryPlugins(&wasmkeeper.QueryPlugins{
-		Custom: CustomQuerier(wasmQueryPlugin),
+		Custom:   CustomQuerier(wasmQueryPlugin),
+		Stargate: wasmkeeper.AcceptListStargateQuerier(AcceptedStargateQueries(), queryRouter, cdc),
 	})
 	messageHandlerDecoratorOpt := wasmkeeper.WithMessageHandlerDecorator(
-		CustomMessageDecorator(ictxKeeper, icqKeeper, transfer, contractmanagerKeeper),
+		CustomMessageDecorator(ictxKeeper, icqKeeper, transfer, contractmanagerKeeper, govKeeper, vestingsKeeper, cronKeeper, msgFilterKeeper, tokenFactoryKeeper, cdc),
 	)
 
 	return []wasmkeeper.Option{
@@ -0,0 +1,119 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+
+	"github.com/Nolus-Protocol/nolus-core/x/cron/types"
+)
+
+// Transaction command flags.
+const (
+	FlagSudo          = "sudo"
+	FlagScheduleGas   = "schedule-gas"
+	FlagScheduleStage = "stage"
+)
+
+// DefaultScheduleGas is the gas limit of the schedules added without the
+// '--schedule-gas' flag.
+const DefaultScheduleGas = uint64(500_000)
+
+func CmdAddSchedule() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-schedule [name] [period] [contract] [msg]",
+		Short: "Add a schedule executing a contract every period blocks.",
+		Long: `Add a schedule passing the JSON msg to the contract every period blocks, at
+the beginning or the end of the block as set by the '--stage' flag. The message
+is sent to the sudo entry point of the contract with '--sudo', or executed with
+the cron module account as the sender otherwise. Must be signed by the security
+address of the module.`,
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			period, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			sudo, err := cmd.Flags().GetBool(FlagSudo)
+			if err != nil {
+				return err
+			}
+
+			gasLimit, err := cmd.Flags().GetUint64(FlagScheduleGas)
+			if err != nil {
+				return err
+			}
+
+			stage, err := cmd.Flags().GetString(FlagScheduleStage)
+			if err != nil {
+				return err
+			}
+
+			executionStage, err := parseExecutionStage(stage)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgAddSchedule(clientCtx.GetFromAddress(), types.Schedule{
+				Name:           args[0],
+				Period:         period,
+				Contract:       args[2],
+				Msg:            args[3],
+				Sudo:           sudo,
+				GasLimit:       gasLimit,
+				ExecutionStage: executionStage,
+			})
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().Bool(FlagSudo, false, "Send the message to the sudo entry point of the contract")
+	cmd.Flags().Uint64(FlagScheduleGas, DefaultScheduleGas, "Gas limit of an execution of the schedule")
+	cmd.Flags().String(FlagScheduleStage, "end", "Stage of the block the schedule is executed at, 'begin' or 'end'")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdRemoveSchedule() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-schedule [name]",
+		Short: "Remove a schedule.",
+		Long:  `Remove a schedule. Must be signed by the security address of the module.`,
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRemoveSchedule(clientCtx.GetFromAddress(), args[0])
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func parseExecutionStage(stage string) (types.ExecutionStage, error) {
+	switch strings.ToLower(stage) {
+	case "begin":
+		return types.EXECUTION_STAGE_BEGIN_BLOCKER, nil
+	case "end":
+		return types.EXECUTION_STAGE_END_BLOCKER, nil
+	default:
+		return 0, fmt.Errorf("invalid execution stage %s, expected 'begin' or 'end'", stage)
+	}
+}
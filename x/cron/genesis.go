@@ -0,0 +1,25 @@
+package cron
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Nolus-Protocol/nolus-core/x/cron/keeper"
+	"github.com/Nolus-Protocol/nolus-core/x/cron/types"
+)
+
+// InitGenesis initializes the cron module's state from a provided genesis
+// state.
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState) {
+	if err := k.SetParams(ctx, genState.Params); err != nil {
+		ctx.Logger().Error("failed to set cron module params", "error", err)
+	}
+
+	for _, schedule := range genState.Schedules {
+		k.SetSchedule(ctx, schedule)
+	}
+}
+
+// ExportGenesis returns the cron module's exported genesis.
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	return types.NewGenesisState(k.GetParams(ctx), k.GetAllSchedules(ctx))
+}
@@ -0,0 +1,39 @@
+package cron_test
+
+import (
+	"testing"
+	"time"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Nolus-Protocol/nolus-core/app/params"
+	simulationapp "github.com/Nolus-Protocol/nolus-core/testutil/simapp"
+	"github.com/Nolus-Protocol/nolus-core/x/cron"
+	"github.com/Nolus-Protocol/nolus-core/x/cron/types"
+)
+
+func TestGenesis(t *testing.T) {
+	_ = params.SetAddressPrefixes()
+	app, err := simulationapp.TestSetup(t)
+	require.NoError(t, err)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{}).WithBlockTime(time.Now())
+
+	contract := sdk.AccAddress("contract____________").String()
+	genesisState := types.GenesisState{
+		Params: types.NewParams(sdk.AccAddress("security____________").String(), 3),
+		Schedules: []types.Schedule{
+			{Name: "a", Period: 1, Contract: contract, Msg: `{}`, GasLimit: 1000, LastExecuteHeight: 5},
+			{Name: "b", Period: 2, Contract: contract, Msg: `{}`, Sudo: true, GasLimit: 1000, ExecutionStage: types.EXECUTION_STAGE_END_BLOCKER},
+		},
+	}
+	require.NoError(t, genesisState.Validate())
+
+	cron.InitGenesis(ctx, *app.CronKeeper, genesisState)
+
+	got := cron.ExportGenesis(ctx, *app.CronKeeper)
+	require.NotNil(t, got)
+	require.Equal(t, genesisState.Params, got.Params)
+	require.Equal(t, genesisState.Schedules, got.Schedules)
+}
@@ -0,0 +1,94 @@
+package keeper
+
+import (
+	"sort"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	contractmanagerkeeper "github.com/neutron-org/neutron/x/contractmanager/keeper"
+
+	"github.com/Nolus-Protocol/nolus-core/x/cron/types"
+)
+
+// ExecuteReadySchedules executes the schedules of the stage which are due at
+// the current height. At most params.Limit schedules are executed in a block,
+// the ones waiting the longest first, and the rest are left for the next blocks.
+func (k Keeper) ExecuteReadySchedules(ctx sdk.Context, stage types.ExecutionStage) {
+	height := uint64(ctx.BlockHeight())
+
+	var ready []types.Schedule
+	k.IterateSchedules(ctx, func(schedule types.Schedule) bool {
+		if schedule.ExecutionStage == stage && schedule.IsReady(height) {
+			ready = append(ready, schedule)
+		}
+		return false
+	})
+
+	// the iteration is ordered by name, so the sort is deterministic
+	sort.SliceStable(ready, func(i, j int) bool {
+		return ready[i].LastExecuteHeight < ready[j].LastExecuteHeight
+	})
+
+	limit := k.GetParams(ctx).Limit
+	for i, schedule := range ready {
+		if uint64(i) >= limit {
+			break
+		}
+
+		k.executeSchedule(ctx, schedule)
+	}
+}
+
+// executeSchedule calls the contract of the schedule within its gas limit. The
+// state changes of a failed call are discarded and the failure is recorded in
+// the contractmanager module.
+func (k Keeper) executeSchedule(ctx sdk.Context, schedule types.Schedule) {
+	schedule.LastExecuteHeight = uint64(ctx.BlockHeight())
+	k.SetSchedule(ctx, schedule)
+
+	err := k.callContract(ctx, schedule)
+	if err != nil {
+		k.Logger(ctx).Error("failed to execute schedule", "name", schedule.Name, "contract", schedule.Contract, "error", err)
+		k.contractManagerKeeper.AddContractFailure(ctx, schedule.Contract, []byte(schedule.Msg), contractmanagerkeeper.RedactError(err).Error())
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeExecuteSchedule,
+			sdk.NewAttribute(types.AttributeKeyName, schedule.Name),
+			sdk.NewAttribute(types.AttributeKeyContract, schedule.Contract),
+			sdk.NewAttribute(types.AttributeKeySuccess, strconv.FormatBool(err == nil)),
+		),
+	)
+}
+
+func (k Keeper) callContract(ctx sdk.Context, schedule types.Schedule) (err error) {
+	cacheCtx, writeFn := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithGasMeter(storetypes.NewGasMeter(schedule.GasLimit))
+
+	defer func() {
+		if r := recover(); r != nil {
+			outOfGas, ok := r.(storetypes.ErrorOutOfGas)
+			if !ok {
+				panic(r)
+			}
+			err = errorsmod.Wrapf(sdkerrors.ErrOutOfGas, "out of gas in location: %s", outOfGas.Descriptor)
+		}
+	}()
+
+	contract := sdk.MustAccAddressFromBech32(schedule.Contract)
+	if schedule.Sudo {
+		_, err = k.wasmKeeper.Sudo(cacheCtx, contract, []byte(schedule.Msg))
+	} else {
+		_, err = k.wasmKeeper.Execute(cacheCtx, contract, k.GetModuleAccountAddress(), []byte(schedule.Msg), sdk.NewCoins())
+	}
+	if err != nil {
+		return err
+	}
+
+	writeFn()
+	return nil
+}
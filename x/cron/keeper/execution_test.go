@@ -0,0 +1,142 @@
+package keeper_test
+
+import (
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/Nolus-Protocol/nolus-core/x/cron/types"
+)
+
+func (s *KeeperTestSuite) TestExecuteReadySchedules() {
+	endBlocker := s.schedule("end", 2)
+	beginBlocker := s.schedule("begin", 3)
+	beginBlocker.ExecutionStage = types.EXECUTION_STAGE_BEGIN_BLOCKER
+	beginBlocker.Sudo = true
+	s.Require().NoError(s.keeper.AddSchedule(s.ctx, endBlocker))
+	s.Require().NoError(s.keeper.AddSchedule(s.ctx, beginBlocker))
+
+	s.nextBlock(1)
+	s.keeper.ExecuteReadySchedules(s.ctx, types.EXECUTION_STAGE_BEGIN_BLOCKER)
+	s.keeper.ExecuteReadySchedules(s.ctx, types.EXECUTION_STAGE_END_BLOCKER)
+	s.Require().Empty(s.wasmKeeper.calls)
+
+	// the end blocker schedule is executed with the module account as sender
+	s.nextBlock(1)
+	s.keeper.ExecuteReadySchedules(s.ctx, types.EXECUTION_STAGE_BEGIN_BLOCKER)
+	s.Require().Empty(s.wasmKeeper.calls)
+	s.keeper.ExecuteReadySchedules(s.ctx, types.EXECUTION_STAGE_END_BLOCKER)
+	s.Require().Equal([]mockCall{{
+		contract: endBlocker.Contract,
+		caller:   s.keeper.GetModuleAccountAddress().String(),
+		msg:      endBlocker.Msg,
+	}}, s.wasmKeeper.calls)
+
+	schedule, _ := s.keeper.GetSchedule(s.ctx, "end")
+	s.Require().Equal(uint64(s.ctx.BlockHeight()), schedule.LastExecuteHeight)
+
+	// the begin blocker schedule is sent to the sudo entry point
+	s.wasmKeeper.calls = nil
+	s.nextBlock(1)
+	s.keeper.ExecuteReadySchedules(s.ctx, types.EXECUTION_STAGE_BEGIN_BLOCKER)
+	s.keeper.ExecuteReadySchedules(s.ctx, types.EXECUTION_STAGE_END_BLOCKER)
+	s.Require().Equal([]mockCall{{
+		contract: beginBlocker.Contract,
+		msg:      beginBlocker.Msg,
+		sudo:     true,
+	}}, s.wasmKeeper.calls)
+
+	s.wasmKeeper.calls = nil
+	s.nextBlock(1)
+	s.keeper.ExecuteReadySchedules(s.ctx, types.EXECUTION_STAGE_END_BLOCKER)
+	s.Require().Len(s.wasmKeeper.calls, 1)
+}
+
+func (s *KeeperTestSuite) TestExecuteReadySchedulesLimit() {
+	s.Require().NoError(s.keeper.SetParams(s.ctx, types.NewParams("", 2)))
+
+	for _, name := range []string{"a", "b", "c"} {
+		schedule := s.schedule(name, 1)
+		schedule.Msg = `{"` + name + `":{}}`
+		s.Require().NoError(s.keeper.AddSchedule(s.ctx, schedule))
+	}
+
+	executed := func() []string {
+		var msgs []string
+		for _, call := range s.wasmKeeper.calls {
+			msgs = append(msgs, call.msg)
+		}
+		s.wasmKeeper.calls = nil
+		return msgs
+	}
+
+	s.nextBlock(1)
+	s.keeper.ExecuteReadySchedules(s.ctx, types.EXECUTION_STAGE_END_BLOCKER)
+	s.Require().Equal([]string{`{"a":{}}`, `{"b":{}}`}, executed())
+
+	// the schedule left out is executed first in the next block
+	s.nextBlock(1)
+	s.keeper.ExecuteReadySchedules(s.ctx, types.EXECUTION_STAGE_END_BLOCKER)
+	s.Require().Equal([]string{`{"c":{}}`, `{"a":{}}`}, executed())
+}
+
+func (s *KeeperTestSuite) TestExecuteScheduleFailure() {
+	schedule := s.schedule("failing", 1)
+	s.Require().NoError(s.keeper.AddSchedule(s.ctx, schedule))
+	contract := sdk.MustAccAddressFromBech32(schedule.Contract)
+
+	// the state changes of the failed execution are discarded
+	s.wasmKeeper.call = func(ctx sdk.Context, contract sdk.AccAddress, _ []byte) error {
+		ctx.KVStore(s.app.GetKey(types.StoreKey)).Set([]byte("written"), []byte{1})
+		return errors.New("contract failed")
+	}
+
+	s.nextBlock(1)
+	s.keeper.ExecuteReadySchedules(s.ctx, types.EXECUTION_STAGE_END_BLOCKER)
+	s.Require().Len(s.wasmKeeper.calls, 1)
+	s.Require().False(s.ctx.KVStore(s.app.GetKey(types.StoreKey)).Has([]byte("written")))
+
+	failures := s.app.ContractManagerKeeper.GetAllFailures(s.ctx)
+	s.Require().Len(failures, 1)
+	s.Require().Equal(contract.String(), failures[0].Address)
+	s.Require().Equal([]byte(schedule.Msg), failures[0].SudoPayload)
+
+	// the schedule is still executed at its next period
+	schedule, _ = s.keeper.GetSchedule(s.ctx, "failing")
+	s.Require().Equal(uint64(s.ctx.BlockHeight()), schedule.LastExecuteHeight)
+
+	// successful executions are written
+	s.wasmKeeper.call = func(ctx sdk.Context, contract sdk.AccAddress, _ []byte) error {
+		ctx.KVStore(s.app.GetKey(types.StoreKey)).Set([]byte("written"), []byte{1})
+		return nil
+	}
+
+	s.nextBlock(1)
+	s.keeper.ExecuteReadySchedules(s.ctx, types.EXECUTION_STAGE_END_BLOCKER)
+	s.Require().True(s.ctx.KVStore(s.app.GetKey(types.StoreKey)).Has([]byte("written")))
+	s.Require().Len(s.app.ContractManagerKeeper.GetAllFailures(s.ctx), 1)
+}
+
+func (s *KeeperTestSuite) TestExecuteScheduleOutOfGas() {
+	schedule := s.schedule("expensive", 1)
+	s.Require().NoError(s.keeper.AddSchedule(s.ctx, schedule))
+
+	var limit uint64
+	s.wasmKeeper.call = func(ctx sdk.Context, _ sdk.AccAddress, _ []byte) error {
+		limit = ctx.GasMeter().Limit()
+		ctx.GasMeter().ConsumeGas(schedule.GasLimit+1, "expensive")
+		return nil
+	}
+
+	s.nextBlock(1)
+	s.Require().NotPanics(func() {
+		s.keeper.ExecuteReadySchedules(s.ctx, types.EXECUTION_STAGE_END_BLOCKER)
+	})
+	s.Require().Equal(schedule.GasLimit, limit)
+
+	failures := s.app.ContractManagerKeeper.GetAllFailures(s.ctx)
+	s.Require().Len(failures, 1)
+	s.Require().Equal(fmt.Sprintf("codespace: %s, code: %d", sdkerrors.ErrOutOfGas.Codespace(), sdkerrors.ErrOutOfGas.ABCICode()), failures[0].Error)
+}
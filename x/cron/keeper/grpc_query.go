@@ -0,0 +1,66 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Nolus-Protocol/nolus-core/x/cron/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// Params returns the parameters of the module.
+func (k Keeper) Params(c context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryParamsResponse{Params: k.GetParams(ctx)}, nil
+}
+
+// Schedule returns a schedule by name.
+func (k Keeper) Schedule(c context.Context, req *types.QueryScheduleRequest) (*types.QueryScheduleResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	schedule, found := k.GetSchedule(ctx, req.Name)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "schedule %s not found", req.Name)
+	}
+
+	return &types.QueryScheduleResponse{Schedule: schedule}, nil
+}
+
+// Schedules returns the schedules ordered by name.
+func (k Keeper) Schedules(c context.Context, req *types.QuerySchedulesRequest) (*types.QuerySchedulesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ScheduleKeyPrefix)
+
+	var schedules []types.Schedule
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var schedule types.Schedule
+		if err := k.cdc.Unmarshal(value, &schedule); err != nil {
+			return err
+		}
+
+		schedules = append(schedules, schedule)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QuerySchedulesResponse{Schedules: schedules, Pagination: pageRes}, nil
+}
@@ -0,0 +1,37 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Nolus-Protocol/nolus-core/x/cron/types"
+)
+
+func (s *KeeperTestSuite) TestQuerySchedules() {
+	for _, name := range []string{"a", "b", "c"} {
+		s.Require().NoError(s.keeper.AddSchedule(s.ctx, s.schedule(name, 1)))
+	}
+	ctx := sdk.WrapSDKContext(s.ctx)
+
+	res, err := s.keeper.Schedule(ctx, &types.QueryScheduleRequest{Name: "b"})
+	s.Require().NoError(err)
+	s.Require().Equal("b", res.Schedule.Name)
+
+	_, err = s.keeper.Schedule(ctx, &types.QueryScheduleRequest{Name: "unknown"})
+	s.Require().Equal(codes.NotFound, status.Code(err))
+
+	_, err = s.keeper.Schedules(ctx, nil)
+	s.Require().Equal(codes.InvalidArgument, status.Code(err))
+
+	page, err := s.keeper.Schedules(ctx, &types.QuerySchedulesRequest{Pagination: &query.PageRequest{Limit: 2, CountTotal: true}})
+	s.Require().NoError(err)
+	s.Require().Len(page.Schedules, 2)
+	s.Require().Equal(uint64(3), page.Pagination.Total)
+
+	next, err := s.keeper.Schedules(ctx, &types.QuerySchedulesRequest{Pagination: &query.PageRequest{Key: page.Pagination.NextKey}})
+	s.Require().NoError(err)
+	s.Require().Len(next.Schedules, 1)
+	s.Require().Equal("c", next.Schedules[0].Name)
+}
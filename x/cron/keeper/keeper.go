@@ -0,0 +1,69 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/Nolus-Protocol/nolus-core/x/cron/types"
+)
+
+type Keeper struct {
+	cdc      codec.BinaryCodec
+	storeKey storetypes.StoreKey
+	memKey   storetypes.StoreKey
+
+	wasmKeeper            types.WasmKeeper
+	contractManagerKeeper types.ContractManagerKeeper
+
+	// the address capable of executing a MsgUpdateParams message and managing
+	// the schedules. Typically, this should be the x/gov module account.
+	authority string
+}
+
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	storeKey,
+	memKey storetypes.StoreKey,
+	wasmKeeper types.WasmKeeper,
+	contractManagerKeeper types.ContractManagerKeeper,
+	authority string,
+) *Keeper {
+	return &Keeper{
+		cdc:                   cdc,
+		storeKey:              storeKey,
+		memKey:                memKey,
+		wasmKeeper:            wasmKeeper,
+		contractManagerKeeper: contractManagerKeeper,
+		authority:             authority,
+	}
+}
+
+// GetAuthority returns the x/cron module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// GetModuleAccountAddress returns the address the schedules execute contracts
+// with.
+func (k Keeper) GetModuleAccountAddress() sdk.AccAddress {
+	return authtypes.NewModuleAddress(types.ModuleName)
+}
+
+// IsScheduleAdmin returns true if the address may add and remove schedules.
+func (k Keeper) IsScheduleAdmin(ctx sdk.Context, address string) bool {
+	if address == k.authority {
+		return true
+	}
+
+	securityAddress := k.GetParams(ctx).SecurityAddress
+	return securityAddress != "" && address == securityAddress
+}
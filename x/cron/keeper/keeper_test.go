@@ -0,0 +1,104 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/suite"
+
+	nolusapp "github.com/Nolus-Protocol/nolus-core/app"
+	"github.com/Nolus-Protocol/nolus-core/app/params"
+	simulationapp "github.com/Nolus-Protocol/nolus-core/testutil/simapp"
+	"github.com/Nolus-Protocol/nolus-core/x/cron/keeper"
+	"github.com/Nolus-Protocol/nolus-core/x/cron/types"
+)
+
+// mockWasmKeeper records the contract calls of the schedules and lets the
+// tests decide their outcome.
+type mockWasmKeeper struct {
+	calls []mockCall
+	call  func(ctx sdk.Context, contract sdk.AccAddress, msg []byte) error
+}
+
+type mockCall struct {
+	contract string
+	caller   string
+	msg      string
+	sudo     bool
+}
+
+func (m *mockWasmKeeper) Execute(ctx sdk.Context, contract, caller sdk.AccAddress, msg []byte, _ sdk.Coins) ([]byte, error) {
+	m.calls = append(m.calls, mockCall{contract: contract.String(), caller: caller.String(), msg: string(msg)})
+	return nil, m.result(ctx, contract, msg)
+}
+
+func (m *mockWasmKeeper) Sudo(ctx sdk.Context, contract sdk.AccAddress, msg []byte) ([]byte, error) {
+	m.calls = append(m.calls, mockCall{contract: contract.String(), msg: string(msg), sudo: true})
+	return nil, m.result(ctx, contract, msg)
+}
+
+func (m *mockWasmKeeper) result(ctx sdk.Context, contract sdk.AccAddress, msg []byte) error {
+	if m.call == nil {
+		return nil
+	}
+	return m.call(ctx, contract, msg)
+}
+
+type KeeperTestSuite struct {
+	suite.Suite
+	ctx        sdk.Context
+	app        *nolusapp.App
+	wasmKeeper *mockWasmKeeper
+	keeper     keeper.Keeper
+	msgServer  types.MsgServer
+	authority  string
+}
+
+// SetupTest setups a new test, with a cron keeper calling a mock wasm keeper.
+func (s *KeeperTestSuite) SetupTest() {
+	var err error
+	_ = params.SetAddressPrefixes()
+	s.app, err = simulationapp.TestSetup(s.T())
+	s.Require().NoError(err)
+
+	header := tmproto.Header{Height: s.app.LastBlockHeight() + 1}
+	s.ctx = s.app.BaseApp.NewContext(false, header).WithBlockTime(time.Now())
+
+	s.authority = authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	s.wasmKeeper = &mockWasmKeeper{}
+	s.keeper = *keeper.NewKeeper(
+		s.app.AppCodec(),
+		s.app.GetKey(types.StoreKey),
+		s.app.GetKey(types.MemStoreKey),
+		s.wasmKeeper,
+		s.app.ContractManagerKeeper,
+		s.authority,
+	)
+	s.msgServer = keeper.NewMsgServerImpl(s.keeper)
+}
+
+// schedule returns a valid schedule executing a contract every period blocks
+// at the end of the block.
+func (s *KeeperTestSuite) schedule(name string, period uint64) types.Schedule {
+	return types.Schedule{
+		Name:           name,
+		Period:         period,
+		Contract:       sdk.AccAddress("contract____________").String(),
+		Msg:            `{"tick":{}}`,
+		GasLimit:       100_000,
+		ExecutionStage: types.EXECUTION_STAGE_END_BLOCKER,
+	}
+}
+
+// nextBlock moves the context to the height after n blocks.
+func (s *KeeperTestSuite) nextBlock(n int64) {
+	s.ctx = s.ctx.WithBlockHeight(s.ctx.BlockHeight() + n)
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
@@ -0,0 +1,100 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/Nolus-Protocol/nolus-core/x/cron/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+var _ types.MsgServer = msgServer{}
+
+// AddSchedule adds a schedule on behalf of the governance authority or the
+// security address. Only the governance authority may add sudo schedules.
+func (k msgServer) AddSchedule(goCtx context.Context, req *types.MsgAddSchedule) (*types.MsgAddScheduleResponse, error) {
+	if err := req.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if !k.IsScheduleAdmin(ctx, req.Authority) {
+		return nil, errors.Wrapf(sdkerrors.ErrUnauthorized, "%s may not add schedules", req.Authority)
+	}
+
+	// sudo is a privileged entry point of the contracts, reached on behalf of
+	// the chain only
+	if req.Sudo && req.Authority != k.authority {
+		return nil, errors.Wrapf(sdkerrors.ErrUnauthorized, "only the governance authority may add sudo schedules")
+	}
+
+	if err := k.Keeper.AddSchedule(ctx, req.Schedule()); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAddSchedule,
+			sdk.NewAttribute(types.AttributeKeyName, req.Name),
+			sdk.NewAttribute(types.AttributeKeyContract, req.Contract),
+		),
+	)
+
+	return &types.MsgAddScheduleResponse{}, nil
+}
+
+// RemoveSchedule removes a schedule on behalf of the governance authority or
+// the security address.
+func (k msgServer) RemoveSchedule(goCtx context.Context, req *types.MsgRemoveSchedule) (*types.MsgRemoveScheduleResponse, error) {
+	if err := req.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if !k.IsScheduleAdmin(ctx, req.Authority) {
+		return nil, errors.Wrapf(sdkerrors.ErrUnauthorized, "%s may not remove schedules", req.Authority)
+	}
+
+	if err := k.Keeper.RemoveSchedule(ctx, req.Name); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRemoveSchedule,
+			sdk.NewAttribute(types.AttributeKeyName, req.Name),
+		),
+	)
+
+	return &types.MsgRemoveScheduleResponse{}, nil
+}
+
+func (k msgServer) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if err := req.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	if k.authority != req.Authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.SetParams(ctx, req.Params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
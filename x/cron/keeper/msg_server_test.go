@@ -0,0 +1,72 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/Nolus-Protocol/nolus-core/x/cron/types"
+)
+
+func (s *KeeperTestSuite) TestMsgAddRemoveSchedule() {
+	authority := sdk.MustAccAddressFromBech32(s.authority)
+	securityAddress := sdk.AccAddress("security____________")
+	other := sdk.AccAddress("other_______________")
+	ctx := sdk.WrapSDKContext(s.ctx)
+
+	// only the governance authority may manage the schedules by default
+	_, err := s.msgServer.AddSchedule(ctx, types.NewMsgAddSchedule(securityAddress, s.schedule("security", 1)))
+	s.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+	_, err = s.msgServer.AddSchedule(ctx, types.NewMsgAddSchedule(authority, s.schedule("gov", 1)))
+	s.Require().NoError(err)
+
+	s.Require().NoError(s.keeper.SetParams(s.ctx, types.NewParams(securityAddress.String(), types.DefaultLimit)))
+	_, err = s.msgServer.AddSchedule(ctx, types.NewMsgAddSchedule(securityAddress, s.schedule("security", 1)))
+	s.Require().NoError(err)
+	_, err = s.msgServer.AddSchedule(ctx, types.NewMsgAddSchedule(securityAddress, s.schedule("security", 1)))
+	s.Require().ErrorIs(err, types.ErrScheduleAlreadyExists)
+	_, err = s.msgServer.AddSchedule(ctx, types.NewMsgAddSchedule(other, s.schedule("other", 1)))
+	s.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+	_, err = s.msgServer.AddSchedule(ctx, types.NewMsgAddSchedule(securityAddress, s.schedule("invalid", 0)))
+	s.Require().ErrorIs(err, types.ErrInvalidSchedule)
+
+	// only the governance authority may add sudo schedules
+	sudo := s.schedule("sudo", 1)
+	sudo.Sudo = true
+	_, err = s.msgServer.AddSchedule(ctx, types.NewMsgAddSchedule(securityAddress, sudo))
+	s.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+	s.Require().False(s.keeper.HasSchedule(s.ctx, "sudo"))
+	_, err = s.msgServer.AddSchedule(ctx, types.NewMsgAddSchedule(authority, sudo))
+	s.Require().NoError(err)
+	_, err = s.msgServer.RemoveSchedule(ctx, types.NewMsgRemoveSchedule(securityAddress, "sudo"))
+	s.Require().NoError(err)
+
+	_, err = s.msgServer.RemoveSchedule(ctx, types.NewMsgRemoveSchedule(other, "gov"))
+	s.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+	_, err = s.msgServer.RemoveSchedule(ctx, types.NewMsgRemoveSchedule(securityAddress, "gov"))
+	s.Require().NoError(err)
+	_, err = s.msgServer.RemoveSchedule(ctx, types.NewMsgRemoveSchedule(authority, "security"))
+	s.Require().NoError(err)
+	_, err = s.msgServer.RemoveSchedule(ctx, types.NewMsgRemoveSchedule(authority, "security"))
+	s.Require().ErrorIs(err, types.ErrScheduleNotFound)
+
+	s.Require().Empty(s.keeper.GetAllSchedules(s.ctx))
+}
+
+func (s *KeeperTestSuite) TestMsgUpdateParams() {
+	securityAddress := sdk.AccAddress("security____________").String()
+	params := types.NewParams(securityAddress, 10)
+	ctx := sdk.WrapSDKContext(s.ctx)
+
+	// the security address may not update the params
+	s.Require().NoError(s.keeper.SetParams(s.ctx, params))
+	_, err := s.msgServer.UpdateParams(ctx, &types.MsgUpdateParams{Authority: securityAddress, Params: types.DefaultParams()})
+	s.Require().ErrorIs(err, govtypes.ErrInvalidSigner)
+
+	_, err = s.msgServer.UpdateParams(ctx, &types.MsgUpdateParams{Authority: s.authority, Params: types.NewParams("", 0)})
+	s.Require().Error(err)
+
+	_, err = s.msgServer.UpdateParams(ctx, &types.MsgUpdateParams{Authority: s.authority, Params: types.DefaultParams()})
+	s.Require().NoError(err)
+	s.Require().Equal(types.DefaultParams(), s.keeper.GetParams(s.ctx))
+}
@@ -0,0 +1,89 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Nolus-Protocol/nolus-core/x/cron/types"
+)
+
+// AddSchedule stores a new schedule, which is executed for the first time
+// after its period elapses.
+func (k Keeper) AddSchedule(ctx sdk.Context, schedule types.Schedule) error {
+	if err := schedule.Validate(); err != nil {
+		return err
+	}
+
+	if k.HasSchedule(ctx, schedule.Name) {
+		return types.ErrScheduleAlreadyExists.Wrapf("name %s", schedule.Name)
+	}
+
+	schedule.LastExecuteHeight = uint64(ctx.BlockHeight())
+	k.SetSchedule(ctx, schedule)
+
+	return nil
+}
+
+// RemoveSchedule deletes a schedule.
+func (k Keeper) RemoveSchedule(ctx sdk.Context, name string) error {
+	if !k.HasSchedule(ctx, name) {
+		return types.ErrScheduleNotFound.Wrapf("name %s", name)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetScheduleKey(name))
+
+	return nil
+}
+
+// SetSchedule stores a schedule, overwriting any schedule of the same name.
+func (k Keeper) SetSchedule(ctx sdk.Context, schedule types.Schedule) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetScheduleKey(schedule.Name), k.cdc.MustMarshal(&schedule))
+}
+
+// GetSchedule returns the schedule with the given name.
+func (k Keeper) GetSchedule(ctx sdk.Context, name string) (types.Schedule, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetScheduleKey(name))
+	if bz == nil {
+		return types.Schedule{}, false
+	}
+
+	var schedule types.Schedule
+	k.cdc.MustUnmarshal(bz, &schedule)
+	return schedule, true
+}
+
+// HasSchedule returns whether a schedule with the given name exists.
+func (k Keeper) HasSchedule(ctx sdk.Context, name string) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.GetScheduleKey(name))
+}
+
+// IterateSchedules iterates over the schedules, ordered by name, until cb
+// returns true.
+func (k Keeper) IterateSchedules(ctx sdk.Context, cb func(schedule types.Schedule) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ScheduleKeyPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var schedule types.Schedule
+		k.cdc.MustUnmarshal(iterator.Value(), &schedule)
+		if cb(schedule) {
+			break
+		}
+	}
+}
+
+// GetAllSchedules returns all the schedules ordered by name.
+func (k Keeper) GetAllSchedules(ctx sdk.Context) []types.Schedule {
+	var schedules []types.Schedule
+	k.IterateSchedules(ctx, func(schedule types.Schedule) bool {
+		schedules = append(schedules, schedule)
+		return false
+	})
+
+	return schedules
+}
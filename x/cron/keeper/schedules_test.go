@@ -0,0 +1,34 @@
+package keeper_test
+
+import (
+	"github.com/Nolus-Protocol/nolus-core/x/cron/types"
+)
+
+func (s *KeeperTestSuite) TestSchedules() {
+	first := s.schedule("first", 10)
+	second := s.schedule("second", 5)
+
+	s.Require().NoError(s.keeper.AddSchedule(s.ctx, second))
+	s.Require().NoError(s.keeper.AddSchedule(s.ctx, first))
+	s.Require().ErrorIs(s.keeper.AddSchedule(s.ctx, first), types.ErrScheduleAlreadyExists)
+
+	// the schedules are executed for the first time after their period
+	schedule, found := s.keeper.GetSchedule(s.ctx, "first")
+	s.Require().True(found)
+	s.Require().Equal(uint64(s.ctx.BlockHeight()), schedule.LastExecuteHeight)
+	first.LastExecuteHeight = schedule.LastExecuteHeight
+	s.Require().Equal(first, schedule)
+
+	schedules := s.keeper.GetAllSchedules(s.ctx)
+	s.Require().Len(schedules, 2)
+	s.Require().Equal("first", schedules[0].Name)
+	s.Require().Equal("second", schedules[1].Name)
+
+	s.Require().NoError(s.keeper.RemoveSchedule(s.ctx, "first"))
+	s.Require().ErrorIs(s.keeper.RemoveSchedule(s.ctx, "first"), types.ErrScheduleNotFound)
+	s.Require().False(s.keeper.HasSchedule(s.ctx, "first"))
+	s.Require().True(s.keeper.HasSchedule(s.ctx, "second"))
+
+	invalid := s.schedule("invalid", 0)
+	s.Require().Error(s.keeper.AddSchedule(s.ctx, invalid))
+}
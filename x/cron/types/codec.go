@@ -0,0 +1,25 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+)
+
+func RegisterCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&MsgAddSchedule{}, "nolus-core/x/cron/MsgAddSchedule", nil)
+	cdc.RegisterConcrete(&MsgRemoveSchedule{}, "nolus-core/x/cron/MsgRemoveSchedule", nil)
+	cdc.RegisterConcrete(&MsgUpdateParams{}, "nolus-core/x/cron/MsgUpdateParams", nil)
+}
+
+func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgAddSchedule{},
+		&MsgRemoveSchedule{},
+		&MsgUpdateParams{},
+	)
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
+
+var ModuleCdc = codec.NewProtoCodec(cdctypes.NewInterfaceRegistry())
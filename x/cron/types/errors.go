@@ -0,0 +1,14 @@
+package types
+
+// DONTCOVER
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// x/cron module sentinel errors.
+var (
+	ErrScheduleNotFound      = errorsmod.Register(ModuleName, 1, "schedule not found")
+	ErrScheduleAlreadyExists = errorsmod.Register(ModuleName, 2, "schedule already exists")
+	ErrInvalidSchedule       = errorsmod.Register(ModuleName, 3, "invalid schedule")
+)
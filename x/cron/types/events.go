@@ -0,0 +1,13 @@
+package types
+
+const (
+	EventTypeAddSchedule     = "add_schedule"
+	EventTypeRemoveSchedule  = "remove_schedule"
+	EventTypeExecuteSchedule = "execute_schedule"
+
+	AttributeKeyName     = "name"
+	AttributeKeyContract = "contract"
+	AttributeKeySuccess  = "success"
+
+	AttributeValueCategory = ModuleName
+)
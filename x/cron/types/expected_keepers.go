@@ -0,0 +1,18 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	contractmanagertypes "github.com/neutron-org/neutron/x/contractmanager/types"
+)
+
+// WasmKeeper defines the contract calls made by the schedules.
+type WasmKeeper interface {
+	Execute(ctx sdk.Context, contractAddress, caller sdk.AccAddress, msg []byte, coins sdk.Coins) ([]byte, error)
+	Sudo(ctx sdk.Context, contractAddress sdk.AccAddress, msg []byte) ([]byte, error)
+}
+
+// ContractManagerKeeper records the failed executions of the schedules.
+type ContractManagerKeeper interface {
+	AddContractFailure(ctx sdk.Context, address string, sudoPayload []byte, errMsg string) contractmanagertypes.Failure
+}
@@ -0,0 +1,42 @@
+package types
+
+import (
+	"fmt"
+)
+
+// NewGenesisState creates a new GenesisState object.
+func NewGenesisState(params Params, schedules []Schedule) *GenesisState {
+	return &GenesisState{
+		Params:    params,
+		Schedules: schedules,
+	}
+}
+
+// DefaultGenesis returns the default cron genesis state.
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{
+		Params: DefaultParams(),
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	seen := make(map[string]bool, len(gs.Schedules))
+	for _, schedule := range gs.Schedules {
+		if err := schedule.Validate(); err != nil {
+			return fmt.Errorf("invalid schedule %s: %w", schedule.Name, err)
+		}
+
+		if seen[schedule.Name] {
+			return fmt.Errorf("duplicate schedule %s", schedule.Name)
+		}
+		seen[schedule.Name] = true
+	}
+
+	return nil
+}
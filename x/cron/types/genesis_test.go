@@ -0,0 +1,103 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Nolus-Protocol/nolus-core/app/params"
+	"github.com/Nolus-Protocol/nolus-core/x/cron/types"
+)
+
+func TestGenesisState_Validate(t *testing.T) {
+	params.SetAddressPrefixes()
+	contract := sdk.AccAddress("contract____________").String()
+	schedule := func(modify func(s *types.Schedule)) types.Schedule {
+		s := types.Schedule{Name: "tick", Period: 1, Contract: contract, Msg: `{"tick":{}}`, GasLimit: 1000}
+		if modify != nil {
+			modify(&s)
+		}
+		return s
+	}
+
+	for _, tc := range []struct {
+		desc     string
+		genState *types.GenesisState
+		valid    bool
+	}{
+		{
+			desc:     "default is valid",
+			genState: types.DefaultGenesis(),
+			valid:    true,
+		},
+		{
+			desc:     "valid genesis state",
+			genState: types.NewGenesisState(types.NewParams(contract, 1), []types.Schedule{schedule(nil)}),
+			valid:    true,
+		},
+		{
+			desc:     "invalid security address",
+			genState: types.NewGenesisState(types.NewParams("invalid_address", 1), nil),
+			valid:    false,
+		},
+		{
+			desc:     "zero limit",
+			genState: types.NewGenesisState(types.NewParams("", 0), nil),
+			valid:    false,
+		},
+		{
+			desc:     "duplicate schedule",
+			genState: types.NewGenesisState(types.DefaultParams(), []types.Schedule{schedule(nil), schedule(nil)}),
+			valid:    false,
+		},
+		{
+			desc:     "empty schedule name",
+			genState: types.NewGenesisState(types.DefaultParams(), []types.Schedule{schedule(func(s *types.Schedule) { s.Name = "" })}),
+			valid:    false,
+		},
+		{
+			desc:     "zero schedule period",
+			genState: types.NewGenesisState(types.DefaultParams(), []types.Schedule{schedule(func(s *types.Schedule) { s.Period = 0 })}),
+			valid:    false,
+		},
+		{
+			desc:     "invalid schedule contract",
+			genState: types.NewGenesisState(types.DefaultParams(), []types.Schedule{schedule(func(s *types.Schedule) { s.Contract = "invalid_address" })}),
+			valid:    false,
+		},
+		{
+			desc:     "invalid schedule msg",
+			genState: types.NewGenesisState(types.DefaultParams(), []types.Schedule{schedule(func(s *types.Schedule) { s.Msg = "tick" })}),
+			valid:    false,
+		},
+		{
+			desc:     "zero schedule gas limit",
+			genState: types.NewGenesisState(types.DefaultParams(), []types.Schedule{schedule(func(s *types.Schedule) { s.GasLimit = 0 })}),
+			valid:    false,
+		},
+		{
+			desc:     "invalid schedule execution stage",
+			genState: types.NewGenesisState(types.DefaultParams(), []types.Schedule{schedule(func(s *types.Schedule) { s.ExecutionStage = 2 })}),
+			valid:    false,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := tc.genState.Validate()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestSchedule_IsReady(t *testing.T) {
+	s := types.Schedule{Period: 3, LastExecuteHeight: 10}
+
+	require.False(t, s.IsReady(10))
+	require.False(t, s.IsReady(12))
+	require.True(t, s.IsReady(13))
+	require.True(t, s.IsReady(20))
+}
@@ -0,0 +1,35 @@
+package types
+
+var (
+	// ParamsKey is the key of the module params.
+	ParamsKey = []byte{0x00}
+
+	// ScheduleKeyPrefix is the prefix of the schedules, stored by name.
+	ScheduleKeyPrefix = []byte{0x01}
+)
+
+const (
+	// ModuleName defines the module name.
+	ModuleName = "cron"
+
+	// StoreKey defines the primary module store key.
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for cron.
+	RouterKey = ModuleName
+
+	// QuerierRoute defines the module's query routing key.
+	QuerierRoute = ModuleName
+
+	// MemStoreKey defines the in-memory store key.
+	MemStoreKey = "mem_cron"
+)
+
+func KeyPrefix(p string) []byte {
+	return []byte(p)
+}
+
+// GetScheduleKey returns the store key of a schedule.
+func GetScheduleKey(name string) []byte {
+	return append(ScheduleKeyPrefix, []byte(name)...)
+}
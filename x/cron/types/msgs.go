@@ -0,0 +1,116 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var (
+	_ sdk.Msg = &MsgAddSchedule{}
+	_ sdk.Msg = &MsgRemoveSchedule{}
+	_ sdk.Msg = &MsgUpdateParams{}
+)
+
+// NewMsgAddSchedule returns a reference to a new MsgAddSchedule.
+func NewMsgAddSchedule(authority sdk.AccAddress, schedule Schedule) *MsgAddSchedule {
+	return &MsgAddSchedule{
+		Authority:      authority.String(),
+		Name:           schedule.Name,
+		Period:         schedule.Period,
+		Contract:       schedule.Contract,
+		Msg:            schedule.Msg,
+		Sudo:           schedule.Sudo,
+		GasLimit:       schedule.GasLimit,
+		ExecutionStage: schedule.ExecutionStage,
+	}
+}
+
+// Schedule returns the schedule added by the message.
+func (m MsgAddSchedule) Schedule() Schedule {
+	return Schedule{
+		Name:           m.Name,
+		Period:         m.Period,
+		Contract:       m.Contract,
+		Msg:            m.Msg,
+		Sudo:           m.Sudo,
+		GasLimit:       m.GasLimit,
+		ExecutionStage: m.ExecutionStage,
+	}
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgAddSchedule) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgAddSchedule message.
+func (m *MsgAddSchedule) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgAddSchedule) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	if err := m.Schedule().Validate(); err != nil {
+		return errorsmod.Wrap(ErrInvalidSchedule, err.Error())
+	}
+
+	return nil
+}
+
+// NewMsgRemoveSchedule returns a reference to a new MsgRemoveSchedule.
+func NewMsgRemoveSchedule(authority sdk.AccAddress, name string) *MsgRemoveSchedule {
+	return &MsgRemoveSchedule{
+		Authority: authority.String(),
+		Name:      name,
+	}
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgRemoveSchedule) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgRemoveSchedule message.
+func (m *MsgRemoveSchedule) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgRemoveSchedule) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	if m.Name == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "schedule name cannot be empty")
+	}
+
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgUpdateParams) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgUpdateParams message.
+func (m *MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	return m.Params.Validate()
+}
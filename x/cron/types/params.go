@@ -0,0 +1,77 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"gopkg.in/yaml.v2"
+)
+
+// DefaultLimit is the default maximum number of schedules executed in a block.
+const DefaultLimit = uint64(5)
+
+// NewParams creates a new Params instance.
+func NewParams(securityAddress string, limit uint64) Params {
+	return Params{
+		SecurityAddress: securityAddress,
+		Limit:           limit,
+	}
+}
+
+// DefaultParams returns default x/cron module parameters, which leave the
+// schedules to governance.
+func DefaultParams() Params {
+	return Params{
+		SecurityAddress: "",
+		Limit:           DefaultLimit,
+	}
+}
+
+// Validate validates the set of params.
+func (p Params) Validate() error {
+	if err := validateSecurityAddress(p.SecurityAddress); err != nil {
+		return err
+	}
+
+	if err := validateLimit(p.Limit); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String implements the Stringer interface.
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+func validateSecurityAddress(v interface{}) error {
+	securityAddress, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if securityAddress == "" {
+		return nil
+	}
+
+	if _, err := sdk.AccAddressFromBech32(securityAddress); err != nil {
+		return fmt.Errorf("invalid security address %s: %w", securityAddress, err)
+	}
+
+	return nil
+}
+
+func validateLimit(v interface{}) error {
+	limit, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if limit == 0 {
+		return fmt.Errorf("limit must be positive")
+	}
+
+	return nil
+}
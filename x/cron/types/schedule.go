@@ -0,0 +1,42 @@
+package types
+
+import (
+	"encoding/json"
+	"errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Validate checks for errors on the schedule fields.
+func (s Schedule) Validate() error {
+	if s.Name == "" {
+		return errors.New("schedule name cannot be empty")
+	}
+
+	if s.Period == 0 {
+		return errors.New("schedule period must be positive")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(s.Contract); err != nil {
+		return errors.New("invalid schedule contract address: " + err.Error())
+	}
+
+	if !json.Valid([]byte(s.Msg)) {
+		return errors.New("schedule msg is not valid JSON")
+	}
+
+	if s.GasLimit == 0 {
+		return errors.New("schedule gas limit must be positive")
+	}
+
+	if _, ok := ExecutionStage_name[int32(s.ExecutionStage)]; !ok {
+		return errors.New("invalid schedule execution stage: " + s.ExecutionStage.String())
+	}
+
+	return nil
+}
+
+// IsReady returns true if the schedule is due for execution at height.
+func (s Schedule) IsReady(height uint64) bool {
+	return height >= s.LastExecuteHeight+s.Period
+}
@@ -0,0 +1,655 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: nolus/cron/v1beta1/schedule.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ExecutionStage is the stage of the block at which a schedule is executed.
+type ExecutionStage int32
+
+const (
+	// EXECUTION_STAGE_BEGIN_BLOCKER executes the schedule in BeginBlocker.
+	EXECUTION_STAGE_BEGIN_BLOCKER ExecutionStage = 0
+	// EXECUTION_STAGE_END_BLOCKER executes the schedule in EndBlocker.
+	EXECUTION_STAGE_END_BLOCKER ExecutionStage = 1
+)
+
+var ExecutionStage_name = map[int32]string{
+	0: "EXECUTION_STAGE_BEGIN_BLOCKER",
+	1: "EXECUTION_STAGE_END_BLOCKER",
+}
+
+var ExecutionStage_value = map[string]int32{
+	"EXECUTION_STAGE_BEGIN_BLOCKER": 0,
+	"EXECUTION_STAGE_END_BLOCKER":   1,
+}
+
+func (x ExecutionStage) String() string {
+	return proto.EnumName(ExecutionStage_name, int32(x))
+}
+
+func (ExecutionStage) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_5947ca8451af1394, []int{0}
+}
+
+// Schedule is a contract message executed every period blocks.
+type Schedule struct {
+	// name is the unique name of the schedule.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// period is the number of blocks between two executions of the schedule.
+	Period uint64 `protobuf:"varint,2,opt,name=period,proto3" json:"period,omitempty"`
+	// contract is the address of the executed contract.
+	Contract string `protobuf:"bytes,3,opt,name=contract,proto3" json:"contract,omitempty"`
+	// msg is the JSON encoded message passed to the contract.
+	Msg string `protobuf:"bytes,4,opt,name=msg,proto3" json:"msg,omitempty"`
+	// sudo sends the message to the sudo entry point of the contract instead of
+	// executing it with the module account as the sender.
+	Sudo bool `protobuf:"varint,5,opt,name=sudo,proto3" json:"sudo,omitempty"`
+	// gas_limit is the maximum gas an execution of the schedule may consume.
+	GasLimit uint64 `protobuf:"varint,6,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty" yaml:"gas_limit"`
+	// execution_stage is the stage of the block at which the schedule is
+	// executed.
+	ExecutionStage ExecutionStage `protobuf:"varint,7,opt,name=execution_stage,json=executionStage,proto3,enum=nolus.cron.v1beta1.ExecutionStage" json:"execution_stage,omitempty" yaml:"execution_stage"`
+	// last_execute_height is the height of the last execution of the schedule.
+	LastExecuteHeight uint64 `protobuf:"varint,8,opt,name=last_execute_height,json=lastExecuteHeight,proto3" json:"last_execute_height,omitempty" yaml:"last_execute_height"`
+}
+
+func (m *Schedule) Reset()         { *m = Schedule{} }
+func (m *Schedule) String() string { return proto.CompactTextString(m) }
+func (*Schedule) ProtoMessage()    {}
+func (*Schedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5947ca8451af1394, []int{0}
+}
+func (m *Schedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Schedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Schedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Schedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Schedule.Merge(m, src)
+}
+func (m *Schedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *Schedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_Schedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Schedule proto.InternalMessageInfo
+
+func (m *Schedule) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Schedule) GetPeriod() uint64 {
+	if m != nil {
+		return m.Period
+	}
+	return 0
+}
+
+func (m *Schedule) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *Schedule) GetMsg() string {
+	if m != nil {
+		return m.Msg
+	}
+	return ""
+}
+
+func (m *Schedule) GetSudo() bool {
+	if m != nil {
+		return m.Sudo
+	}
+	return false
+}
+
+func (m *Schedule) GetGasLimit() uint64 {
+	if m != nil {
+		return m.GasLimit
+	}
+	return 0
+}
+
+func (m *Schedule) GetExecutionStage() ExecutionStage {
+	if m != nil {
+		return m.ExecutionStage
+	}
+	return EXECUTION_STAGE_BEGIN_BLOCKER
+}
+
+func (m *Schedule) GetLastExecuteHeight() uint64 {
+	if m != nil {
+		return m.LastExecuteHeight
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("nolus.cron.v1beta1.ExecutionStage", ExecutionStage_name, ExecutionStage_value)
+	proto.RegisterType((*Schedule)(nil), "nolus.cron.v1beta1.Schedule")
+}
+
+func init() { proto.RegisterFile("nolus/cron/v1beta1/schedule.proto", fileDescriptor_5947ca8451af1394) }
+
+var fileDescriptor_5947ca8451af1394 = []byte{
+	// 427 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0x41, 0x6e, 0xd3, 0x40,
+	0x14, 0x86, 0x3d, 0x6d, 0x08, 0xee, 0x2c, 0x42, 0x18, 0xaa, 0xca, 0x32, 0x62, 0x92, 0x7a, 0x15,
+	0x21, 0xd5, 0x56, 0x60, 0xc7, 0x0e, 0x17, 0xab, 0x54, 0xad, 0x5c, 0xe4, 0x14, 0x09, 0x75, 0x63,
+	0x4d, 0x26, 0xa3, 0xb1, 0x25, 0xdb, 0x13, 0x79, 0xc6, 0xa8, 0xbd, 0x01, 0x4b, 0xee, 0xc0, 0x65,
+	0x58, 0x76, 0xc9, 0x2a, 0x42, 0x09, 0x27, 0xc8, 0x09, 0xd0, 0x8c, 0x43, 0xa4, 0x86, 0xee, 0xfe,
+	0xf7, 0xbf, 0xcf, 0xbf, 0x9f, 0x9f, 0x1f, 0x3c, 0xae, 0x44, 0xd1, 0xc8, 0x80, 0xd6, 0xa2, 0x0a,
+	0xbe, 0x8e, 0xa7, 0x4c, 0x91, 0x71, 0x20, 0x69, 0xc6, 0x66, 0x4d, 0xc1, 0xfc, 0x79, 0x2d, 0x94,
+	0x40, 0xc8, 0x20, 0xbe, 0x46, 0xfc, 0x0d, 0xe2, 0x1e, 0x72, 0xc1, 0x85, 0x69, 0x07, 0x5a, 0xb5,
+	0xa4, 0xf7, 0x67, 0x0f, 0xda, 0x93, 0xcd, 0xc3, 0x08, 0xc1, 0x4e, 0x45, 0x4a, 0xe6, 0x80, 0x21,
+	0x18, 0x1d, 0x24, 0x46, 0xa3, 0x23, 0xd8, 0x9d, 0xb3, 0x3a, 0x17, 0x33, 0x67, 0x6f, 0x08, 0x46,
+	0x9d, 0x64, 0x53, 0x21, 0x17, 0xda, 0x54, 0x54, 0xaa, 0x26, 0x54, 0x39, 0xfb, 0x86, 0xdf, 0xd6,
+	0xa8, 0x0f, 0xf7, 0x4b, 0xc9, 0x9d, 0x8e, 0xb1, 0xb5, 0xd4, 0xc9, 0xb2, 0x99, 0x09, 0xe7, 0xc9,
+	0x10, 0x8c, 0xec, 0xc4, 0x68, 0x34, 0x86, 0x07, 0x9c, 0xc8, 0xb4, 0xc8, 0xcb, 0x5c, 0x39, 0x5d,
+	0x1d, 0x1e, 0x1e, 0xae, 0x17, 0x83, 0xfe, 0x1d, 0x29, 0x8b, 0x77, 0xde, 0xb6, 0xe5, 0x25, 0x36,
+	0x27, 0xf2, 0x52, 0x4b, 0xc4, 0xe1, 0x33, 0x76, 0xcb, 0x68, 0xa3, 0x72, 0x51, 0xa5, 0x52, 0x11,
+	0xce, 0x9c, 0xa7, 0x43, 0x30, 0xea, 0xbd, 0xf1, 0xfc, 0xff, 0xbf, 0xd8, 0x8f, 0xfe, 0xa1, 0x13,
+	0x4d, 0x86, 0xee, 0x7a, 0x31, 0x38, 0x6a, 0xc3, 0x77, 0x42, 0xbc, 0xa4, 0xc7, 0x1e, 0xb0, 0x28,
+	0x86, 0x2f, 0x0a, 0x22, 0x55, 0xda, 0xda, 0x2c, 0xcd, 0x58, 0xce, 0x33, 0xe5, 0xd8, 0x66, 0x4a,
+	0xbc, 0x5e, 0x0c, 0xdc, 0x36, 0xe8, 0x11, 0xc8, 0x4b, 0x9e, 0x6b, 0xb7, 0x7d, 0x39, 0xfb, 0x68,
+	0xbc, 0xd7, 0x37, 0xb0, 0xf7, 0x70, 0x1a, 0x74, 0x0c, 0x5f, 0x45, 0x5f, 0xa2, 0xd3, 0xcf, 0xd7,
+	0xe7, 0x57, 0x71, 0x3a, 0xb9, 0x7e, 0x7f, 0x16, 0xa5, 0x61, 0x74, 0x76, 0x1e, 0xa7, 0xe1, 0xe5,
+	0xd5, 0xe9, 0x45, 0x94, 0xf4, 0x2d, 0x34, 0x80, 0x2f, 0x77, 0x91, 0x28, 0xfe, 0xb0, 0x05, 0x80,
+	0xdb, 0xf9, 0xf6, 0x03, 0x5b, 0xe1, 0xc5, 0xcf, 0x25, 0x06, 0xf7, 0x4b, 0x0c, 0x7e, 0x2f, 0x31,
+	0xf8, 0xbe, 0xc2, 0xd6, 0xfd, 0x0a, 0x5b, 0xbf, 0x56, 0xd8, 0xba, 0x19, 0xf3, 0x5c, 0x65, 0xcd,
+	0xd4, 0xa7, 0xa2, 0x0c, 0x62, 0xbd, 0x9f, 0x93, 0x4f, 0xfa, 0xa7, 0x53, 0x51, 0x04, 0x66, 0x5d,
+	0x27, 0x54, 0xd4, 0x2c, 0xb8, 0x6d, 0x4f, 0x49, 0xdd, 0xcd, 0x99, 0x9c, 0x76, 0xcd, 0x59, 0xbc,
+	0xfd, 0x3b, 0x00, 0xb3, 0x68, 0x46, 0x23, 0x65, 0x02, 0x00, 0x00,
+}
+
+func (m *Schedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Schedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Schedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastExecuteHeight != 0 {
+		i = encodeVarintSchedule(dAtA, i, uint64(m.LastExecuteHeight))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.ExecutionStage != 0 {
+		i = encodeVarintSchedule(dAtA, i, uint64(m.ExecutionStage))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.GasLimit != 0 {
+		i = encodeVarintSchedule(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Sudo {
+		i--
+		if m.Sudo {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Msg) > 0 {
+		i -= len(m.Msg)
+		copy(dAtA[i:], m.Msg)
+		i = encodeVarintSchedule(dAtA, i, uint64(len(m.Msg)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintSchedule(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Period != 0 {
+		i = encodeVarintSchedule(dAtA, i, uint64(m.Period))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintSchedule(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSchedule(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSchedule(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Schedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovSchedule(uint64(l))
+	}
+	if m.Period != 0 {
+		n += 1 + sovSchedule(uint64(m.Period))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovSchedule(uint64(l))
+	}
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovSchedule(uint64(l))
+	}
+	if m.Sudo {
+		n += 2
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovSchedule(uint64(m.GasLimit))
+	}
+	if m.ExecutionStage != 0 {
+		n += 1 + sovSchedule(uint64(m.ExecutionStage))
+	}
+	if m.LastExecuteHeight != 0 {
+		n += 1 + sovSchedule(uint64(m.LastExecuteHeight))
+	}
+	return n
+}
+
+func sovSchedule(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozSchedule(x uint64) (n int) {
+	return sovSchedule(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Schedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSchedule
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Schedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Schedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			m.Period = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Period |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msg = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sudo", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Sudo = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionStage", wireType)
+			}
+			m.ExecutionStage = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecutionStage |= ExecutionStage(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastExecuteHeight", wireType)
+			}
+			m.LastExecuteHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastExecuteHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSchedule(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipSchedule(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowSchedule
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthSchedule
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupSchedule
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthSchedule
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthSchedule        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowSchedule          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupSchedule = fmt.Errorf("proto: unexpected end of group")
+)
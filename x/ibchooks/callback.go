@@ -0,0 +1,63 @@
+package ibchooks
+
+import (
+	"encoding/json"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+
+	ibchookstypes "github.com/neutron-org/neutron/x/ibc-hooks/types"
+)
+
+// IBCLifecycleComplete is the sudo message which calls back a contract with the outcome of a
+// transfer it sent.
+type IBCLifecycleComplete struct {
+	IBCLifecycleComplete IBCLifecycle `json:"ibc_lifecycle_complete"`
+}
+
+// IBCLifecycle holds either the acknowledgement or the timeout of the transfer.
+type IBCLifecycle struct {
+	IBCAck     *IBCAck     `json:"ibc_ack,omitempty"`
+	IBCTimeout *IBCTimeout `json:"ibc_timeout,omitempty"`
+}
+
+// IBCAck is the acknowledgement of the transfer sent over the channel with the sequence.
+type IBCAck struct {
+	Channel  string `json:"channel"`
+	Sequence uint64 `json:"sequence"`
+	Ack      string `json:"ack"`
+	Success  bool   `json:"success"`
+}
+
+// IBCTimeout is the timeout of the transfer sent over the channel with the sequence.
+type IBCTimeout struct {
+	Channel  string `json:"channel"`
+	Sequence uint64 `json:"sequence"`
+}
+
+// CallbackContract returns the contract named by the `ibc_callback` key of the memo of the
+// transfer. Only the sender of the transfer may ask to be called back, so the memo of any other
+// sender is ignored.
+func CallbackContract(data transfertypes.FungibleTokenPacketData) (sdk.AccAddress, bool) {
+	var memo map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(data.GetMemo()), &memo); err != nil {
+		return nil, false
+	}
+
+	raw, found := memo[ibchookstypes.IBCCallbackKey]
+	if !found {
+		return nil, false
+	}
+
+	var contract string
+	if err := json.Unmarshal(raw, &contract); err != nil || contract != data.GetSender() {
+		return nil, false
+	}
+
+	contractAddr, err := sdk.AccAddressFromBech32(contract)
+	if err != nil {
+		return nil, false
+	}
+
+	return contractAddr, true
+}
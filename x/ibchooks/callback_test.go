@@ -0,0 +1,40 @@
+package ibchooks_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Nolus-Protocol/nolus-core/app/params"
+	"github.com/Nolus-Protocol/nolus-core/x/ibchooks"
+)
+
+func TestCallbackContract(t *testing.T) {
+	_ = params.SetAddressPrefixes()
+	sender := sdk.AccAddress("sender").String()
+
+	testCases := []struct {
+		name  string
+		memo  string
+		found bool
+	}{
+		{"callback to the sender", `{"ibc_callback":"` + sender + `"}`, true},
+		{"callback next to other keys", `{"forward":{},"ibc_callback":"` + sender + `"}`, true},
+		{"no memo", "", false},
+		{"no callback", `{"wasm":{}}`, false},
+		{"not an object", `"ibc_callback"`, false},
+		{"callback is not a string", `{"ibc_callback":{"contract":"` + sender + `"}}`, false},
+		{"callback to another contract", `{"ibc_callback":"` + sdk.AccAddress("contract").String() + `"}`, false},
+	}
+
+	for _, tc := range testCases {
+		data := transfertypes.NewFungibleTokenPacketData("unls", "100", sender, "receiver", tc.memo)
+		contract, found := ibchooks.CallbackContract(data)
+		require.Equal(t, tc.found, found, tc.name)
+		if tc.found {
+			require.Equal(t, sender, contract.String(), tc.name)
+		}
+	}
+}
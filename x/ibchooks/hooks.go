@@ -0,0 +1,100 @@
+package ibchooks
+
+import (
+	"encoding/json"
+
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	"github.com/cometbft/cometbft/libs/log"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+
+	contractmanagertypes "github.com/neutron-org/neutron/x/contractmanager/types"
+	ibchooks "github.com/neutron-org/neutron/x/ibc-hooks"
+	ibchookstypes "github.com/neutron-org/neutron/x/ibc-hooks/types"
+)
+
+var (
+	_ ibchooks.OnRecvPacketOverrideHooks         = WasmHooks{}
+	_ ibchooks.OnAcknowledgementPacketAfterHooks = WasmHooks{}
+	_ ibchooks.OnTimeoutPacketAfterHooks         = WasmHooks{}
+)
+
+// WasmHooks executes the contracts named by the `wasm` memo of the received transfers and calls
+// back the contracts which asked for the outcome of the transfers they sent.
+type WasmHooks struct {
+	ibchooks.WasmHooks
+
+	sudoKeeper contractmanagertypes.WasmKeeper
+}
+
+// NewWasmHooks creates new wasm hooks given the keepers executing and calling back the contracts.
+func NewWasmHooks(contractKeeper *wasmkeeper.Keeper, sudoKeeper contractmanagertypes.WasmKeeper, bech32Prefix string) WasmHooks {
+	return WasmHooks{
+		WasmHooks:  ibchooks.NewWasmHooks(contractKeeper, bech32Prefix),
+		sudoKeeper: sudoKeeper,
+	}
+}
+
+// OnAcknowledgementPacketAfterHook calls back the sender of the transfer with its acknowledgement.
+func (h WasmHooks) OnAcknowledgementPacketAfterHook(ctx sdk.Context, packet channeltypes.Packet, acknowledgement []byte, _ sdk.AccAddress, err error) {
+	if err != nil {
+		return
+	}
+
+	var ack channeltypes.Acknowledgement
+	if err := channeltypes.SubModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil {
+		return
+	}
+
+	h.callback(ctx, packet, IBCLifecycle{
+		IBCAck: &IBCAck{
+			Channel:  packet.GetSourceChannel(),
+			Sequence: packet.GetSequence(),
+			Ack:      string(acknowledgement),
+			Success:  ack.Success(),
+		},
+	})
+}
+
+// OnTimeoutPacketAfterHook calls back the sender of the transfer with its timeout.
+func (h WasmHooks) OnTimeoutPacketAfterHook(ctx sdk.Context, packet channeltypes.Packet, _ sdk.AccAddress, err error) {
+	if err != nil {
+		return
+	}
+
+	h.callback(ctx, packet, IBCLifecycle{
+		IBCTimeout: &IBCTimeout{
+			Channel:  packet.GetSourceChannel(),
+			Sequence: packet.GetSequence(),
+		},
+	})
+}
+
+// callback sudo calls the contract of the `ibc_callback` memo of the transfer. The sudo keeper
+// limits the gas of the call and records its failure, which does not fail the packet.
+func (h WasmHooks) callback(ctx sdk.Context, packet channeltypes.Packet, lifecycle IBCLifecycle) {
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		return
+	}
+
+	contract, found := CallbackContract(data)
+	if !found || !h.sudoKeeper.HasContractInfo(ctx, contract) {
+		return
+	}
+
+	msg, err := json.Marshal(IBCLifecycleComplete{IBCLifecycleComplete: lifecycle})
+	if err != nil {
+		logger(ctx).Error("failed to marshal the ibc callback", "contract", contract.String(), "error", err)
+		return
+	}
+
+	if _, err := h.sudoKeeper.Sudo(ctx, contract, msg); err != nil {
+		logger(ctx).Debug("failed to call back the contract", "contract", contract.String(), "sequence", packet.GetSequence(), "error", err)
+	}
+}
+
+func logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+ibchookstypes.ModuleName)
+}
@@ -0,0 +1,264 @@
+package ibchooks_test
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/suite"
+
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v7/testing"
+
+	feerefundertypes "github.com/neutron-org/neutron/x/feerefunder/types"
+	ibchooksutils "github.com/neutron-org/neutron/x/ibc-hooks/utils"
+	neutrontransfertypes "github.com/neutron-org/neutron/x/transfer/types"
+
+	nolusapp "github.com/Nolus-Protocol/nolus-core/app"
+	"github.com/Nolus-Protocol/nolus-core/app/params"
+	"github.com/Nolus-Protocol/nolus-core/testutil/simapp"
+	"github.com/Nolus-Protocol/nolus-core/x/ibchooks"
+)
+
+var (
+	amount = sdkmath.NewInt(100)
+	// fee is the smallest fee the contracts lock to send a transfer
+	fee = sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000))
+)
+
+// HooksTestSuite executes the reflect contract on chain B with the transfers from chain A.
+type HooksTestSuite struct {
+	suite.Suite
+
+	coordinator *ibctesting.Coordinator
+	chainA      *ibctesting.TestChain
+	chainB      *ibctesting.TestChain
+	path        *ibctesting.Path
+}
+
+func TestHooksTestSuite(t *testing.T) {
+	suite.Run(t, new(HooksTestSuite))
+}
+
+func (s *HooksTestSuite) SetupTest() {
+	_ = params.SetAddressPrefixes()
+	ibctesting.DefaultTestingAppInit = simapp.SetupTestingApp
+
+	s.coordinator = ibctesting.NewCoordinator(s.T(), 2)
+	s.chainA = s.coordinator.GetChain(ibctesting.GetChainID(1))
+	s.chainB = s.coordinator.GetChain(ibctesting.GetChainID(2))
+
+	s.path = ibctesting.NewPath(s.chainA, s.chainB)
+	s.path.EndpointA.ChannelConfig.PortID = ibctesting.TransferPort
+	s.path.EndpointB.ChannelConfig.PortID = ibctesting.TransferPort
+	s.path.EndpointA.ChannelConfig.Version = transfertypes.Version
+	s.path.EndpointB.ChannelConfig.Version = transfertypes.Version
+	s.coordinator.Setup(s.path)
+
+	minFee := feerefundertypes.Fee{AckFee: fee, TimeoutFee: fee}
+	s.Require().NoError(nolus(s.chainB).FeeRefunderKeeper.SetParams(s.chainB.GetContext(), feerefundertypes.NewParams(minFee)))
+	s.coordinator.CommitBlock(s.chainB)
+}
+
+func nolus(chain *ibctesting.TestChain) *nolusapp.App {
+	return chain.App.(*nolusapp.App)
+}
+
+// voucher is the denom of the base denom of chain A on chain B.
+func (s *HooksTestSuite) voucher() string {
+	endpoint := s.path.EndpointB
+	return transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom(endpoint.ChannelConfig.PortID, endpoint.ChannelID, sdk.DefaultBondDenom)).IBCDenom()
+}
+
+func (s *HooksTestSuite) balance(chain *ibctesting.TestChain, addr sdk.AccAddress, denom string) sdkmath.Int {
+	return nolus(chain).BankKeeper.GetBalance(chain.GetContext(), addr, denom).Amount
+}
+
+// intermediateSender is the sender of the contract calls made with the transfers of the sender of chain A.
+func (s *HooksTestSuite) intermediateSender() sdk.AccAddress {
+	sender, err := ibchooksutils.DeriveIntermediateSender(s.path.EndpointB.ChannelID, s.chainA.SenderAccount.GetAddress().String(), params.Bech32PrefixAccAddr)
+	s.Require().NoError(err)
+
+	return sdk.MustAccAddressFromBech32(sender)
+}
+
+// instantiateReflect instantiates the reflect contract on chain B owned by the owner and funds
+// it with the fees of a transfer.
+func (s *HooksTestSuite) instantiateReflect(owner sdk.AccAddress) sdk.AccAddress {
+	wasmCode, err := os.ReadFile("testdata/reflect.wasm")
+	s.Require().NoError(err)
+
+	ctx := s.chainB.GetContext()
+	contractKeeper := wasmkeeper.NewDefaultPermissionKeeper(nolus(s.chainB).WasmKeeper)
+	codeID, _, err := contractKeeper.Create(ctx, owner, wasmCode, nil)
+	s.Require().NoError(err)
+
+	contract, _, err := contractKeeper.Instantiate(ctx, codeID, owner, owner, []byte("{}"), "reflect", nil)
+	s.Require().NoError(err)
+
+	s.Require().NoError(nolus(s.chainB).BankKeeper.SendCoins(ctx, s.chainB.SenderAccount.GetAddress(), contract, fee.Add(fee...)))
+	s.coordinator.CommitBlock(s.chainB)
+
+	return contract
+}
+
+// wasmMemo asks chain B to execute the contract with the msg.
+func wasmMemo(contract sdk.AccAddress, msg string) string {
+	return fmt.Sprintf(`{"wasm":{"contract":"%s","msg":%s}}`, contract, msg)
+}
+
+// reflectTransfer is the msg which makes the reflect contract send the vouchers back to chain A
+// and ask to be called back with the outcome.
+func (s *HooksTestSuite) reflectTransfer(contract sdk.AccAddress, timeoutTimestamp uint64) string {
+	msg := &neutrontransfertypes.MsgTransfer{
+		SourcePort:       s.path.EndpointB.ChannelConfig.PortID,
+		SourceChannel:    s.path.EndpointB.ChannelID,
+		Token:            sdk.NewCoin(s.voucher(), amount),
+		Sender:           contract.String(),
+		Receiver:         s.chainA.SenderAccount.GetAddress().String(),
+		TimeoutHeight:    clienttypes.ZeroHeight(),
+		TimeoutTimestamp: timeoutTimestamp,
+		Memo:             fmt.Sprintf(`{"ibc_callback":"%s"}`, contract),
+		Fee:              feerefundertypes.Fee{AckFee: fee, TimeoutFee: fee},
+	}
+	bz, err := nolus(s.chainB).AppCodec().Marshal(msg)
+	s.Require().NoError(err)
+
+	return fmt.Sprintf(`{"reflect_msg":{"msgs":[{"stargate":{"type_url":"/neutron.transfer.MsgTransfer","value":"%s"}}]}}`, base64.StdEncoding.EncodeToString(bz))
+}
+
+// transfer sends the base denom of chain A to the receiver on chain B and returns the events of
+// receiving the packet along with its acknowledgement.
+func (s *HooksTestSuite) transfer(receiver sdk.AccAddress, memo string) (sdk.Events, channeltypes.Acknowledgement) {
+	msg := transfertypes.NewMsgTransfer(s.path.EndpointA.ChannelConfig.PortID, s.path.EndpointA.ChannelID, sdk.NewCoin(sdk.DefaultBondDenom, amount),
+		s.chainA.SenderAccount.GetAddress().String(), receiver.String(), clienttypes.NewHeight(1, 110), 0, memo)
+	res, err := s.chainA.SendMsgs(msg)
+	s.Require().NoError(err)
+
+	packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	s.Require().NoError(err)
+
+	s.Require().NoError(s.path.EndpointB.UpdateClient())
+	res, err = s.path.EndpointB.RecvPacketWithResult(packet)
+	s.Require().NoError(err)
+
+	bz, err := ibctesting.ParseAckFromEvents(res.GetEvents())
+	s.Require().NoError(err)
+	s.Require().NoError(s.path.EndpointA.AcknowledgePacket(packet, bz))
+
+	var ack channeltypes.Acknowledgement
+	s.Require().NoError(channeltypes.SubModuleCdc.UnmarshalJSON(bz, &ack))
+
+	return res.GetEvents(), ack
+}
+
+// callbacks returns the sudo payloads of the callbacks of the contract, which the reflect
+// contract fails as it has no sudo entry point.
+func (s *HooksTestSuite) callbacks(contract sdk.AccAddress) []ibchooks.IBCLifecycleComplete {
+	var callbacks []ibchooks.IBCLifecycleComplete
+	for _, failure := range nolus(s.chainB).ContractManagerKeeper.GetAllFailures(s.chainB.GetContext()) {
+		var callback ibchooks.IBCLifecycleComplete
+		if failure.Address == contract.String() && json.Unmarshal(failure.SudoPayload, &callback) == nil &&
+			(callback.IBCLifecycleComplete.IBCAck != nil || callback.IBCLifecycleComplete.IBCTimeout != nil) {
+			callbacks = append(callbacks, callback)
+		}
+	}
+
+	return callbacks
+}
+
+func (s *HooksTestSuite) TestExecuteContract() {
+	contract := s.instantiateReflect(s.intermediateSender())
+
+	msg := fmt.Sprintf(`{"change_owner":{"owner":"%s"}}`, s.intermediateSender())
+	_, ack := s.transfer(contract, wasmMemo(contract, msg))
+	s.Require().True(ack.Success(), ack.GetError())
+
+	// the contract is paid with the tokens of the transfer
+	s.Require().Equal(amount, s.balance(s.chainB, contract, s.voucher()))
+	s.Require().True(s.balance(s.chainB, s.intermediateSender(), s.voucher()).IsZero())
+}
+
+func (s *HooksTestSuite) TestExecuteContractFailure() {
+	contract := s.instantiateReflect(s.chainB.SenderAccount.GetAddress())
+	balance := s.balance(s.chainA, s.chainA.SenderAccount.GetAddress(), sdk.DefaultBondDenom)
+
+	testCases := []struct {
+		name     string
+		receiver sdk.AccAddress
+		memo     string
+	}{
+		{"contract fails", contract, wasmMemo(contract, fmt.Sprintf(`{"change_owner":{"owner":"%s"}}`, s.intermediateSender()))},
+		{"receiver is not the contract", s.chainB.SenderAccount.GetAddress(), wasmMemo(contract, `{"change_owner":{}}`)},
+		{"msg is not an object", contract, wasmMemo(contract, `"change_owner"`)},
+	}
+
+	for _, tc := range testCases {
+		_, ack := s.transfer(tc.receiver, tc.memo)
+		s.Require().False(ack.Success(), tc.name)
+
+		// the counterparty refunds the sender
+		s.Require().Equal(balance, s.balance(s.chainA, s.chainA.SenderAccount.GetAddress(), sdk.DefaultBondDenom), tc.name)
+		s.Require().True(nolus(s.chainB).BankKeeper.GetSupply(s.chainB.GetContext(), s.voucher()).Amount.IsZero(), tc.name)
+	}
+}
+
+func (s *HooksTestSuite) TestCallbackOnAcknowledgement() {
+	contract := s.instantiateReflect(s.intermediateSender())
+	balance := s.balance(s.chainA, s.chainA.SenderAccount.GetAddress(), sdk.DefaultBondDenom)
+
+	timeout := uint64(s.chainB.CurrentHeader.Time.Add(time.Hour).UnixNano())
+	events, ack := s.transfer(contract, wasmMemo(contract, s.reflectTransfer(contract, timeout)))
+	s.Require().True(ack.Success(), ack.GetError())
+
+	packet, err := ibctesting.ParsePacketFromEvents(events)
+	s.Require().NoError(err)
+	s.Require().NoError(s.path.RelayPacket(packet))
+
+	s.Require().Equal(balance, s.balance(s.chainA, s.chainA.SenderAccount.GetAddress(), sdk.DefaultBondDenom))
+	s.Require().Equal([]ibchooks.IBCLifecycleComplete{{
+		IBCLifecycleComplete: ibchooks.IBCLifecycle{
+			IBCAck: &ibchooks.IBCAck{
+				Channel:  s.path.EndpointB.ChannelID,
+				Sequence: packet.GetSequence(),
+				Ack:      string(channeltypes.NewResultAcknowledgement([]byte{byte(1)}).Acknowledgement()),
+				Success:  true,
+			},
+		},
+	}}, s.callbacks(contract))
+}
+
+func (s *HooksTestSuite) TestCallbackOnTimeout() {
+	contract := s.instantiateReflect(s.intermediateSender())
+
+	timeout := uint64(s.chainB.CurrentHeader.Time.Add(time.Minute).UnixNano())
+	events, ack := s.transfer(contract, wasmMemo(contract, s.reflectTransfer(contract, timeout)))
+	s.Require().True(ack.Success(), ack.GetError())
+
+	packet, err := ibctesting.ParsePacketFromEvents(events)
+	s.Require().NoError(err)
+
+	s.coordinator.IncrementTimeBy(2 * time.Minute)
+	s.coordinator.CommitBlock(s.chainA)
+	s.Require().NoError(s.path.EndpointB.UpdateClient())
+	s.Require().NoError(s.path.EndpointB.TimeoutPacket(packet))
+
+	// the contract is refunded and called back
+	s.Require().Equal(amount, s.balance(s.chainB, contract, s.voucher()))
+	s.Require().Equal([]ibchooks.IBCLifecycleComplete{{
+		IBCLifecycleComplete: ibchooks.IBCLifecycle{
+			IBCTimeout: &ibchooks.IBCTimeout{
+				Channel:  s.path.EndpointB.ChannelID,
+				Sequence: packet.GetSequence(),
+			},
+		},
+	}}, s.callbacks(contract))
+}
@@ -174,6 +174,14 @@ func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
 		panic(errNegativeBlockTime)
 	}
 
+	if minter.Paused {
+		// keep the previous block timestamp current, so that the paused time is not minted for on resume
+		minter.PrevBlockTimestamp = sdkmath.NewUint(uint64(blockTime))
+		minter.AnnualInflation = sdkmath.ZeroUint()
+		k.SetMinter(ctx, minter)
+		return
+	}
+
 	coinAmount := calcTokens(sdkmath.NewUint(uint64(blockTime)), &minter, params.MaxMintableNanoseconds)
 	minter.AnnualInflation = predictTotalMinted(minter.TotalMinted, minter.NormTimePassed, twelveMonths)
 	ctx.Logger().Debug(fmt.Sprintf("miner: %v total, %v norm time, %v minted", minter.TotalMinted.String(), minter.NormTimePassed.String(), coinAmount.String()))
@@ -24,6 +24,7 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryParams(),
 		GetCmdQueryMintState(),
 		GetCmdAnnualQueryInflation(),
+		GetCmdQueryApr(),
 	)
 
 	return mintingQueryCmd
@@ -114,3 +115,32 @@ func GetCmdAnnualQueryInflation() *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdQueryApr implements a command to return the inflation rate, the staking APR and the real yield.
+func GetCmdQueryApr() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "apr",
+		Short: "Query the inflation rate, the staking APR and the real yield of the tokens minted over the next 12 months",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			params := &types.QueryAprRequest{}
+
+			res, err := queryClient.Apr(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
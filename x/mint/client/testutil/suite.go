@@ -96,12 +96,13 @@ func (s *IntegrationTestSuite) TestGetCmdQueryMintState() {
 		{
 			"json output",
 			[]string{fmt.Sprintf("--%s=1", flags.FlagHeight), fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
-			`{"norm_time_passed":"0.470000000000000000","total_minted":"0"}`,
+			`{"norm_time_passed":"0.470000000000000000","total_minted":"0","paused":false}`,
 		},
 		{
 			"text output",
 			[]string{fmt.Sprintf("--%s=1", flags.FlagHeight), fmt.Sprintf("--%s=text", tmcli.OutputFlag)},
 			`norm_time_passed: "0.470000000000000000"
+paused: false
 total_minted: "0"`,
 		},
 	}
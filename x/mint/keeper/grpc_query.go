@@ -3,6 +3,8 @@ package keeper
 import (
 	"context"
 
+	sdkmath "cosmossdk.io/math"
+
 	"github.com/Nolus-Protocol/nolus-core/x/mint/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -23,7 +25,7 @@ func (k Keeper) MintState(c context.Context, _ *types.QueryMintStateRequest) (*t
 	ctx := sdk.UnwrapSDKContext(c)
 	minter := k.GetMinter(ctx)
 
-	return &types.QueryMintStateResponse{NormTimePassed: minter.NormTimePassed, TotalMinted: minter.TotalMinted}, nil
+	return &types.QueryMintStateResponse{NormTimePassed: minter.NormTimePassed, TotalMinted: minter.TotalMinted, Paused: minter.Paused}, nil
 }
 
 // AnnualInflation returns minter.Inflation of the mint module.
@@ -33,3 +35,47 @@ func (k Keeper) AnnualInflation(c context.Context, _ *types.QueryAnnualInflation
 
 	return &types.QueryAnnualInflationResponse{AnnualInflation: minter.AnnualInflation}, nil
 }
+
+// Apr returns the nominal inflation rate, the staking APR and the real yield of the tokens
+// minted over the next 12 months, as predicted by the minter at the beginning of the block.
+// The real yield is relative to the net inflation, which takes off the tokens expected to be
+// burned from the tax over the same 12 months.
+// The rates are zero when there is no supply or no bonded tokens to relate the minted tokens to.
+func (k Keeper) Apr(c context.Context, _ *types.QueryAprRequest) (*types.QueryAprResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	minter := k.GetMinter(ctx)
+	params := k.GetParams(ctx)
+
+	annualInflation := sdkmath.NewIntFromBigInt(minter.AnnualInflation.BigInt())
+	totalSupply := k.bankKeeper.GetSupply(ctx, params.MintDenom).Amount
+	bondedTokens := k.stakingKeeper.TotalBondedTokens(ctx)
+	communityTax := k.distrKeeper.GetCommunityTax(ctx)
+	annualBurn := k.taxKeeper.AnnualBurn(ctx)
+
+	inflationRate, netInflationRate := sdkmath.LegacyZeroDec(), sdkmath.LegacyZeroDec()
+	if totalSupply.IsPositive() {
+		inflationRate = sdkmath.LegacyNewDecFromInt(annualInflation).QuoInt(totalSupply)
+		netInflationRate = sdkmath.LegacyNewDecFromInt(annualInflation.Sub(annualBurn)).QuoInt(totalSupply)
+	}
+
+	stakingApr, realYield := sdkmath.LegacyZeroDec(), sdkmath.LegacyZeroDec()
+	if bondedTokens.IsPositive() {
+		stakingApr = sdkmath.LegacyNewDecFromInt(annualInflation).Mul(sdkmath.LegacyOneDec().Sub(communityTax)).QuoInt(bondedTokens)
+	}
+	// the real yield is undefined when the tokens expected to be burned exceed the supply
+	if bondedTokens.IsPositive() && sdkmath.LegacyOneDec().Add(netInflationRate).IsPositive() {
+		realYield = sdkmath.LegacyOneDec().Add(stakingApr).Quo(sdkmath.LegacyOneDec().Add(netInflationRate)).Sub(sdkmath.LegacyOneDec())
+	}
+
+	return &types.QueryAprResponse{
+		InflationRate:    inflationRate,
+		StakingApr:       stakingApr,
+		RealYield:        realYield,
+		AnnualInflation:  minter.AnnualInflation,
+		TotalSupply:      totalSupply,
+		BondedTokens:     bondedTokens,
+		CommunityTax:     communityTax,
+		AnnualBurn:       annualBurn,
+		NetInflationRate: netInflationRate,
+	}, nil
+}
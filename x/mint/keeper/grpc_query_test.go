@@ -11,6 +11,7 @@ import (
 	simulationapp "github.com/Nolus-Protocol/nolus-core/testutil/simapp"
 	"github.com/Nolus-Protocol/nolus-core/x/mint/keeper"
 	"github.com/Nolus-Protocol/nolus-core/x/mint/types"
+	taxtypes "github.com/Nolus-Protocol/nolus-core/x/tax/types"
 )
 
 var (
@@ -49,3 +50,75 @@ func (s *KeeperTestSuite) TestMintState() {
 	s.Require().NoError(err)
 	s.Require().Equal(sdkmath.ZeroUint(), resp.TotalMinted)
 }
+
+func (s *KeeperTestSuite) TestApr() {
+	s.SetupTest(false)
+	minterKeeper := s.app.MintKeeper
+
+	minter := minterKeeper.GetMinter(s.ctx)
+	minter.AnnualInflation = sdkmath.NewUint(1_000_000_000)
+	minterKeeper.SetMinter(s.ctx, minter)
+
+	totalSupply := s.app.BankKeeper.GetSupply(s.ctx, defaultMintDenom).Amount
+	bondedTokens := s.app.StakingKeeper.TotalBondedTokens(s.ctx)
+	communityTax := s.app.DistrKeeper.GetCommunityTax(s.ctx)
+	s.Require().True(totalSupply.IsPositive())
+	s.Require().True(bondedTokens.IsPositive())
+
+	resp, err := minterKeeper.Apr(s.ctx, &types.QueryAprRequest{})
+	s.Require().NoError(err)
+
+	inflationRate := sdk.NewDec(1_000_000_000).QuoInt(totalSupply)
+	stakingApr := sdk.NewDec(1_000_000_000).Mul(sdk.OneDec().Sub(communityTax)).QuoInt(bondedTokens)
+	s.Require().Equal(minter.AnnualInflation, resp.AnnualInflation)
+	s.Require().Equal(totalSupply, resp.TotalSupply)
+	s.Require().Equal(bondedTokens, resp.BondedTokens)
+	s.Require().Equal(communityTax, resp.CommunityTax)
+	s.Require().Equal(inflationRate, resp.InflationRate)
+	s.Require().Equal(stakingApr, resp.StakingApr)
+	s.Require().True(resp.AnnualBurn.IsZero())
+	s.Require().Equal(inflationRate, resp.NetInflationRate)
+	s.Require().Equal(sdk.OneDec().Add(stakingApr).Quo(sdk.OneDec().Add(inflationRate)).Sub(sdk.OneDec()), resp.RealYield)
+	// the bonded tokens are part of the supply, so stakers outpace the inflation
+	s.Require().True(resp.RealYield.IsPositive())
+}
+
+func (s *KeeperTestSuite) TestAprNoInflation() {
+	s.SetupTest(false)
+	minterKeeper := s.app.MintKeeper
+
+	minter := minterKeeper.GetMinter(s.ctx)
+	minter.AnnualInflation = sdkmath.ZeroUint()
+	minterKeeper.SetMinter(s.ctx, minter)
+
+	resp, err := minterKeeper.Apr(s.ctx, &types.QueryAprRequest{})
+	s.Require().NoError(err)
+	s.Require().True(resp.InflationRate.IsZero())
+	s.Require().True(resp.StakingApr.IsZero())
+	s.Require().True(resp.RealYield.IsZero())
+}
+
+func (s *KeeperTestSuite) TestAprWithBurn() {
+	s.SetupTest(false)
+	minterKeeper := s.app.MintKeeper
+
+	minter := minterKeeper.GetMinter(s.ctx)
+	minter.AnnualInflation = sdkmath.NewUint(1_000_000_000)
+	minterKeeper.SetMinter(s.ctx, minter)
+
+	// 50_000_000 burned in the last month are 600_000_000 over the next 12 months
+	s.app.TaxKeeper.SetBurnedSupply(s.ctx, taxtypes.BurnedSupply{
+		Amount: sdkmath.NewInt(50_000_000),
+		Since:  s.ctx.BlockTime().Add(-30 * 24 * time.Hour),
+	})
+
+	resp, err := minterKeeper.Apr(s.ctx, &types.QueryAprRequest{})
+	s.Require().NoError(err)
+
+	totalSupply := s.app.BankKeeper.GetSupply(s.ctx, defaultMintDenom).Amount
+	netInflationRate := sdk.NewDec(400_000_000).QuoInt(totalSupply)
+	s.Require().Equal(sdkmath.NewInt(600_000_000), resp.AnnualBurn)
+	s.Require().Equal(sdk.NewDec(1_000_000_000).QuoInt(totalSupply), resp.InflationRate)
+	s.Require().Equal(netInflationRate, resp.NetInflationRate)
+	s.Require().Equal(sdk.OneDec().Add(resp.StakingApr).Quo(sdk.OneDec().Add(netInflationRate)).Sub(sdk.OneDec()), resp.RealYield)
+}
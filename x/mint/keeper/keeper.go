@@ -16,6 +16,9 @@ type Keeper struct {
 	cdc              codec.BinaryCodec
 	storeKey         storetypes.StoreKey
 	bankKeeper       types.BankKeeper
+	stakingKeeper    types.StakingKeeper
+	distrKeeper      types.DistributionKeeper
+	taxKeeper        types.TaxKeeper
 	feeCollectorName string
 
 	// the address capable of executing a MsgUpdateParams message. Typically, this
@@ -27,6 +30,7 @@ type Keeper struct {
 func NewKeeper(
 	cdc codec.BinaryCodec, key storetypes.StoreKey,
 	ak types.AccountKeeper, bk types.BankKeeper,
+	sk types.StakingKeeper, dk types.DistributionKeeper, tk types.TaxKeeper,
 	feeCollectorName string, authority string,
 ) Keeper {
 	// ensure mint module account is set
@@ -38,6 +42,9 @@ func NewKeeper(
 		cdc:              cdc,
 		storeKey:         key,
 		bankKeeper:       bk,
+		stakingKeeper:    sk,
+		distrKeeper:      dk,
+		taxKeeper:        tk,
 		feeCollectorName: feeCollectorName,
 		authority:        authority,
 	}
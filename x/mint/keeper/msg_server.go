@@ -40,3 +40,61 @@ func (ms msgServer) UpdateParams(goCtx context.Context, req *types.MsgUpdatePara
 
 	return &types.MsgUpdateParamsResponse{}, nil
 }
+
+func (ms msgServer) PauseMinting(goCtx context.Context, req *types.MsgPauseMinting) (*types.MsgPauseMintingResponse, error) {
+	if err := req.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	if ms.authority != req.Authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.authority, req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	minter := ms.GetMinter(ctx)
+	if minter.Paused {
+		return nil, types.ErrMintingPaused
+	}
+
+	minter.Paused = true
+	ms.SetMinter(ctx, minter)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypePauseMinting,
+			sdk.NewAttribute(types.AttributeKeyTotalMinted, minter.TotalMinted.String()),
+			sdk.NewAttribute(types.AttributeKeyPrevBlockTime, minter.PrevBlockTimestamp.String()),
+		),
+	)
+
+	return &types.MsgPauseMintingResponse{}, nil
+}
+
+func (ms msgServer) ResumeMinting(goCtx context.Context, req *types.MsgResumeMinting) (*types.MsgResumeMintingResponse, error) {
+	if err := req.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	if ms.authority != req.Authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.authority, req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	minter := ms.GetMinter(ctx)
+	if !minter.Paused {
+		return nil, types.ErrMintingNotPaused
+	}
+
+	minter.Paused = false
+	ms.SetMinter(ctx, minter)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeResumeMinting,
+			sdk.NewAttribute(types.AttributeKeyTotalMinted, minter.TotalMinted.String()),
+			sdk.NewAttribute(types.AttributeKeyPrevBlockTime, minter.PrevBlockTimestamp.String()),
+		),
+	)
+
+	return &types.MsgResumeMintingResponse{}, nil
+}
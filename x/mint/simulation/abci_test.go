@@ -9,10 +9,13 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	"github.com/Nolus-Protocol/nolus-core/app/params"
 	"github.com/Nolus-Protocol/nolus-core/testutil/simapp"
 	"github.com/Nolus-Protocol/nolus-core/x/mint"
+	"github.com/Nolus-Protocol/nolus-core/x/mint/keeper"
+	minttypes "github.com/Nolus-Protocol/nolus-core/x/mint/types"
 
 	"github.com/stretchr/testify/require"
 )
@@ -39,3 +42,59 @@ func Test_BeginBlock(t *testing.T) {
 	fmt.Printf("balance %v \n", feesCollected)
 	require.Equal(t, sdk.NewIntFromBigInt(minter.TotalMinted.BigInt()), feesCollectedInt.AmountOf(sdk.DefaultBondDenom))
 }
+
+func Test_BeginBlock_Paused(t *testing.T) {
+	params.SetAddressPrefixes()
+	app, err := simapp.TestSetup(t)
+	require.NoError(t, err)
+
+	blockTime := time.Now()
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: app.LastBlockHeight() + 1}).WithBlockTime(blockTime)
+	minterKeeper := app.MintKeeper
+	msgServer := keeper.NewMsgServerImpl(*minterKeeper)
+	mint.BeginBlocker(ctx, *minterKeeper)
+
+	// the tokens minted for 10 seconds after the first block
+	expectedCtx, _ := ctx.CacheContext()
+	mint.BeginBlocker(expectedCtx.WithBlockTime(blockTime.Add(time.Second*10)), *minterKeeper)
+	expected := minterKeeper.GetMinter(expectedCtx)
+
+	_, err = msgServer.PauseMinting(ctx, &minttypes.MsgPauseMinting{Authority: app.AccountKeeper.GetModuleAddress(types.FeeCollectorName).String()})
+	require.ErrorIs(t, err, govtypes.ErrInvalidSigner)
+	_, err = msgServer.ResumeMinting(ctx, &minttypes.MsgResumeMinting{Authority: minterKeeper.GetAuthority()})
+	require.ErrorIs(t, err, minttypes.ErrMintingNotPaused)
+
+	_, err = msgServer.PauseMinting(ctx, &minttypes.MsgPauseMinting{Authority: minterKeeper.GetAuthority()})
+	require.NoError(t, err)
+	require.Equal(t, minttypes.EventTypePauseMinting, ctx.EventManager().Events()[len(ctx.EventManager().Events())-1].Type)
+	_, err = msgServer.PauseMinting(ctx, &minttypes.MsgPauseMinting{Authority: minterKeeper.GetAuthority()})
+	require.ErrorIs(t, err, minttypes.ErrMintingPaused)
+
+	// nothing is minted while paused, but the previous block timestamp keeps advancing
+	before := minterKeeper.GetMinter(ctx)
+	pausedCtx := ctx.WithBlockTime(blockTime.Add(time.Second * 40))
+	mint.BeginBlocker(pausedCtx, *minterKeeper)
+	paused := minterKeeper.GetMinter(pausedCtx)
+	require.True(t, paused.Paused)
+	require.Equal(t, before.TotalMinted, paused.TotalMinted)
+	require.Equal(t, before.NormTimePassed, paused.NormTimePassed)
+	require.Equal(t, uint64(pausedCtx.BlockTime().UnixNano()), paused.PrevBlockTimestamp.Uint64())
+	require.True(t, paused.AnnualInflation.IsZero())
+
+	res, err := minterKeeper.MintState(pausedCtx, &minttypes.QueryMintStateRequest{})
+	require.NoError(t, err)
+	require.True(t, res.Paused)
+
+	_, err = msgServer.ResumeMinting(pausedCtx, &minttypes.MsgResumeMinting{Authority: minterKeeper.GetAuthority()})
+	require.NoError(t, err)
+	require.Equal(t, minttypes.EventTypeResumeMinting, pausedCtx.EventManager().Events()[len(pausedCtx.EventManager().Events())-1].Type)
+
+	// on resume only the time since the last paused block is minted for
+	resumedCtx := pausedCtx.WithBlockTime(blockTime.Add(time.Second * 50))
+	mint.BeginBlocker(resumedCtx, *minterKeeper)
+	resumed := minterKeeper.GetMinter(resumedCtx)
+	require.False(t, resumed.Paused)
+	require.Equal(t, expected.TotalMinted, resumed.TotalMinted)
+	require.Equal(t, expected.NormTimePassed, resumed.NormTimePassed)
+	require.False(t, resumed.AnnualInflation.IsZero())
+}
@@ -15,9 +15,13 @@ import (
 
 // Simulation operation weights constants.
 const (
-	DefaultWeightMsgUpdateParams int = 100
+	DefaultWeightMsgUpdateParams  int = 100
+	DefaultWeightMsgPauseMinting  int = 5
+	DefaultWeightMsgResumeMinting int = 5
 
-	OpWeightMsgUpdateParams = "op_weight_msg_update_params" //nolint:gosec
+	OpWeightMsgUpdateParams  = "op_weight_msg_update_params"  //nolint:gosec
+	OpWeightMsgPauseMinting  = "op_weight_msg_pause_minting"  //nolint:gosec
+	OpWeightMsgResumeMinting = "op_weight_msg_resume_minting" //nolint:gosec
 )
 
 // ProposalMsgs defines the module weighted proposals' contents.
@@ -28,6 +32,16 @@ func ProposalMsgs() []simtypes.WeightedProposalMsg {
 			DefaultWeightMsgUpdateParams,
 			SimulateMsgUpdateParams,
 		),
+		simulation.NewWeightedProposalMsg(
+			OpWeightMsgPauseMinting,
+			DefaultWeightMsgPauseMinting,
+			SimulateMsgPauseMinting,
+		),
+		simulation.NewWeightedProposalMsg(
+			OpWeightMsgResumeMinting,
+			DefaultWeightMsgResumeMinting,
+			SimulateMsgResumeMinting,
+		),
 	}
 }
 
@@ -45,3 +59,17 @@ func SimulateMsgUpdateParams(r *rand.Rand, _ sdk.Context, _ []simtypes.Account)
 		Params:    params,
 	}
 }
+
+// SimulateMsgPauseMinting returns a MsgPauseMinting.
+func SimulateMsgPauseMinting(_ *rand.Rand, _ sdk.Context, _ []simtypes.Account) sdk.Msg {
+	var authority sdk.AccAddress = address.Module("gov")
+
+	return &types.MsgPauseMinting{Authority: authority.String()}
+}
+
+// SimulateMsgResumeMinting returns a MsgResumeMinting.
+func SimulateMsgResumeMinting(_ *rand.Rand, _ sdk.Context, _ []simtypes.Account) sdk.Msg {
+	var authority sdk.AccAddress = address.Module("gov")
+
+	return &types.MsgResumeMinting{Authority: authority.String()}
+}
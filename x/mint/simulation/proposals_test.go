@@ -26,7 +26,7 @@ func TestProposalMsgs(t *testing.T) {
 
 	// execute ProposalMsgs function
 	weightedProposalMsgs := simulation.ProposalMsgs()
-	assert.Assert(t, len(weightedProposalMsgs) == 1)
+	assert.Assert(t, len(weightedProposalMsgs) == 3)
 
 	w0 := weightedProposalMsgs[0]
 
@@ -41,4 +41,20 @@ func TestProposalMsgs(t *testing.T) {
 	assert.Equal(t, sdk.AccAddress(address.Module("gov")).String(), msgUpdateParams.Authority)
 	assert.Equal(t, "UzXPFGkqEG", msgUpdateParams.Params.MintDenom)
 	assert.DeepEqual(t, sdkmath.NewUint(uint64(122877)), msgUpdateParams.Params.MaxMintableNanoseconds)
+
+	w1 := weightedProposalMsgs[1]
+	assert.Equal(t, simulation.OpWeightMsgPauseMinting, w1.AppParamsKey())
+	assert.Equal(t, simulation.DefaultWeightMsgPauseMinting, w1.DefaultWeight())
+
+	msgPauseMinting, ok := w1.MsgSimulatorFn()(r, ctx, accounts).(*types.MsgPauseMinting)
+	assert.Assert(t, ok)
+	assert.Equal(t, sdk.AccAddress(address.Module("gov")).String(), msgPauseMinting.Authority)
+
+	w2 := weightedProposalMsgs[2]
+	assert.Equal(t, simulation.OpWeightMsgResumeMinting, w2.AppParamsKey())
+	assert.Equal(t, simulation.DefaultWeightMsgResumeMinting, w2.DefaultWeight())
+
+	msgResumeMinting, ok := w2.MsgSimulatorFn()(r, ctx, accounts).(*types.MsgResumeMinting)
+	assert.Assert(t, ok)
+	assert.Equal(t, sdk.AccAddress(address.Module("gov")).String(), msgResumeMinting.Authority)
 }
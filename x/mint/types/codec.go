@@ -28,12 +28,16 @@ func init() {
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(Params{}, "nolus-core/x/mint/Params", nil)
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "nolus-core/x/mint/MsgUpdateParams")
+	legacy.RegisterAminoMsg(cdc, &MsgPauseMinting{}, "nolus-core/x/mint/MsgPauseMinting")
+	legacy.RegisterAminoMsg(cdc, &MsgResumeMinting{}, "nolus-core/x/mint/MsgResumeMinting")
 }
 
 func RegisterInterfaces(registry types.InterfaceRegistry) {
 	registry.RegisterImplementations(
 		(*sdk.Msg)(nil),
 		&MsgUpdateParams{},
+		&MsgPauseMinting{},
+		&MsgResumeMinting{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -0,0 +1,13 @@
+package types
+
+// DONTCOVER
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// x/mint module sentinel errors.
+var (
+	ErrMintingPaused    = errorsmod.Register(ModuleName, 1, "minting is already paused")
+	ErrMintingNotPaused = errorsmod.Register(ModuleName, 2, "minting is not paused")
+)
@@ -2,7 +2,11 @@ package types
 
 // Minting module event types.
 const (
-	EventTypeMint = ModuleName
+	EventTypeMint          = ModuleName
+	EventTypePauseMinting  = "pause_minting"
+	EventTypeResumeMinting = "resume_minting"
 
-	AttributeKeyDenom = "denom"
+	AttributeKeyDenom         = "denom"
+	AttributeKeyPrevBlockTime = "prev_block_timestamp"
+	AttributeKeyTotalMinted   = "total_minted"
 )
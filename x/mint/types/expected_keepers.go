@@ -1,6 +1,7 @@
 package types // noalias
 
 import (
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 )
@@ -20,4 +21,20 @@ type BankKeeper interface {
 	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
 	SendCoinsFromModuleToModule(ctx sdk.Context, senderModule, recipientModule string, amt sdk.Coins) error
 	MintCoins(ctx sdk.Context, name string, amt sdk.Coins) error
+	GetSupply(ctx sdk.Context, denom string) sdk.Coin
+}
+
+// StakingKeeper defines the expected staking keeper.
+type StakingKeeper interface {
+	TotalBondedTokens(ctx sdk.Context) sdkmath.Int
+}
+
+// DistributionKeeper defines the expected distribution keeper.
+type DistributionKeeper interface {
+	GetCommunityTax(ctx sdk.Context) sdkmath.LegacyDec
+}
+
+// TaxKeeper defines the expected tax keeper.
+type TaxKeeper interface {
+	AnnualBurn(ctx sdk.Context) sdkmath.Int
 }
@@ -30,6 +30,10 @@ type Minter struct {
 	TotalMinted        cosmossdk_io_math.Uint      `protobuf:"bytes,3,opt,name=total_minted,json=totalMinted,proto3,customtype=cosmossdk.io/math.Uint" json:"total_minted"`
 	PrevBlockTimestamp cosmossdk_io_math.Uint      `protobuf:"bytes,4,opt,name=prev_block_timestamp,json=prevBlockTimestamp,proto3,customtype=cosmossdk.io/math.Uint" json:"prev_block_timestamp"`
 	AnnualInflation    cosmossdk_io_math.Uint      `protobuf:"bytes,5,opt,name=annual_inflation,json=annualInflation,proto3,customtype=cosmossdk.io/math.Uint" json:"annual_inflation"`
+	// paused stops the minting until it is resumed by the authority. The
+	// previous block timestamp keeps advancing, so the time spent paused is
+	// never minted for.
+	Paused bool `protobuf:"varint,6,opt,name=paused,proto3" json:"paused,omitempty"`
 }
 
 func (m *Minter) Reset()         { *m = Minter{} }
@@ -65,6 +69,13 @@ func (m *Minter) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Minter proto.InternalMessageInfo
 
+func (m *Minter) GetPaused() bool {
+	if m != nil {
+		return m.Paused
+	}
+	return false
+}
+
 // Params holds parameters for the mint module.
 type Params struct {
 	// type of coin to mint
@@ -120,32 +131,32 @@ func init() {
 func init() { proto.RegisterFile("nolus/mint/v1beta1/mint.proto", fileDescriptor_e9c8d0486b75e8ca) }
 
 var fileDescriptor_e9c8d0486b75e8ca = []byte{
-	// 389 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xc1, 0x8a, 0x1a, 0x31,
-	0x18, 0xc7, 0x67, 0x6c, 0x2b, 0x98, 0x96, 0x56, 0x82, 0xc8, 0xd0, 0xe2, 0x58, 0xec, 0xa5, 0x17,
-	0x67, 0x90, 0x3e, 0x41, 0xc5, 0x8b, 0xb4, 0xca, 0x20, 0x16, 0x4a, 0x2f, 0x43, 0x26, 0x93, 0x8e,
-	0xc1, 0x49, 0xbe, 0x61, 0x12, 0x45, 0x8f, 0xfb, 0x06, 0xfb, 0x36, 0xfb, 0x0a, 0x1e, 0x3d, 0x2e,
-	0x7b, 0x90, 0x45, 0x5f, 0x64, 0x49, 0x1c, 0xd9, 0xc3, 0x1e, 0xd6, 0x5b, 0xf2, 0x7d, 0xf9, 0xfd,
-	0xf3, 0xcf, 0x97, 0x3f, 0xea, 0x48, 0xc8, 0x57, 0x2a, 0x14, 0x5c, 0xea, 0x70, 0x3d, 0x48, 0x98,
-	0x26, 0x03, 0xbb, 0x09, 0x8a, 0x12, 0x34, 0x60, 0x6c, 0xdb, 0x81, 0xad, 0x54, 0xed, 0xcf, 0xad,
-	0x0c, 0x32, 0xb0, 0xed, 0xd0, 0xac, 0xce, 0x27, 0x7b, 0x77, 0x35, 0x54, 0x9f, 0x70, 0xa9, 0x59,
-	0x89, 0x27, 0xa8, 0x29, 0xa1, 0x14, 0xb1, 0xe6, 0x82, 0xc5, 0x05, 0x51, 0x8a, 0xa5, 0x5e, 0xed,
-	0xab, 0xfb, 0xbd, 0x31, 0xfc, 0xb6, 0x3b, 0x74, 0x9d, 0x87, 0x43, 0xf7, 0x0b, 0x05, 0x25, 0x40,
-	0xa9, 0x74, 0x19, 0x70, 0x08, 0x05, 0xd1, 0x8b, 0xe0, 0x37, 0xcb, 0x08, 0xdd, 0x8e, 0x18, 0x9d,
-	0x7d, 0x34, 0xf0, 0x9c, 0x0b, 0x16, 0x59, 0x14, 0xff, 0x44, 0x1f, 0x34, 0x68, 0x92, 0xc7, 0xc6,
-	0x05, 0x4b, 0xbd, 0x37, 0x56, 0xca, 0xaf, 0xa4, 0xda, 0x2f, 0xa5, 0xfe, 0x70, 0xa9, 0x67, 0xef,
-	0x2d, 0x63, 0x1d, 0xa5, 0x38, 0x42, 0xad, 0xa2, 0x64, 0xeb, 0x38, 0xc9, 0x81, 0x2e, 0xad, 0x2f,
-	0xa5, 0x89, 0x28, 0xbc, 0xb7, 0x57, 0x49, 0x61, 0xc3, 0x0e, 0x0d, 0x3a, 0xbf, 0x90, 0x78, 0x8c,
-	0x9a, 0x44, 0xca, 0x15, 0xc9, 0x63, 0x2e, 0xff, 0xe7, 0x44, 0x73, 0x90, 0xde, 0xbb, 0xab, 0xd4,
-	0x3e, 0x9d, 0xb9, 0xf1, 0x05, 0xeb, 0xdd, 0xb8, 0xa8, 0x1e, 0x91, 0x92, 0x08, 0x85, 0x3b, 0x08,
-	0x99, 0x47, 0xc6, 0x29, 0x93, 0x20, 0x3c, 0xd7, 0xe8, 0xcd, 0x1a, 0xa6, 0x32, 0x32, 0x05, 0xfc,
-	0x17, 0x79, 0x82, 0x6c, 0xec, 0x1c, 0x48, 0x92, 0xb3, 0x58, 0x12, 0x09, 0x8a, 0x51, 0x90, 0xa9,
-	0xaa, 0x06, 0xfc, 0xda, 0xe5, 0x6d, 0x41, 0x36, 0x93, 0x0a, 0x9f, 0x3e, 0xd3, 0xc3, 0x5f, 0xbb,
-	0xa3, 0xef, 0xee, 0x8f, 0xbe, 0xfb, 0x78, 0xf4, 0xdd, 0xdb, 0x93, 0xef, 0xec, 0x4f, 0xbe, 0x73,
-	0x7f, 0xf2, 0x9d, 0x7f, 0x83, 0x8c, 0xeb, 0xc5, 0x2a, 0x09, 0x28, 0x88, 0x70, 0x6a, 0xc2, 0xd0,
-	0x8f, 0xcc, 0x7f, 0x53, 0xc8, 0x43, 0x9b, 0x8d, 0x3e, 0x85, 0x92, 0x85, 0x9b, 0x73, 0x82, 0xf4,
-	0xb6, 0x60, 0x2a, 0xa9, 0xdb, 0x44, 0xfc, 0x78, 0x0a, 0x00, 0x00, 0xff, 0xff, 0xd2, 0x21, 0x68,
-	0xa9, 0x5c, 0x02, 0x00, 0x00,
+	// 399 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xc1, 0x8a, 0xdb, 0x30,
+	0x10, 0x86, 0xed, 0xb4, 0x35, 0x5d, 0xb5, 0xb4, 0x8b, 0x58, 0x82, 0x69, 0x59, 0xef, 0xb2, 0xbd,
+	0xec, 0x65, 0x6d, 0x42, 0x9f, 0xa0, 0x61, 0x2f, 0x4b, 0x9b, 0xc5, 0x98, 0x14, 0x4a, 0x2f, 0x66,
+	0x2c, 0xab, 0x8e, 0x88, 0xa5, 0x31, 0x96, 0x1c, 0x92, 0x63, 0xdf, 0xa0, 0x8f, 0x95, 0x5b, 0x73,
+	0x2c, 0x3d, 0x84, 0x92, 0xbc, 0x48, 0x91, 0xe2, 0xd0, 0x43, 0x2f, 0xb9, 0x69, 0x66, 0xf4, 0xfd,
+	0xfa, 0x35, 0xfc, 0xe4, 0x52, 0x61, 0xdd, 0xe9, 0x44, 0x0a, 0x65, 0x92, 0xc5, 0xa8, 0xe0, 0x06,
+	0x46, 0xae, 0x88, 0x9b, 0x16, 0x0d, 0x52, 0xea, 0xc6, 0xb1, 0xeb, 0xf4, 0xe3, 0x37, 0x17, 0x15,
+	0x56, 0xe8, 0xc6, 0x89, 0x3d, 0x1d, 0x6e, 0xde, 0xfc, 0x1c, 0x90, 0x60, 0x22, 0x94, 0xe1, 0x2d,
+	0x9d, 0x90, 0x73, 0x85, 0xad, 0xcc, 0x8d, 0x90, 0x3c, 0x6f, 0x40, 0x6b, 0x5e, 0x86, 0x83, 0x6b,
+	0xff, 0xf6, 0x6c, 0xfc, 0x6e, 0xbd, 0xbd, 0xf2, 0x7e, 0x6f, 0xaf, 0xde, 0x32, 0xd4, 0x12, 0xb5,
+	0x2e, 0xe7, 0xb1, 0xc0, 0x44, 0x82, 0x99, 0xc5, 0x9f, 0x78, 0x05, 0x6c, 0x75, 0xcf, 0x59, 0xf6,
+	0xca, 0xc2, 0x53, 0x21, 0x79, 0xea, 0x50, 0xfa, 0x81, 0xbc, 0x34, 0x68, 0xa0, 0xce, 0xad, 0x0b,
+	0x5e, 0x86, 0x4f, 0x9c, 0x54, 0xd4, 0x4b, 0x0d, 0xff, 0x97, 0xfa, 0x2c, 0x94, 0xc9, 0x5e, 0x38,
+	0xc6, 0x39, 0x2a, 0x69, 0x4a, 0x2e, 0x9a, 0x96, 0x2f, 0xf2, 0xa2, 0x46, 0x36, 0x77, 0xbe, 0xb4,
+	0x01, 0xd9, 0x84, 0x4f, 0x4f, 0x92, 0xa2, 0x96, 0x1d, 0x5b, 0x74, 0x7a, 0x24, 0xe9, 0x03, 0x39,
+	0x07, 0xa5, 0x3a, 0xa8, 0x73, 0xa1, 0xbe, 0xd5, 0x60, 0x04, 0xaa, 0xf0, 0xd9, 0x49, 0x6a, 0xaf,
+	0x0f, 0xdc, 0xc3, 0x11, 0xa3, 0x43, 0x12, 0x34, 0xd0, 0xd9, 0x25, 0x05, 0xd7, 0xfe, 0xed, 0xf3,
+	0xac, 0xaf, 0x6e, 0xbe, 0xfb, 0x24, 0x48, 0xa1, 0x05, 0xa9, 0xe9, 0x25, 0x21, 0xf6, 0xf3, 0x79,
+	0xc9, 0x15, 0xca, 0xd0, 0xb7, 0xef, 0x64, 0x67, 0xb6, 0x73, 0x6f, 0x1b, 0xf4, 0x0b, 0x09, 0x25,
+	0x2c, 0xdd, 0x7e, 0xa0, 0xa8, 0x79, 0xae, 0x40, 0xa1, 0xe6, 0x0c, 0x55, 0xa9, 0xc3, 0xc1, 0x49,
+	0xa6, 0x86, 0x12, 0x96, 0x93, 0x1e, 0x7f, 0xfc, 0x47, 0x8f, 0x3f, 0xae, 0x77, 0x91, 0xbf, 0xd9,
+	0x45, 0xfe, 0x9f, 0x5d, 0xe4, 0xff, 0xd8, 0x47, 0xde, 0x66, 0x1f, 0x79, 0xbf, 0xf6, 0x91, 0xf7,
+	0x75, 0x54, 0x09, 0x33, 0xeb, 0x8a, 0x98, 0xa1, 0x4c, 0x1e, 0x6d, 0x48, 0xee, 0x52, 0x9b, 0x03,
+	0x86, 0x75, 0xe2, 0x32, 0x73, 0xc7, 0xb0, 0xe5, 0xc9, 0xf2, 0x90, 0x2c, 0xb3, 0x6a, 0xb8, 0x2e,
+	0x02, 0x97, 0x94, 0xf7, 0x7f, 0x07, 0x00, 0xff, 0x00, 0xb0, 0xdf, 0x74, 0x02, 0x00, 0x00,
 }
 
 func (m *Minter) Marshal() (dAtA []byte, err error) {
@@ -168,6 +179,16 @@ func (m *Minter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
 	{
 		size := m.AnnualInflation.Size()
 		i -= size
@@ -276,6 +297,9 @@ func (m *Minter) Size() (n int) {
 	n += 1 + l + sovMint(uint64(l))
 	l = m.AnnualInflation.Size()
 	n += 1 + l + sovMint(uint64(l))
+	if m.Paused {
+		n += 2
+	}
 	return n
 }
 
@@ -465,6 +489,26 @@ func (m *Minter) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Paused = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMint(dAtA[iNdEx:])
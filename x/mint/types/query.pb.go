@@ -155,6 +155,8 @@ var xxx_messageInfo_QueryMintStateRequest proto.InternalMessageInfo
 type QueryMintStateResponse struct {
 	NormTimePassed cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=norm_time_passed,json=normTimePassed,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"norm_time_passed"`
 	TotalMinted    cosmossdk_io_math.Uint      `protobuf:"bytes,2,opt,name=total_minted,json=totalMinted,proto3,customtype=cosmossdk.io/math.Uint" json:"total_minted"`
+	// paused is true while the minting is paused by the authority.
+	Paused bool `protobuf:"varint,3,opt,name=paused,proto3" json:"paused,omitempty"`
 }
 
 func (m *QueryMintStateResponse) Reset()         { *m = QueryMintStateResponse{} }
@@ -190,6 +192,13 @@ func (m *QueryMintStateResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryMintStateResponse proto.InternalMessageInfo
 
+func (m *QueryMintStateResponse) GetPaused() bool {
+	if m != nil {
+		return m.Paused
+	}
+	return false
+}
+
 // QueryAnnualInflationRequest is the request type for the Query/AnnualInflation
 // RPC method.
 type QueryAnnualInflationRequest struct {
@@ -268,6 +277,104 @@ func (m *QueryAnnualInflationResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryAnnualInflationResponse proto.InternalMessageInfo
 
+// QueryAprRequest is the request type for the Query/Apr RPC method.
+type QueryAprRequest struct {
+}
+
+func (m *QueryAprRequest) Reset()         { *m = QueryAprRequest{} }
+func (m *QueryAprRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAprRequest) ProtoMessage()    {}
+func (*QueryAprRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c0819bb52a62656e, []int{6}
+}
+func (m *QueryAprRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAprRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAprRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAprRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAprRequest.Merge(m, src)
+}
+func (m *QueryAprRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAprRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAprRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAprRequest proto.InternalMessageInfo
+
+// QueryAprResponse is the response type for the Query/Apr RPC method.
+type QueryAprResponse struct {
+	// inflation_rate is the ratio of the tokens minted over the next 12 months
+	// to the total supply of the mint denom.
+	InflationRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=inflation_rate,json=inflationRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"inflation_rate"`
+	// staking_apr is the ratio of the tokens minted over the next 12 months,
+	// less the community tax, to the bonded tokens.
+	StakingApr cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=staking_apr,json=stakingApr,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"staking_apr"`
+	// real_yield is the growth of the share of the total supply held by a
+	// staker over 12 months, (1 + staking_apr) / (1 + net_inflation_rate) - 1.
+	RealYield cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=real_yield,json=realYield,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"real_yield"`
+	// annual_inflation is the amount of tokens minted over the next 12 months.
+	AnnualInflation cosmossdk_io_math.Uint `protobuf:"bytes,4,opt,name=annual_inflation,json=annualInflation,proto3,customtype=cosmossdk.io/math.Uint" json:"annual_inflation"`
+	// total_supply is the total supply of the mint denom.
+	TotalSupply cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=total_supply,json=totalSupply,proto3,customtype=cosmossdk.io/math.Int" json:"total_supply"`
+	// bonded_tokens is the amount of tokens bonded to validators.
+	BondedTokens cosmossdk_io_math.Int `protobuf:"bytes,6,opt,name=bonded_tokens,json=bondedTokens,proto3,customtype=cosmossdk.io/math.Int" json:"bonded_tokens"`
+	// community_tax is the share of the staking rewards sent to the community
+	// pool.
+	CommunityTax cosmossdk_io_math.LegacyDec `protobuf:"bytes,7,opt,name=community_tax,json=communityTax,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"community_tax"`
+	// annual_burn is the amount of tokens expected to be burned from the tax
+	// over the next 12 months, at the average rate since the first burn.
+	AnnualBurn cosmossdk_io_math.Int `protobuf:"bytes,8,opt,name=annual_burn,json=annualBurn,proto3,customtype=cosmossdk.io/math.Int" json:"annual_burn"`
+	// net_inflation_rate is the ratio of the tokens minted less the tokens
+	// burned over the next 12 months to the total supply of the mint denom.
+	NetInflationRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,9,opt,name=net_inflation_rate,json=netInflationRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"net_inflation_rate"`
+}
+
+func (m *QueryAprResponse) Reset()         { *m = QueryAprResponse{} }
+func (m *QueryAprResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAprResponse) ProtoMessage()    {}
+func (*QueryAprResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c0819bb52a62656e, []int{7}
+}
+func (m *QueryAprResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAprResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAprResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAprResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAprResponse.Merge(m, src)
+}
+func (m *QueryAprResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAprResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAprResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAprResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "nolus.mint.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "nolus.mint.v1beta1.QueryParamsResponse")
@@ -275,44 +382,61 @@ func init() {
 	proto.RegisterType((*QueryMintStateResponse)(nil), "nolus.mint.v1beta1.QueryMintStateResponse")
 	proto.RegisterType((*QueryAnnualInflationRequest)(nil), "nolus.mint.v1beta1.QueryAnnualInflationRequest")
 	proto.RegisterType((*QueryAnnualInflationResponse)(nil), "nolus.mint.v1beta1.QueryAnnualInflationResponse")
+	proto.RegisterType((*QueryAprRequest)(nil), "nolus.mint.v1beta1.QueryAprRequest")
+	proto.RegisterType((*QueryAprResponse)(nil), "nolus.mint.v1beta1.QueryAprResponse")
 }
 
 func init() { proto.RegisterFile("nolus/mint/v1beta1/query.proto", fileDescriptor_c0819bb52a62656e) }
 
 var fileDescriptor_c0819bb52a62656e = []byte{
-	// 511 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0x3d, 0x6f, 0xd3, 0x40,
-	0x18, 0x8e, 0x0b, 0x54, 0xe2, 0x5a, 0xd1, 0xea, 0x28, 0xa5, 0x38, 0xa9, 0x0b, 0x06, 0x95, 0x0f,
-	0x51, 0x1f, 0x29, 0x0b, 0x6b, 0x23, 0x96, 0x0a, 0x02, 0x21, 0xc0, 0xc2, 0x12, 0x5d, 0x9c, 0xc3,
-	0x3d, 0x61, 0xdf, 0xeb, 0xfa, 0xce, 0x88, 0x0c, 0x2c, 0x48, 0xec, 0x48, 0xfc, 0x03, 0x46, 0x56,
-	0xfe, 0x44, 0xc7, 0x4a, 0x2c, 0x88, 0xa1, 0x42, 0x09, 0x3f, 0x04, 0xf9, 0xf5, 0x05, 0xa9, 0x89,
-	0xab, 0x76, 0xb3, 0xee, 0x79, 0x9f, 0x8f, 0x7b, 0x1f, 0x1f, 0xf1, 0x14, 0xc4, 0xb9, 0x66, 0x89,
-	0x54, 0x86, 0xbd, 0x6f, 0xf6, 0x85, 0xe1, 0x4d, 0xb6, 0x9f, 0x8b, 0x6c, 0x18, 0xa4, 0x19, 0x18,
-	0xa0, 0x14, 0xf1, 0xa0, 0xc0, 0x03, 0x8b, 0xbb, 0x2b, 0x11, 0x44, 0x80, 0x30, 0x2b, 0xbe, 0xca,
-	0x49, 0xb7, 0x11, 0x01, 0x44, 0xb1, 0x60, 0x3c, 0x95, 0x8c, 0x2b, 0x05, 0x86, 0x1b, 0x09, 0x4a,
-	0x5b, 0x74, 0xbd, 0xc2, 0x07, 0x45, 0x11, 0xf6, 0x57, 0x08, 0x7d, 0x51, 0xb8, 0x76, 0x78, 0xc6,
-	0x13, 0xdd, 0x15, 0xfb, 0xb9, 0xd0, 0xc6, 0x7f, 0x4e, 0x2e, 0x1f, 0x3b, 0xd5, 0x29, 0x28, 0x2d,
-	0xe8, 0x23, 0x32, 0x9f, 0xe2, 0xc9, 0x9a, 0x73, 0xdd, 0xb9, 0xb3, 0xb0, 0xed, 0x06, 0xb3, 0x21,
-	0x83, 0x92, 0xd3, 0x3a, 0x7f, 0x70, 0xb4, 0x51, 0xeb, 0xda, 0x79, 0xff, 0x2a, 0xb9, 0x82, 0x82,
-	0x6d, 0xa9, 0xcc, 0x4b, 0xc3, 0x8d, 0x98, 0x38, 0x7d, 0x77, 0xc8, 0xea, 0x34, 0x62, 0xdd, 0xda,
-	0x64, 0x59, 0x41, 0x96, 0xf4, 0x8c, 0x4c, 0x44, 0x2f, 0xe5, 0x5a, 0x8b, 0x01, 0xfa, 0x2e, 0xb6,
-	0x6e, 0x16, 0xda, 0xbf, 0x8f, 0x36, 0xea, 0x21, 0xe8, 0x04, 0xb4, 0x1e, 0xbc, 0x0b, 0x24, 0xb0,
-	0x84, 0x9b, 0xbd, 0xe0, 0xa9, 0x88, 0x78, 0x38, 0x7c, 0x2c, 0xc2, 0xee, 0xa5, 0x82, 0xfc, 0x4a,
-	0x26, 0xa2, 0x83, 0x54, 0xba, 0x43, 0x16, 0x0d, 0x18, 0x1e, 0xf7, 0x8a, 0xb4, 0x62, 0xb0, 0x36,
-	0x87, 0x52, 0x9e, 0x95, 0x5a, 0x9d, 0x95, 0x7a, 0x2d, 0x95, 0xe9, 0x2e, 0x20, 0xa7, 0x8d, 0x14,
-	0x7f, 0x9d, 0xd4, 0x31, 0xeb, 0x8e, 0x52, 0x39, 0x8f, 0x77, 0xd5, 0xdb, 0x18, 0x57, 0x3d, 0xb9,
-	0x8b, 0x24, 0x8d, 0x6a, 0xd8, 0x5e, 0x68, 0x97, 0x2c, 0x73, 0x84, 0x7a, 0x72, 0x82, 0xd9, 0x0b,
-	0x9d, 0x96, 0x62, 0x89, 0x1f, 0x97, 0xdc, 0xfe, 0x71, 0x8e, 0x5c, 0x40, 0x2f, 0xfa, 0x91, 0xcc,
-	0x97, 0x1b, 0xa7, 0x9b, 0x55, 0x6d, 0xcc, 0x96, 0xeb, 0xde, 0x3e, 0x75, 0xae, 0xcc, 0xeb, 0xfb,
-	0x9f, 0x7e, 0xfe, 0xfd, 0x3a, 0xd7, 0xa0, 0x2e, 0xab, 0xf8, 0x87, 0xca, 0x62, 0xe9, 0x67, 0x87,
-	0x5c, 0xfc, 0x5f, 0x1d, 0xbd, 0x7b, 0xa2, 0xf4, 0x74, 0xf1, 0xee, 0xbd, 0xb3, 0x8c, 0xda, 0x20,
-	0x37, 0x30, 0x48, 0x9d, 0x5e, 0xab, 0x0a, 0xa2, 0xd1, 0xf9, 0x9b, 0x43, 0x96, 0xa6, 0xf6, 0x4e,
-	0xd9, 0x89, 0x16, 0xd5, 0x05, 0xba, 0x0f, 0xce, 0x4e, 0xb0, 0xc9, 0xee, 0x63, 0xb2, 0x4d, 0x7a,
-	0xab, 0x2a, 0xd9, 0x74, 0xd9, 0xad, 0x27, 0x07, 0x23, 0xcf, 0x39, 0x1c, 0x79, 0xce, 0x9f, 0x91,
-	0xe7, 0x7c, 0x19, 0x7b, 0xb5, 0xc3, 0xb1, 0x57, 0xfb, 0x35, 0xf6, 0x6a, 0x6f, 0x9a, 0x91, 0x34,
-	0x7b, 0x79, 0x3f, 0x08, 0x21, 0x61, 0xcf, 0x0a, 0xa5, 0xad, 0x4e, 0xf1, 0x3c, 0x43, 0x88, 0x4b,
-	0xe1, 0xad, 0x10, 0x32, 0xc1, 0x3e, 0x94, 0xfa, 0x66, 0x98, 0x0a, 0xdd, 0x9f, 0xc7, 0x07, 0xfc,
-	0xf0, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x94, 0x73, 0x0e, 0x40, 0x49, 0x04, 0x00, 0x00,
+	// 741 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0x4d, 0x53, 0xd3, 0x4e,
+	0x18, 0x6f, 0x78, 0xe9, 0x1f, 0x9e, 0x16, 0xe8, 0x7f, 0xe5, 0x25, 0x04, 0x08, 0x58, 0x18, 0x44,
+	0x47, 0x12, 0xc1, 0x8b, 0x27, 0x47, 0x3a, 0x1c, 0xac, 0x8a, 0x42, 0xc1, 0x83, 0x5e, 0x32, 0xdb,
+	0x76, 0x0d, 0x19, 0x92, 0xdd, 0x90, 0xdd, 0x38, 0xf4, 0xe0, 0xc5, 0x19, 0xef, 0xce, 0x38, 0xe3,
+	0x07, 0xf0, 0xe0, 0x07, 0xf1, 0xc4, 0x91, 0x19, 0x2f, 0x8e, 0x07, 0xc6, 0x01, 0x3f, 0x88, 0x93,
+	0x4d, 0x5a, 0xa5, 0x0d, 0x1a, 0xbd, 0xb5, 0xfb, 0x3c, 0xbf, 0x97, 0x7d, 0xf2, 0xe4, 0x17, 0xd0,
+	0x29, 0x73, 0x43, 0x6e, 0x7a, 0x0e, 0x15, 0xe6, 0xcb, 0xb5, 0x3a, 0x11, 0x78, 0xcd, 0x3c, 0x0c,
+	0x49, 0xd0, 0x32, 0xfc, 0x80, 0x09, 0x86, 0x90, 0xac, 0x1b, 0x51, 0xdd, 0x48, 0xea, 0xda, 0xb8,
+	0xcd, 0x6c, 0x26, 0xcb, 0x66, 0xf4, 0x2b, 0xee, 0xd4, 0x66, 0x6d, 0xc6, 0x6c, 0x97, 0x98, 0xd8,
+	0x77, 0x4c, 0x4c, 0x29, 0x13, 0x58, 0x38, 0x8c, 0xf2, 0xa4, 0x3a, 0x97, 0xa2, 0x23, 0x49, 0x65,
+	0xb9, 0x3c, 0x0e, 0x68, 0x27, 0x52, 0xdd, 0xc6, 0x01, 0xf6, 0x78, 0x8d, 0x1c, 0x86, 0x84, 0x8b,
+	0xf2, 0x13, 0xb8, 0x72, 0xe1, 0x94, 0xfb, 0x8c, 0x72, 0x82, 0xee, 0x40, 0xde, 0x97, 0x27, 0xaa,
+	0xb2, 0xa0, 0xac, 0x14, 0xd6, 0x35, 0xa3, 0xd7, 0xa4, 0x11, 0x63, 0x2a, 0x03, 0xc7, 0xa7, 0xf3,
+	0xb9, 0x5a, 0xd2, 0x5f, 0x9e, 0x82, 0x09, 0x49, 0xb8, 0xe5, 0x50, 0xb1, 0x2b, 0xb0, 0x20, 0x6d,
+	0xa5, 0x4f, 0x0a, 0x4c, 0x76, 0x57, 0x12, 0xb5, 0x2d, 0x28, 0x51, 0x16, 0x78, 0x96, 0x70, 0x3c,
+	0x62, 0xf9, 0x98, 0x73, 0xd2, 0x94, 0xba, 0xc5, 0xca, 0x62, 0xc4, 0xfd, 0xf5, 0x74, 0x7e, 0xa6,
+	0xc1, 0xb8, 0xc7, 0x38, 0x6f, 0x1e, 0x18, 0x0e, 0x33, 0x3d, 0x2c, 0xf6, 0x8d, 0x47, 0xc4, 0xc6,
+	0x8d, 0xd6, 0x26, 0x69, 0xd4, 0x46, 0x23, 0xf0, 0x9e, 0xe3, 0x91, 0x6d, 0x09, 0x45, 0x1b, 0x50,
+	0x14, 0x4c, 0x60, 0xd7, 0x8a, 0xdc, 0x92, 0xa6, 0xda, 0x27, 0xa9, 0xf4, 0x84, 0x6a, 0xb2, 0x97,
+	0xea, 0xa9, 0x43, 0x45, 0xad, 0x20, 0x31, 0x5b, 0x12, 0x82, 0x26, 0xa3, 0xfb, 0x87, 0x91, 0x8f,
+	0xfe, 0x05, 0x65, 0x65, 0xa8, 0x96, 0xfc, 0x2b, 0xcf, 0xc1, 0x8c, 0xbc, 0xc3, 0x06, 0xa5, 0x21,
+	0x76, 0xab, 0xf4, 0x85, 0x2b, 0x1f, 0x41, 0xfb, 0x8e, 0x0e, 0xcc, 0xa6, 0x97, 0x93, 0x8b, 0x56,
+	0xa1, 0x84, 0x65, 0xc9, 0x72, 0xda, 0x35, 0x55, 0xc9, 0xe4, 0x6e, 0x0c, 0x5f, 0xa4, 0x2c, 0xff,
+	0x0f, 0x63, 0xb1, 0x94, 0x1f, 0xb4, 0xd5, 0xdf, 0x0f, 0x42, 0xe9, 0xe7, 0x59, 0x22, 0xf9, 0x00,
+	0x46, 0x3b, 0x5a, 0x56, 0x80, 0x05, 0xf9, 0x9b, 0xc9, 0x8e, 0x74, 0xa0, 0x35, 0x2c, 0x08, 0xda,
+	0x84, 0x02, 0x17, 0xf8, 0xc0, 0xa1, 0xb6, 0x85, 0xfd, 0x40, 0xed, 0xcb, 0x4e, 0x04, 0x09, 0x6e,
+	0xc3, 0x0f, 0x50, 0x05, 0x20, 0x20, 0xd8, 0xb5, 0x5a, 0x0e, 0x71, 0xe3, 0xf9, 0x66, 0x24, 0x19,
+	0x8e, 0x60, 0xcf, 0x22, 0x54, 0xea, 0x20, 0x07, 0xfe, 0x69, 0x90, 0xe8, 0x5e, 0x7b, 0x5b, 0x78,
+	0xe8, 0xfb, 0x6e, 0x4b, 0x1d, 0x94, 0x34, 0x73, 0x09, 0xcd, 0x44, 0x2f, 0x4d, 0xb5, 0xb3, 0x2c,
+	0xbb, 0x12, 0x81, 0x2a, 0x30, 0x52, 0x67, 0xb4, 0x49, 0x9a, 0x96, 0x60, 0x07, 0x84, 0x72, 0x35,
+	0x9f, 0x85, 0xa2, 0x18, 0x63, 0xf6, 0x24, 0x04, 0xdd, 0x87, 0x91, 0x06, 0xf3, 0xbc, 0x90, 0x3a,
+	0xa2, 0x65, 0x09, 0x7c, 0xa4, 0xfe, 0x97, 0x7d, 0x2e, 0xc5, 0x0e, 0x72, 0x0f, 0x1f, 0xa1, 0xbb,
+	0x50, 0x48, 0x46, 0x53, 0x0f, 0x03, 0xaa, 0x0e, 0x65, 0xf1, 0x02, 0x31, 0xa2, 0x12, 0x06, 0x14,
+	0xed, 0x00, 0xa2, 0x44, 0x58, 0x5d, 0x4b, 0x33, 0x9c, 0xdd, 0x4e, 0x89, 0x12, 0x51, 0xfd, 0x75,
+	0x6f, 0xd6, 0x3f, 0x0e, 0xc0, 0xa0, 0x5c, 0x4c, 0xf4, 0x0a, 0xf2, 0x71, 0x6a, 0xa0, 0xe5, 0xb4,
+	0x44, 0xe9, 0x0d, 0x28, 0xed, 0xda, 0x1f, 0xfb, 0xe2, 0x45, 0x2f, 0x97, 0x5f, 0x7f, 0xfe, 0xfe,
+	0xae, 0x6f, 0x16, 0x69, 0x66, 0x4a, 0x0e, 0xc6, 0xe1, 0x84, 0xde, 0x28, 0x30, 0xdc, 0x89, 0x1f,
+	0x74, 0xfd, 0x52, 0xea, 0xee, 0xf0, 0xd2, 0x6e, 0x64, 0x69, 0x4d, 0x8c, 0x5c, 0x95, 0x46, 0x66,
+	0xd0, 0x74, 0x9a, 0x11, 0x2e, 0x95, 0x3f, 0x28, 0x30, 0xd6, 0x95, 0x11, 0xc8, 0xbc, 0x54, 0x22,
+	0x3d, 0x6c, 0xb4, 0x5b, 0xd9, 0x01, 0x89, 0xb3, 0x9b, 0xd2, 0xd9, 0x32, 0x5a, 0x4a, 0x73, 0xd6,
+	0xfd, 0x3e, 0xa1, 0x43, 0xe8, 0x8f, 0x5e, 0xd7, 0xc5, 0xcb, 0x65, 0x3a, 0xd1, 0xa3, 0x2d, 0xfd,
+	0xbe, 0x29, 0xd1, 0x9f, 0x97, 0xfa, 0xd3, 0x68, 0x2a, 0x55, 0xdf, 0x0f, 0x2a, 0x0f, 0x8f, 0xcf,
+	0x74, 0xe5, 0xe4, 0x4c, 0x57, 0xbe, 0x9d, 0xe9, 0xca, 0xdb, 0x73, 0x3d, 0x77, 0x72, 0xae, 0xe7,
+	0xbe, 0x9c, 0xeb, 0xb9, 0xe7, 0x6b, 0xb6, 0x23, 0xf6, 0xc3, 0xba, 0xd1, 0x60, 0x9e, 0xf9, 0x38,
+	0x02, 0xaf, 0x6e, 0x47, 0x5f, 0xb5, 0x06, 0x73, 0x63, 0xae, 0xd5, 0x06, 0x0b, 0x88, 0x79, 0x14,
+	0x53, 0x8a, 0x96, 0x4f, 0x78, 0x3d, 0x2f, 0xbf, 0x7b, 0xb7, 0x7f, 0x0c, 0x00, 0x34, 0xc9, 0x6a,
+	0x0c, 0x80, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -334,6 +458,9 @@ type QueryClient interface {
 	// AnnualInflation returns the current minting inflation rate for the next 12
 	// months.
 	AnnualInflation(ctx context.Context, in *QueryAnnualInflationRequest, opts ...grpc.CallOption) (*QueryAnnualInflationResponse, error)
+	// Apr returns the nominal inflation rate, the staking APR and the real yield
+	// of the tokens minted over the next 12 months.
+	Apr(ctx context.Context, in *QueryAprRequest, opts ...grpc.CallOption) (*QueryAprResponse, error)
 }
 
 type queryClient struct {
@@ -371,6 +498,15 @@ func (c *queryClient) AnnualInflation(ctx context.Context, in *QueryAnnualInflat
 	return out, nil
 }
 
+func (c *queryClient) Apr(ctx context.Context, in *QueryAprRequest, opts ...grpc.CallOption) (*QueryAprResponse, error) {
+	out := new(QueryAprResponse)
+	err := c.cc.Invoke(ctx, "/nolus.mint.v1beta1.Query/Apr", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params returns the total set of minting parameters.
@@ -380,6 +516,9 @@ type QueryServer interface {
 	// AnnualInflation returns the current minting inflation rate for the next 12
 	// months.
 	AnnualInflation(context.Context, *QueryAnnualInflationRequest) (*QueryAnnualInflationResponse, error)
+	// Apr returns the nominal inflation rate, the staking APR and the real yield
+	// of the tokens minted over the next 12 months.
+	Apr(context.Context, *QueryAprRequest) (*QueryAprResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -395,6 +534,9 @@ func (*UnimplementedQueryServer) MintState(ctx context.Context, req *QueryMintSt
 func (*UnimplementedQueryServer) AnnualInflation(ctx context.Context, req *QueryAnnualInflationRequest) (*QueryAnnualInflationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AnnualInflation not implemented")
 }
+func (*UnimplementedQueryServer) Apr(ctx context.Context, req *QueryAprRequest) (*QueryAprResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Apr not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -454,6 +596,24 @@ func _Query_AnnualInflation_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_Apr_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAprRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Apr(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nolus.mint.v1beta1.Query/Apr",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Apr(ctx, req.(*QueryAprRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "nolus.mint.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -470,6 +630,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AnnualInflation",
 			Handler:    _Query_AnnualInflation_Handler,
 		},
+		{
+			MethodName: "Apr",
+			Handler:    _Query_Apr_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "nolus/mint/v1beta1/query.proto",
@@ -574,6 +738,16 @@ func (m *QueryMintStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
 	{
 		size := m.TotalMinted.Size()
 		i -= size
@@ -653,6 +827,142 @@ func (m *QueryAnnualInflationResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryAprRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAprRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAprRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAprResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAprResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAprResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.NetInflationRate.Size()
+		i -= size
+		if _, err := m.NetInflationRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.AnnualBurn.Size()
+		i -= size
+		if _, err := m.AnnualBurn.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.CommunityTax.Size()
+		i -= size
+		if _, err := m.CommunityTax.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.BondedTokens.Size()
+		i -= size
+		if _, err := m.BondedTokens.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.TotalSupply.Size()
+		i -= size
+		if _, err := m.TotalSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.AnnualInflation.Size()
+		i -= size
+		if _, err := m.AnnualInflation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.RealYield.Size()
+		i -= size
+		if _, err := m.RealYield.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.StakingApr.Size()
+		i -= size
+		if _, err := m.StakingApr.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.InflationRate.Size()
+		i -= size
+		if _, err := m.InflationRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -703,6 +1013,9 @@ func (m *QueryMintStateResponse) Size() (n int) {
 	n += 1 + l + sovQuery(uint64(l))
 	l = m.TotalMinted.Size()
 	n += 1 + l + sovQuery(uint64(l))
+	if m.Paused {
+		n += 2
+	}
 	return n
 }
 
@@ -726,6 +1039,42 @@ func (m *QueryAnnualInflationResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryAprRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryAprResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.InflationRate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.StakingApr.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.RealYield.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.AnnualInflation.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.TotalSupply.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.BondedTokens.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.CommunityTax.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.AnnualBurn.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.NetInflationRate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1010,6 +1359,26 @@ func (m *QueryMintStateResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Paused = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1164,6 +1533,403 @@ func (m *QueryAnnualInflationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryAprRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAprRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAprRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAprResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAprResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAprResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationRate", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InflationRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingApr", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StakingApr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RealYield", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RealYield.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AnnualInflation", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AnnualInflation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSupply", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BondedTokens", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BondedTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityTax", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CommunityTax.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AnnualBurn", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AnnualBurn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetInflationRate", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NetInflationRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -129,36 +129,213 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
+// MsgPauseMinting is the Msg/PauseMinting request type.
+type MsgPauseMinting struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+}
+
+func (m *MsgPauseMinting) Reset()         { *m = MsgPauseMinting{} }
+func (m *MsgPauseMinting) String() string { return proto.CompactTextString(m) }
+func (*MsgPauseMinting) ProtoMessage()    {}
+func (*MsgPauseMinting) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4120de15c071c685, []int{2}
+}
+func (m *MsgPauseMinting) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPauseMinting) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPauseMinting.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgPauseMinting) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPauseMinting.Merge(m, src)
+}
+func (m *MsgPauseMinting) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPauseMinting) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPauseMinting.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPauseMinting proto.InternalMessageInfo
+
+func (m *MsgPauseMinting) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+// MsgPauseMintingResponse defines the response structure for executing a
+// MsgPauseMinting message.
+type MsgPauseMintingResponse struct {
+}
+
+func (m *MsgPauseMintingResponse) Reset()         { *m = MsgPauseMintingResponse{} }
+func (m *MsgPauseMintingResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgPauseMintingResponse) ProtoMessage()    {}
+func (*MsgPauseMintingResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4120de15c071c685, []int{3}
+}
+func (m *MsgPauseMintingResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPauseMintingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPauseMintingResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgPauseMintingResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPauseMintingResponse.Merge(m, src)
+}
+func (m *MsgPauseMintingResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPauseMintingResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPauseMintingResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPauseMintingResponse proto.InternalMessageInfo
+
+// MsgResumeMinting is the Msg/ResumeMinting request type.
+type MsgResumeMinting struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+}
+
+func (m *MsgResumeMinting) Reset()         { *m = MsgResumeMinting{} }
+func (m *MsgResumeMinting) String() string { return proto.CompactTextString(m) }
+func (*MsgResumeMinting) ProtoMessage()    {}
+func (*MsgResumeMinting) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4120de15c071c685, []int{4}
+}
+func (m *MsgResumeMinting) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgResumeMinting) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgResumeMinting.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgResumeMinting) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgResumeMinting.Merge(m, src)
+}
+func (m *MsgResumeMinting) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgResumeMinting) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgResumeMinting.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgResumeMinting proto.InternalMessageInfo
+
+func (m *MsgResumeMinting) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+// MsgResumeMintingResponse defines the response structure for executing a
+// MsgResumeMinting message.
+type MsgResumeMintingResponse struct {
+}
+
+func (m *MsgResumeMintingResponse) Reset()         { *m = MsgResumeMintingResponse{} }
+func (m *MsgResumeMintingResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgResumeMintingResponse) ProtoMessage()    {}
+func (*MsgResumeMintingResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4120de15c071c685, []int{5}
+}
+func (m *MsgResumeMintingResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgResumeMintingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgResumeMintingResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgResumeMintingResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgResumeMintingResponse.Merge(m, src)
+}
+func (m *MsgResumeMintingResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgResumeMintingResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgResumeMintingResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgResumeMintingResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgUpdateParams)(nil), "nolus.mint.v1beta1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "nolus.mint.v1beta1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgPauseMinting)(nil), "nolus.mint.v1beta1.MsgPauseMinting")
+	proto.RegisterType((*MsgPauseMintingResponse)(nil), "nolus.mint.v1beta1.MsgPauseMintingResponse")
+	proto.RegisterType((*MsgResumeMinting)(nil), "nolus.mint.v1beta1.MsgResumeMinting")
+	proto.RegisterType((*MsgResumeMintingResponse)(nil), "nolus.mint.v1beta1.MsgResumeMintingResponse")
 }
 
 func init() { proto.RegisterFile("nolus/mint/v1beta1/tx.proto", fileDescriptor_4120de15c071c685) }
 
 var fileDescriptor_4120de15c071c685 = []byte{
-	// 327 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xce, 0xcb, 0xcf, 0x29,
-	0x2d, 0xd6, 0xcf, 0xcd, 0xcc, 0x2b, 0xd1, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4, 0x2f,
-	0xa9, 0xd0, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x02, 0x4b, 0xea, 0x81, 0x24, 0xf5, 0xa0,
-	0x92, 0x52, 0xe2, 0xc9, 0xf9, 0xc5, 0xb9, 0xf9, 0xc5, 0xfa, 0xb9, 0xc5, 0xe9, 0xfa, 0x65, 0x86,
-	0x20, 0x0a, 0xa2, 0x58, 0x4a, 0x16, 0x8b, 0x49, 0x60, 0x9d, 0x10, 0x69, 0x91, 0xf4, 0xfc, 0xf4,
-	0x7c, 0x30, 0x53, 0x1f, 0xc4, 0x82, 0x8a, 0x4a, 0x42, 0x4c, 0x8b, 0x87, 0x48, 0x40, 0x38, 0x10,
-	0x29, 0xa5, 0xc9, 0x8c, 0x5c, 0xfc, 0xbe, 0xc5, 0xe9, 0xa1, 0x05, 0x29, 0x89, 0x25, 0xa9, 0x01,
-	0x89, 0x45, 0x89, 0xb9, 0xc5, 0x42, 0x66, 0x5c, 0x9c, 0x89, 0xa5, 0x25, 0x19, 0xf9, 0x45, 0x99,
-	0x25, 0x95, 0x12, 0x8c, 0x0a, 0x8c, 0x1a, 0x9c, 0x4e, 0x12, 0x97, 0xb6, 0xe8, 0x8a, 0x40, 0x35,
-	0x3a, 0xa6, 0xa4, 0x14, 0xa5, 0x16, 0x17, 0x07, 0x97, 0x14, 0x65, 0xe6, 0xa5, 0x07, 0x21, 0x94,
-	0x0a, 0x59, 0x70, 0xb1, 0x15, 0x80, 0x4d, 0x90, 0x60, 0x52, 0x60, 0xd4, 0xe0, 0x36, 0x92, 0xd2,
-	0xc3, 0xf4, 0x99, 0x1e, 0xc4, 0x0e, 0x27, 0x96, 0x13, 0xf7, 0xe4, 0x19, 0x82, 0xa0, 0xea, 0xad,
-	0xf8, 0x9a, 0x9e, 0x6f, 0xd0, 0x42, 0x98, 0xa4, 0x24, 0xc9, 0x25, 0x8e, 0xe6, 0xa8, 0xa0, 0xd4,
-	0xe2, 0x82, 0xfc, 0xbc, 0xe2, 0x54, 0xa3, 0x74, 0x2e, 0x66, 0xdf, 0xe2, 0x74, 0xa1, 0x04, 0x2e,
-	0x1e, 0x14, 0x37, 0x2b, 0x63, 0xb3, 0x0b, 0xcd, 0x0c, 0x29, 0x6d, 0x22, 0x14, 0xc1, 0x2c, 0x72,
-	0xf2, 0x3e, 0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x27, 0x3c,
-	0x96, 0x63, 0xb8, 0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86, 0x28, 0xc3, 0xf4, 0xcc, 0x92,
-	0x8c, 0xd2, 0x24, 0xbd, 0xe4, 0xfc, 0x5c, 0x7d, 0x3f, 0x90, 0x81, 0xba, 0x01, 0xa0, 0xb0, 0x4c,
-	0xce, 0xcf, 0xd1, 0x07, 0x9b, 0xaf, 0x9b, 0x9c, 0x5f, 0x94, 0xaa, 0x5f, 0x01, 0x89, 0xa4, 0x92,
-	0xca, 0x82, 0xd4, 0xe2, 0x24, 0x36, 0x70, 0x68, 0x1b, 0x03, 0x02, 0x00, 0x00, 0xff, 0xff, 0xe0,
-	0xef, 0x7a, 0xcb, 0x09, 0x02, 0x00, 0x00,
+	// 407 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0xcf, 0x4a, 0xeb, 0x40,
+	0x14, 0xc6, 0x93, 0xde, 0x4b, 0xa1, 0x73, 0xff, 0x12, 0x0a, 0x4d, 0x23, 0xc6, 0x52, 0x5d, 0x14,
+	0x6b, 0x33, 0xa4, 0x82, 0x88, 0x3b, 0xbb, 0x95, 0x48, 0xa9, 0xb8, 0xb0, 0x1b, 0x4d, 0xd3, 0x61,
+	0x1a, 0x68, 0x32, 0x21, 0x33, 0x29, 0xed, 0xd6, 0x27, 0x10, 0x7c, 0x0f, 0x71, 0xe1, 0x43, 0x74,
+	0x59, 0x5c, 0xb9, 0x12, 0x69, 0x17, 0xbe, 0x86, 0x24, 0x93, 0xfe, 0x49, 0x6d, 0xa1, 0x88, 0xab,
+	0xcc, 0xe4, 0xfb, 0xce, 0xf7, 0x3b, 0x39, 0xe1, 0x80, 0x2d, 0x97, 0x74, 0x03, 0x0a, 0x1d, 0xdb,
+	0x65, 0xb0, 0xa7, 0xb7, 0x10, 0x33, 0x75, 0xc8, 0xfa, 0x9a, 0xe7, 0x13, 0x46, 0x24, 0x29, 0x12,
+	0xb5, 0x50, 0xd4, 0x62, 0x51, 0xc9, 0x59, 0x84, 0x3a, 0x84, 0x42, 0x87, 0x62, 0xd8, 0xd3, 0xc3,
+	0x07, 0x37, 0x2b, 0xdb, 0x2b, 0x92, 0xa2, 0x4a, 0x2e, 0x67, 0x31, 0xc1, 0x24, 0x3a, 0xc2, 0xf0,
+	0x14, 0xbf, 0xcd, 0xf3, 0xb4, 0x6b, 0x2e, 0xf0, 0x0b, 0x97, 0x8a, 0xf7, 0x22, 0xf8, 0x67, 0x50,
+	0x7c, 0xe9, 0xb5, 0x4d, 0x86, 0xea, 0xa6, 0x6f, 0x3a, 0x54, 0x3a, 0x02, 0x19, 0x33, 0x60, 0x1d,
+	0xe2, 0xdb, 0x6c, 0x20, 0x8b, 0x05, 0xb1, 0x94, 0xa9, 0xc9, 0xcf, 0x4f, 0x95, 0x6c, 0x5c, 0x78,
+	0xda, 0x6e, 0xfb, 0x88, 0xd2, 0x0b, 0xe6, 0xdb, 0x2e, 0x6e, 0xcc, 0xad, 0xd2, 0x31, 0x48, 0x7b,
+	0x51, 0x82, 0x9c, 0x2a, 0x88, 0xa5, 0x5f, 0x55, 0x45, 0xfb, 0xfc, 0x65, 0x1a, 0x67, 0xd4, 0x7e,
+	0x0e, 0x5f, 0x77, 0x84, 0x46, 0xec, 0x3f, 0xf9, 0x7b, 0xfb, 0xfe, 0xb8, 0x3f, 0x4f, 0x2a, 0xe6,
+	0x41, 0x6e, 0xa9, 0xa9, 0x06, 0xa2, 0x1e, 0x71, 0x29, 0x2a, 0x5e, 0x45, 0xfd, 0xd6, 0xcd, 0x80,
+	0x22, 0xc3, 0x76, 0x99, 0xed, 0xe2, 0xaf, 0xf6, 0xbb, 0x86, 0xba, 0x18, 0x3d, 0xa3, 0x36, 0xc1,
+	0x7f, 0x83, 0x86, 0xd7, 0xc0, 0xf9, 0x76, 0xac, 0x02, 0xe4, 0xe5, 0xec, 0x29, 0xb7, 0xfa, 0x90,
+	0x02, 0x3f, 0x0c, 0x8a, 0xa5, 0x1b, 0xf0, 0x3b, 0xf1, 0x8b, 0x76, 0x57, 0x8d, 0x76, 0x69, 0x64,
+	0x4a, 0x79, 0x03, 0xd3, 0x94, 0x14, 0x12, 0x12, 0x43, 0x5d, 0x47, 0x58, 0x34, 0x29, 0xe5, 0x0d,
+	0x4c, 0x33, 0x82, 0x05, 0xfe, 0x24, 0x07, 0xb8, 0xb7, 0xa6, 0x3a, 0xe1, 0x52, 0x0e, 0x36, 0x71,
+	0x4d, 0x21, 0xb5, 0xb3, 0xe1, 0x58, 0x15, 0x47, 0x63, 0x55, 0x7c, 0x1b, 0xab, 0xe2, 0xdd, 0x44,
+	0x15, 0x46, 0x13, 0x55, 0x78, 0x99, 0xa8, 0x42, 0x53, 0xc7, 0x36, 0xeb, 0x04, 0x2d, 0xcd, 0x22,
+	0x0e, 0x3c, 0x0f, 0x13, 0x2b, 0xf5, 0x70, 0x03, 0x2c, 0xd2, 0x85, 0x11, 0xa0, 0x62, 0x11, 0x1f,
+	0xc1, 0x3e, 0x5f, 0x2d, 0x36, 0xf0, 0x10, 0x6d, 0xa5, 0xa3, 0x1d, 0x39, 0xfc, 0x18, 0x00, 0x54,
+	0x78, 0x08, 0x6c, 0xbf, 0x03, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -178,6 +355,14 @@ type MsgClient interface {
 	//
 	// Since: cosmos-sdk 0.47
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// PauseMinting defines a governance operation for stopping the minting
+	// until it is resumed. The authority is hard-coded to the x/gov module
+	// account.
+	PauseMinting(ctx context.Context, in *MsgPauseMinting, opts ...grpc.CallOption) (*MsgPauseMintingResponse, error)
+	// ResumeMinting defines a governance operation for resuming the minting
+	// paused by MsgPauseMinting. The authority is hard-coded to the x/gov
+	// module account.
+	ResumeMinting(ctx context.Context, in *MsgResumeMinting, opts ...grpc.CallOption) (*MsgResumeMintingResponse, error)
 }
 
 type msgClient struct {
@@ -197,6 +382,24 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) PauseMinting(ctx context.Context, in *MsgPauseMinting, opts ...grpc.CallOption) (*MsgPauseMintingResponse, error) {
+	out := new(MsgPauseMintingResponse)
+	err := c.cc.Invoke(ctx, "/nolus.mint.v1beta1.Msg/PauseMinting", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ResumeMinting(ctx context.Context, in *MsgResumeMinting, opts ...grpc.CallOption) (*MsgResumeMintingResponse, error) {
+	out := new(MsgResumeMintingResponse)
+	err := c.cc.Invoke(ctx, "/nolus.mint.v1beta1.Msg/ResumeMinting", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// UpdateParams defines a governance operation for updating the x/mint module
@@ -204,6 +407,14 @@ type MsgServer interface {
 	//
 	// Since: cosmos-sdk 0.47
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// PauseMinting defines a governance operation for stopping the minting
+	// until it is resumed. The authority is hard-coded to the x/gov module
+	// account.
+	PauseMinting(context.Context, *MsgPauseMinting) (*MsgPauseMintingResponse, error)
+	// ResumeMinting defines a governance operation for resuming the minting
+	// paused by MsgPauseMinting. The authority is hard-coded to the x/gov
+	// module account.
+	ResumeMinting(context.Context, *MsgResumeMinting) (*MsgResumeMintingResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -213,6 +424,12 @@ type UnimplementedMsgServer struct {
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (*UnimplementedMsgServer) PauseMinting(ctx context.Context, req *MsgPauseMinting) (*MsgPauseMintingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseMinting not implemented")
+}
+func (*UnimplementedMsgServer) ResumeMinting(ctx context.Context, req *MsgResumeMinting) (*MsgResumeMintingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeMinting not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -236,6 +453,42 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_PauseMinting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPauseMinting)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PauseMinting(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nolus.mint.v1beta1.Msg/PauseMinting",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PauseMinting(ctx, req.(*MsgPauseMinting))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ResumeMinting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgResumeMinting)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ResumeMinting(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nolus.mint.v1beta1.Msg/ResumeMinting",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ResumeMinting(ctx, req.(*MsgResumeMinting))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "nolus.mint.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -244,6 +497,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "PauseMinting",
+			Handler:    _Msg_PauseMinting_Handler,
+		},
+		{
+			MethodName: "ResumeMinting",
+			Handler:    _Msg_ResumeMinting_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "nolus/mint/v1beta1/tx.proto",
@@ -312,6 +573,112 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgPauseMinting) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPauseMinting) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPauseMinting) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPauseMintingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPauseMintingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPauseMintingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgResumeMinting) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgResumeMinting) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgResumeMinting) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgResumeMintingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgResumeMintingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgResumeMintingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -347,15 +714,59 @@ func (m *MsgUpdateParamsResponse) Size() (n int) {
 	return n
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *MsgPauseMinting) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
+
+func (m *MsgPauseMintingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgResumeMinting) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgResumeMintingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
 	for iNdEx < l {
 		preIndex := iNdEx
 		var wire uint64
@@ -518,6 +929,270 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgPauseMinting) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPauseMinting: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPauseMinting: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPauseMintingResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPauseMintingResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPauseMintingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgResumeMinting) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgResumeMinting: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgResumeMinting: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgResumeMintingResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgResumeMintingResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgResumeMintingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,64 @@
+package cli
+
+import (
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+
+	"github.com/Nolus-Protocol/nolus-core/x/msgfilter/types"
+)
+
+func CmdAddEmergencyBlock() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-emergency-block [type-url] [duration]",
+		Short: "Block a message type for the given duration, e.g. 24h.",
+		Long: `Reject the transactions containing the message type, also when wrapped in an
+authz MsgExec or sent to an interchain account, until the duration passes. Must be
+signed by the emergency address of the module, which may not block a message for
+longer than the max emergency duration.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			duration, err := time.ParseDuration(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgAddEmergencyBlock(clientCtx.GetFromAddress(), args[0], duration)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdRemoveEmergencyBlock() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-emergency-block [type-url]",
+		Short: "Lift the emergency block of a message type before it expires.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRemoveEmergencyBlock(clientCtx.GetFromAddress(), args[0])
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
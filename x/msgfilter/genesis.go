@@ -0,0 +1,25 @@
+package msgfilter
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Nolus-Protocol/nolus-core/x/msgfilter/keeper"
+	"github.com/Nolus-Protocol/nolus-core/x/msgfilter/types"
+)
+
+// InitGenesis initializes the msgfilter module's state from a provided genesis
+// state.
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState) {
+	if err := k.SetParams(ctx, genState.Params); err != nil {
+		ctx.Logger().Error("failed to set msgfilter module params", "error", err)
+	}
+
+	for _, block := range genState.EmergencyBlocks {
+		k.SetEmergencyBlock(ctx, block)
+	}
+}
+
+// ExportGenesis returns the msgfilter module's exported genesis.
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	return types.NewGenesisState(k.GetParams(ctx), k.GetAllEmergencyBlocks(ctx))
+}
@@ -0,0 +1,40 @@
+package msgfilter_test
+
+import (
+	"testing"
+	"time"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Nolus-Protocol/nolus-core/app/params"
+	simulationapp "github.com/Nolus-Protocol/nolus-core/testutil/simapp"
+	"github.com/Nolus-Protocol/nolus-core/x/msgfilter"
+	"github.com/Nolus-Protocol/nolus-core/x/msgfilter/types"
+)
+
+func TestGenesis(t *testing.T) {
+	_ = params.SetAddressPrefixes()
+	app, err := simulationapp.TestSetup(t)
+	require.NoError(t, err)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{}).WithBlockTime(time.Now())
+
+	emergency := sdk.AccAddress("emergency___________").String()
+	expiresAt := time.Unix(1000, 0).UTC()
+	genesisState := types.GenesisState{
+		Params: types.NewParams([]string{"/cosmos.bank.v1beta1.MsgSend"}, emergency, time.Hour),
+		EmergencyBlocks: []types.EmergencyBlock{
+			types.NewEmergencyBlock("/cosmwasm.wasm.v1.MsgInstantiateContract", expiresAt, emergency),
+			types.NewEmergencyBlock("/ibc.applications.transfer.v1.MsgTransfer", expiresAt, emergency),
+		},
+	}
+	require.NoError(t, genesisState.Validate())
+
+	msgfilter.InitGenesis(ctx, *app.MsgFilterKeeper, genesisState)
+
+	got := msgfilter.ExportGenesis(ctx, *app.MsgFilterKeeper)
+	require.NotNil(t, got)
+	require.Equal(t, genesisState.Params, got.Params)
+	require.Equal(t, genesisState.EmergencyBlocks, got.EmergencyBlocks)
+}
@@ -0,0 +1,23 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MsgFilterDecorator rejects the transactions containing a blocked message.
+type MsgFilterDecorator struct {
+	keeper Keeper
+}
+
+// NewMsgFilterDecorator returns an ante decorator rejecting the blocked messages.
+func NewMsgFilterDecorator(keeper Keeper) MsgFilterDecorator {
+	return MsgFilterDecorator{keeper: keeper}
+}
+
+func (d MsgFilterDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if err := d.keeper.CheckMsgs(ctx, tx.GetMsgs()); err != nil {
+		return ctx, err
+	}
+
+	return next(ctx, tx, simulate)
+}
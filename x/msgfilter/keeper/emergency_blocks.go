@@ -0,0 +1,115 @@
+package keeper
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Nolus-Protocol/nolus-core/x/msgfilter/types"
+)
+
+// AddEmergencyBlock blocks a message type until duration passes from the current block time.
+// An existing emergency block of the message type is replaced.
+func (k Keeper) AddEmergencyBlock(ctx sdk.Context, typeURL string, duration time.Duration, addedBy string) (types.EmergencyBlock, error) {
+	block := types.NewEmergencyBlock(typeURL, ctx.BlockTime().Add(duration), addedBy)
+	if err := block.Validate(); err != nil {
+		return block, errorsmod.Wrap(types.ErrInvalidEmergencyBlock, err.Error())
+	}
+
+	k.SetEmergencyBlock(ctx, block)
+	return block, nil
+}
+
+// RemoveEmergencyBlock lifts the emergency block of a message type.
+func (k Keeper) RemoveEmergencyBlock(ctx sdk.Context, typeURL string) error {
+	if !k.HasEmergencyBlock(ctx, typeURL) {
+		return errorsmod.Wrapf(types.ErrEmergencyBlockNotFound, "message %s", typeURL)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetEmergencyBlockKey(typeURL))
+
+	return nil
+}
+
+// SetEmergencyBlock stores an emergency block.
+func (k Keeper) SetEmergencyBlock(ctx sdk.Context, block types.EmergencyBlock) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetEmergencyBlockKey(block.TypeUrl), k.cdc.MustMarshal(&block))
+}
+
+// GetEmergencyBlock returns the emergency block of a message type.
+func (k Keeper) GetEmergencyBlock(ctx sdk.Context, typeURL string) (types.EmergencyBlock, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetEmergencyBlockKey(typeURL))
+	if bz == nil {
+		return types.EmergencyBlock{}, false
+	}
+
+	var block types.EmergencyBlock
+	k.cdc.MustUnmarshal(bz, &block)
+	return block, true
+}
+
+// HasEmergencyBlock returns true if the message type has an emergency block.
+func (k Keeper) HasEmergencyBlock(ctx sdk.Context, typeURL string) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.GetEmergencyBlockKey(typeURL))
+}
+
+// IterateEmergencyBlocks iterates over the emergency blocks ordered by message type URL
+// and stops when cb returns true.
+func (k Keeper) IterateEmergencyBlocks(ctx sdk.Context, cb func(block types.EmergencyBlock) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.EmergencyBlockKeyPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var block types.EmergencyBlock
+		k.cdc.MustUnmarshal(iterator.Value(), &block)
+		if cb(block) {
+			break
+		}
+	}
+}
+
+// GetAllEmergencyBlocks returns all the emergency blocks.
+func (k Keeper) GetAllEmergencyBlocks(ctx sdk.Context) []types.EmergencyBlock {
+	var blocks []types.EmergencyBlock
+	k.IterateEmergencyBlocks(ctx, func(block types.EmergencyBlock) bool {
+		blocks = append(blocks, block)
+		return false
+	})
+
+	return blocks
+}
+
+// PruneExpiredEmergencyBlocks removes the emergency blocks that expired at the current block time.
+func (k Keeper) PruneExpiredEmergencyBlocks(ctx sdk.Context) {
+	var expired []types.EmergencyBlock
+	k.IterateEmergencyBlocks(ctx, func(block types.EmergencyBlock) bool {
+		if !block.IsActive(ctx.BlockTime()) {
+			expired = append(expired, block)
+		}
+		return false
+	})
+
+	store := ctx.KVStore(k.storeKey)
+	for _, block := range expired {
+		store.Delete(types.GetEmergencyBlockKey(block.TypeUrl))
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeExpireEmergencyBlock,
+				sdk.NewAttribute(types.AttributeKeyTypeURL, block.TypeUrl),
+			),
+		)
+	}
+}
+
+// IsMsgBlocked returns true if the message type is blocked by the params or by an active emergency block.
+func (k Keeper) IsMsgBlocked(ctx sdk.Context, typeURL string) bool {
+	return k.newMsgFilter(ctx).checkTypeURL(typeURL) != nil
+}
@@ -0,0 +1,61 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/Nolus-Protocol/nolus-core/x/msgfilter/types"
+)
+
+func (s *KeeperTestSuite) TestAddRemoveEmergencyBlock() {
+	block, err := s.keeper.AddEmergencyBlock(s.ctx, msgSendURL, time.Hour, s.emergency.String())
+	s.Require().NoError(err)
+	s.Require().Equal(s.ctx.BlockTime().Add(time.Hour), block.ExpiresAt)
+
+	got, found := s.keeper.GetEmergencyBlock(s.ctx, msgSendURL)
+	s.Require().True(found)
+	s.Require().Equal(block, got)
+
+	// adding the block again replaces the expiry
+	block, err = s.keeper.AddEmergencyBlock(s.ctx, msgSendURL, 2*time.Hour, s.emergency.String())
+	s.Require().NoError(err)
+	s.Require().Equal([]types.EmergencyBlock{block}, s.keeper.GetAllEmergencyBlocks(s.ctx))
+
+	_, err = s.keeper.AddEmergencyBlock(s.ctx, "invalid", time.Hour, s.emergency.String())
+	s.Require().ErrorIs(err, types.ErrInvalidEmergencyBlock)
+
+	s.Require().NoError(s.keeper.RemoveEmergencyBlock(s.ctx, msgSendURL))
+	s.Require().False(s.keeper.HasEmergencyBlock(s.ctx, msgSendURL))
+	s.Require().ErrorIs(s.keeper.RemoveEmergencyBlock(s.ctx, msgSendURL), types.ErrEmergencyBlockNotFound)
+}
+
+func (s *KeeperTestSuite) TestPruneExpiredEmergencyBlocks() {
+	_, err := s.keeper.AddEmergencyBlock(s.ctx, msgSendURL, time.Hour, s.emergency.String())
+	s.Require().NoError(err)
+	_, err = s.keeper.AddEmergencyBlock(s.ctx, msgTransferURL, 2*time.Hour, s.emergency.String())
+	s.Require().NoError(err)
+
+	s.passTime(time.Hour)
+	s.keeper.PruneExpiredEmergencyBlocks(s.ctx)
+
+	s.Require().False(s.keeper.HasEmergencyBlock(s.ctx, msgSendURL))
+	s.Require().True(s.keeper.HasEmergencyBlock(s.ctx, msgTransferURL))
+
+	events := s.ctx.EventManager().Events()
+	s.Require().Len(events, 1)
+	s.Require().Equal(types.EventTypeExpireEmergencyBlock, events[0].Type)
+}
+
+func (s *KeeperTestSuite) TestIsMsgBlocked() {
+	s.Require().False(s.keeper.IsMsgBlocked(s.ctx, msgSendURL))
+
+	_, err := s.keeper.AddEmergencyBlock(s.ctx, msgSendURL, time.Hour, s.emergency.String())
+	s.Require().NoError(err)
+	s.Require().True(s.keeper.IsMsgBlocked(s.ctx, msgSendURL))
+
+	// an expired block which is not pruned yet no longer applies
+	s.passTime(time.Hour)
+	s.Require().False(s.keeper.IsMsgBlocked(s.ctx, msgSendURL))
+
+	s.Require().NoError(s.keeper.SetParams(s.ctx, types.NewParams([]string{msgSendURL}, "", time.Hour)))
+	s.Require().True(s.keeper.IsMsgBlocked(s.ctx, msgSendURL))
+}
@@ -0,0 +1,111 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	icacontrollertypes "github.com/cosmos/ibc-go/v7/modules/apps/27-interchain-accounts/controller/types"
+	icatypes "github.com/cosmos/ibc-go/v7/modules/apps/27-interchain-accounts/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+
+	ictxtypes "github.com/neutron-org/neutron/x/interchaintxs/types"
+	transferwrappertypes "github.com/neutron-org/neutron/x/transfer/types"
+
+	"github.com/Nolus-Protocol/nolus-core/x/msgfilter/types"
+)
+
+// CheckMsgs returns an error if any of the messages, or a message they wrap, is blocked.
+//
+// The messages executed by authz MsgExec are checked recursively. The messages sent to
+// interchain accounts, through the interchaintxs module or the ICA controller, are
+// checked by type URL only, as they are executed on the host chain. The transfers of the
+// neutron transfer wrapper are blocked together with the ibc-go transfers they execute.
+func (k Keeper) CheckMsgs(ctx sdk.Context, msgs []sdk.Msg) error {
+	filter := k.newMsgFilter(ctx)
+	for _, msg := range msgs {
+		if err := filter.checkMsg(msg); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// msgFilter caches the params of the module while checking the messages of a transaction.
+type msgFilter struct {
+	ctx     sdk.Context
+	keeper  Keeper
+	blocked map[string]bool
+}
+
+func (k Keeper) newMsgFilter(ctx sdk.Context) msgFilter {
+	params := k.GetParams(ctx)
+	blocked := make(map[string]bool, len(params.BlockedMsgTypeUrls))
+	for _, typeURL := range params.BlockedMsgTypeUrls {
+		blocked[typeURL] = true
+	}
+
+	return msgFilter{ctx: ctx, keeper: k, blocked: blocked}
+}
+
+func (f msgFilter) checkMsg(msg sdk.Msg) error {
+	if err := f.checkTypeURL(sdk.MsgTypeURL(msg)); err != nil {
+		return err
+	}
+
+	switch msg := msg.(type) {
+	case *authz.MsgExec:
+		nested, err := msg.GetMessages()
+		if err != nil {
+			return err
+		}
+
+		for _, nestedMsg := range nested {
+			if err := f.checkMsg(nestedMsg); err != nil {
+				return err
+			}
+		}
+	case *transferwrappertypes.MsgTransfer:
+		return f.checkTypeURL(sdk.MsgTypeURL(&ibctransfertypes.MsgTransfer{}))
+	case *ictxtypes.MsgSubmitTx:
+		return f.checkAnys(msg.Msgs)
+	case *icacontrollertypes.MsgSendTx:
+		var cosmosTx icatypes.CosmosTx
+		if err := cosmosTx.Unmarshal(msg.PacketData.Data); err != nil {
+			// the ICA host rejects the packet anyway
+			return nil
+		}
+
+		return f.checkAnys(cosmosTx.Messages)
+	}
+
+	return nil
+}
+
+func (f msgFilter) checkAnys(anys []*codectypes.Any) error {
+	for _, any := range anys {
+		if any == nil {
+			continue
+		}
+
+		if err := f.checkTypeURL(any.TypeUrl); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (f msgFilter) checkTypeURL(typeURL string) error {
+	if f.blocked[typeURL] {
+		return errorsmod.Wrapf(types.ErrMessageBlocked, "%s is blocked by governance", typeURL)
+	}
+
+	block, found := f.keeper.GetEmergencyBlock(f.ctx, typeURL)
+	if found && block.IsActive(f.ctx.BlockTime()) {
+		return errorsmod.Wrapf(types.ErrMessageBlocked, "%s is blocked until %s", typeURL, block.ExpiresAt)
+	}
+
+	return nil
+}
@@ -0,0 +1,123 @@
+package keeper_test
+
+import (
+	"time"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/cosmos/gogoproto/proto"
+	icacontrollertypes "github.com/cosmos/ibc-go/v7/modules/apps/27-interchain-accounts/controller/types"
+	icatypes "github.com/cosmos/ibc-go/v7/modules/apps/27-interchain-accounts/types"
+
+	ictxtypes "github.com/neutron-org/neutron/x/interchaintxs/types"
+	transferwrappertypes "github.com/neutron-org/neutron/x/transfer/types"
+
+	"github.com/Nolus-Protocol/nolus-core/x/msgfilter/keeper"
+	"github.com/Nolus-Protocol/nolus-core/x/msgfilter/types"
+)
+
+func (s *KeeperTestSuite) msgSend() *banktypes.MsgSend {
+	from := sdk.AccAddress("from________________")
+	to := sdk.AccAddress("to__________________")
+	return banktypes.NewMsgSend(from, to, sdk.NewCoins(sdk.NewInt64Coin("unls", 1)))
+}
+
+func (s *KeeperTestSuite) blockMsgSend() {
+	s.Require().NoError(s.keeper.SetParams(s.ctx, types.NewParams([]string{msgSendURL}, "", time.Hour)))
+}
+
+func (s *KeeperTestSuite) TestCheckMsgs() {
+	delegate := &stakingtypes.MsgDelegate{}
+	s.Require().NoError(s.keeper.CheckMsgs(s.ctx, []sdk.Msg{s.msgSend(), delegate}))
+
+	s.blockMsgSend()
+	s.Require().ErrorIs(s.keeper.CheckMsgs(s.ctx, []sdk.Msg{delegate, s.msgSend()}), types.ErrMessageBlocked)
+	s.Require().NoError(s.keeper.CheckMsgs(s.ctx, []sdk.Msg{delegate}))
+
+	_, err := s.keeper.AddEmergencyBlock(s.ctx, sdk.MsgTypeURL(delegate), time.Hour, s.emergency.String())
+	s.Require().NoError(err)
+	s.Require().ErrorIs(s.keeper.CheckMsgs(s.ctx, []sdk.Msg{delegate}), types.ErrMessageBlocked)
+
+	s.passTime(time.Hour)
+	s.Require().NoError(s.keeper.CheckMsgs(s.ctx, []sdk.Msg{delegate}))
+}
+
+func (s *KeeperTestSuite) TestCheckMsgsAuthzExec() {
+	grantee := sdk.AccAddress("grantee_____________")
+	exec := authz.NewMsgExec(grantee, []sdk.Msg{s.msgSend()})
+	nested := authz.NewMsgExec(grantee, []sdk.Msg{&exec})
+	s.Require().NoError(s.keeper.CheckMsgs(s.ctx, []sdk.Msg{&nested}))
+
+	s.blockMsgSend()
+	s.Require().ErrorIs(s.keeper.CheckMsgs(s.ctx, []sdk.Msg{&exec}), types.ErrMessageBlocked)
+	s.Require().ErrorIs(s.keeper.CheckMsgs(s.ctx, []sdk.Msg{&nested}), types.ErrMessageBlocked)
+}
+
+func (s *KeeperTestSuite) TestCheckMsgsInterchainTxs() {
+	msgAny, err := codectypes.NewAnyWithValue(s.msgSend())
+	s.Require().NoError(err)
+
+	submitTx := &ictxtypes.MsgSubmitTx{Msgs: []*codectypes.Any{msgAny}}
+	data, err := icatypes.SerializeCosmosTx(s.app.AppCodec(), []proto.Message{s.msgSend()})
+	s.Require().NoError(err)
+	sendTx := &icacontrollertypes.MsgSendTx{PacketData: icatypes.InterchainAccountPacketData{Type: icatypes.EXECUTE_TX, Data: data}}
+	s.Require().NoError(s.keeper.CheckMsgs(s.ctx, []sdk.Msg{submitTx, sendTx}))
+
+	s.blockMsgSend()
+	s.Require().ErrorIs(s.keeper.CheckMsgs(s.ctx, []sdk.Msg{submitTx}), types.ErrMessageBlocked)
+	s.Require().ErrorIs(s.keeper.CheckMsgs(s.ctx, []sdk.Msg{sendTx}), types.ErrMessageBlocked)
+}
+
+func (s *KeeperTestSuite) TestCheckMsgsTransferWrapper() {
+	transfer := &transferwrappertypes.MsgTransfer{}
+	s.Require().NoError(s.keeper.CheckMsgs(s.ctx, []sdk.Msg{transfer}))
+
+	// blocking the ibc-go transfer blocks the transfers of the wrapper as well
+	s.Require().NoError(s.keeper.SetParams(s.ctx, types.NewParams([]string{msgTransferURL}, "", time.Hour)))
+	s.Require().ErrorIs(s.keeper.CheckMsgs(s.ctx, []sdk.Msg{transfer}), types.ErrMessageBlocked)
+}
+
+func (s *KeeperTestSuite) TestMsgFilterDecorator() {
+	s.blockMsgSend()
+	decorator := keeper.NewMsgFilterDecorator(s.keeper)
+
+	nextCalled := false
+	next := func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) {
+		nextCalled = true
+		return ctx, nil
+	}
+
+	_, err := decorator.AnteHandle(s.ctx, mockTx{msgs: []sdk.Msg{s.msgSend()}}, false, next)
+	s.Require().ErrorIs(err, types.ErrMessageBlocked)
+	s.Require().False(nextCalled)
+
+	_, err = decorator.AnteHandle(s.ctx, mockTx{msgs: []sdk.Msg{&stakingtypes.MsgDelegate{}}}, false, next)
+	s.Require().NoError(err)
+	s.Require().True(nextCalled)
+}
+
+func (s *KeeperTestSuite) TestFilteredMessageRouter() {
+	s.blockMsgSend()
+	router := keeper.NewFilteredMessageRouter(s.app.MsgServiceRouter(), s.keeper)
+
+	handler := router.Handler(s.msgSend())
+	s.Require().NotNil(handler)
+	_, err := handler(s.ctx, s.msgSend())
+	s.Require().ErrorIs(err, types.ErrMessageBlocked)
+
+	handler = router.Handler(&stakingtypes.MsgDelegate{})
+	s.Require().NotNil(handler)
+}
+
+// mockTx is a transaction carrying only messages.
+type mockTx struct {
+	sdk.Tx
+	msgs []sdk.Msg
+}
+
+func (tx mockTx) GetMsgs() []sdk.Msg {
+	return tx.msgs
+}
@@ -0,0 +1,61 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Nolus-Protocol/nolus-core/x/msgfilter/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// Params returns the parameters of the module.
+func (k Keeper) Params(c context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryParamsResponse{Params: k.GetParams(ctx)}, nil
+}
+
+// EmergencyBlocks returns the emergency blocks ordered by message type URL.
+func (k Keeper) EmergencyBlocks(c context.Context, req *types.QueryEmergencyBlocksRequest) (*types.QueryEmergencyBlocksResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.EmergencyBlockKeyPrefix)
+
+	var blocks []types.EmergencyBlock
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var block types.EmergencyBlock
+		if err := k.cdc.Unmarshal(value, &block); err != nil {
+			return err
+		}
+
+		blocks = append(blocks, block)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryEmergencyBlocksResponse{EmergencyBlocks: blocks, Pagination: pageRes}, nil
+}
+
+// IsBlocked returns whether a message type is currently blocked.
+func (k Keeper) IsBlocked(c context.Context, req *types.QueryIsBlockedRequest) (*types.QueryIsBlockedResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryIsBlockedResponse{Blocked: k.IsMsgBlocked(ctx, req.TypeUrl)}, nil
+}
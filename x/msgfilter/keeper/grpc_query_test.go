@@ -0,0 +1,47 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Nolus-Protocol/nolus-core/x/msgfilter/types"
+)
+
+func (s *KeeperTestSuite) TestQueryEmergencyBlocks() {
+	for _, typeURL := range []string{msgTransferURL, msgSendURL, "/cosmos.staking.v1beta1.MsgDelegate"} {
+		_, err := s.keeper.AddEmergencyBlock(s.ctx, typeURL, time.Hour, s.emergency.String())
+		s.Require().NoError(err)
+	}
+	ctx := sdk.WrapSDKContext(s.ctx)
+
+	_, err := s.keeper.EmergencyBlocks(ctx, nil)
+	s.Require().Equal(codes.InvalidArgument, status.Code(err))
+
+	page, err := s.keeper.EmergencyBlocks(ctx, &types.QueryEmergencyBlocksRequest{Pagination: &query.PageRequest{Limit: 2, CountTotal: true}})
+	s.Require().NoError(err)
+	s.Require().Len(page.EmergencyBlocks, 2)
+	s.Require().Equal(uint64(3), page.Pagination.Total)
+	s.Require().Equal(msgSendURL, page.EmergencyBlocks[0].TypeUrl)
+
+	next, err := s.keeper.EmergencyBlocks(ctx, &types.QueryEmergencyBlocksRequest{Pagination: &query.PageRequest{Key: page.Pagination.NextKey}})
+	s.Require().NoError(err)
+	s.Require().Len(next.EmergencyBlocks, 1)
+	s.Require().Equal(msgTransferURL, next.EmergencyBlocks[0].TypeUrl)
+}
+
+func (s *KeeperTestSuite) TestQueryIsBlocked() {
+	s.blockMsgSend()
+	ctx := sdk.WrapSDKContext(s.ctx)
+
+	res, err := s.keeper.IsBlocked(ctx, &types.QueryIsBlockedRequest{TypeUrl: msgSendURL})
+	s.Require().NoError(err)
+	s.Require().True(res.Blocked)
+
+	res, err = s.keeper.IsBlocked(ctx, &types.QueryIsBlockedRequest{TypeUrl: msgTransferURL})
+	s.Require().NoError(err)
+	s.Require().False(res.Blocked)
+}
@@ -0,0 +1,55 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Nolus-Protocol/nolus-core/x/msgfilter/types"
+)
+
+type Keeper struct {
+	cdc      codec.BinaryCodec
+	storeKey storetypes.StoreKey
+	memKey   storetypes.StoreKey
+
+	// the address capable of executing a MsgUpdateParams message and managing
+	// the emergency blocks. Typically, this should be the x/gov module account.
+	authority string
+}
+
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	storeKey,
+	memKey storetypes.StoreKey,
+	authority string,
+) *Keeper {
+	return &Keeper{
+		cdc:       cdc,
+		storeKey:  storeKey,
+		memKey:    memKey,
+		authority: authority,
+	}
+}
+
+// GetAuthority returns the x/msgfilter module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// IsEmergencyAdmin returns true if the address may add and remove emergency blocks.
+func (k Keeper) IsEmergencyAdmin(ctx sdk.Context, address string) bool {
+	if address == k.authority {
+		return true
+	}
+
+	emergencyAddress := k.GetParams(ctx).EmergencyAddress
+	return emergencyAddress != "" && address == emergencyAddress
+}
@@ -0,0 +1,57 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/suite"
+
+	nolusapp "github.com/Nolus-Protocol/nolus-core/app"
+	"github.com/Nolus-Protocol/nolus-core/app/params"
+	simulationapp "github.com/Nolus-Protocol/nolus-core/testutil/simapp"
+	"github.com/Nolus-Protocol/nolus-core/x/msgfilter/keeper"
+	"github.com/Nolus-Protocol/nolus-core/x/msgfilter/types"
+)
+
+const (
+	msgSendURL     = "/cosmos.bank.v1beta1.MsgSend"
+	msgTransferURL = "/ibc.applications.transfer.v1.MsgTransfer"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+	ctx       sdk.Context
+	app       *nolusapp.App
+	keeper    keeper.Keeper
+	msgServer types.MsgServer
+	authority string
+	emergency sdk.AccAddress
+}
+
+// SetupTest setups a new test, with an emergency address allowed to block messages for a day.
+func (s *KeeperTestSuite) SetupTest() {
+	var err error
+	_ = params.SetAddressPrefixes()
+	s.app, err = simulationapp.TestSetup(s.T())
+	s.Require().NoError(err)
+
+	header := tmproto.Header{Height: s.app.LastBlockHeight() + 1}
+	s.ctx = s.app.BaseApp.NewContext(false, header).WithBlockTime(time.Unix(1_000_000, 0).UTC())
+
+	s.keeper = *s.app.MsgFilterKeeper
+	s.msgServer = keeper.NewMsgServerImpl(s.keeper)
+	s.authority = s.keeper.GetAuthority()
+	s.emergency = sdk.AccAddress("emergency___________")
+	s.Require().NoError(s.keeper.SetParams(s.ctx, types.NewParams(nil, s.emergency.String(), 24*time.Hour)))
+}
+
+// passTime moves the block time of the context forward.
+func (s *KeeperTestSuite) passTime(d time.Duration) {
+	s.ctx = s.ctx.WithBlockTime(s.ctx.BlockTime().Add(d))
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
@@ -0,0 +1,109 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/Nolus-Protocol/nolus-core/x/msgfilter/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+var _ types.MsgServer = msgServer{}
+
+// AddEmergencyBlock blocks a message type temporarily on behalf of the
+// governance authority or the emergency address. The emergency address may
+// not block a message for longer than the max emergency duration, nor extend
+// a block which is still active.
+func (k msgServer) AddEmergencyBlock(goCtx context.Context, req *types.MsgAddEmergencyBlock) (*types.MsgAddEmergencyBlockResponse, error) {
+	if err := req.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if !k.IsEmergencyAdmin(ctx, req.Authority) {
+		return nil, errors.Wrapf(sdkerrors.ErrUnauthorized, "%s may not block messages", req.Authority)
+	}
+
+	if req.Authority != k.authority {
+		if maxDuration := k.GetParams(ctx).MaxEmergencyDuration; req.Duration > maxDuration {
+			return nil, errors.Wrapf(types.ErrInvalidEmergencyBlock, "duration %s exceeds the max emergency duration %s", req.Duration, maxDuration)
+		}
+
+		if block, found := k.GetEmergencyBlock(ctx, req.TypeUrl); found && block.IsActive(ctx.BlockTime()) {
+			return nil, errors.Wrapf(sdkerrors.ErrUnauthorized, "only the governance authority may extend the block of %s", req.TypeUrl)
+		}
+	}
+
+	block, err := k.Keeper.AddEmergencyBlock(ctx, req.TypeUrl, req.Duration, req.Authority)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAddEmergencyBlock,
+			sdk.NewAttribute(types.AttributeKeyTypeURL, block.TypeUrl),
+			sdk.NewAttribute(types.AttributeKeyExpiresAt, block.ExpiresAt.String()),
+			sdk.NewAttribute(types.AttributeKeyAuthority, req.Authority),
+		),
+	)
+
+	return &types.MsgAddEmergencyBlockResponse{}, nil
+}
+
+// RemoveEmergencyBlock lifts a temporary block on behalf of the governance
+// authority or the emergency address.
+func (k msgServer) RemoveEmergencyBlock(goCtx context.Context, req *types.MsgRemoveEmergencyBlock) (*types.MsgRemoveEmergencyBlockResponse, error) {
+	if err := req.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if !k.IsEmergencyAdmin(ctx, req.Authority) {
+		return nil, errors.Wrapf(sdkerrors.ErrUnauthorized, "%s may not unblock messages", req.Authority)
+	}
+
+	if err := k.Keeper.RemoveEmergencyBlock(ctx, req.TypeUrl); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRemoveEmergencyBlock,
+			sdk.NewAttribute(types.AttributeKeyTypeURL, req.TypeUrl),
+			sdk.NewAttribute(types.AttributeKeyAuthority, req.Authority),
+		),
+	)
+
+	return &types.MsgRemoveEmergencyBlockResponse{}, nil
+}
+
+func (k msgServer) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if err := req.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	if k.authority != req.Authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.SetParams(ctx, req.Params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
@@ -0,0 +1,106 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/Nolus-Protocol/nolus-core/x/msgfilter/types"
+)
+
+func (s *KeeperTestSuite) TestMsgAddRemoveEmergencyBlock() {
+	authority := sdk.MustAccAddressFromBech32(s.authority)
+	other := sdk.AccAddress("other_______________")
+	ctx := sdk.WrapSDKContext(s.ctx)
+
+	_, err := s.msgServer.AddEmergencyBlock(ctx, types.NewMsgAddEmergencyBlock(other, msgSendURL, time.Hour))
+	s.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	// only the governance authority may exceed the max emergency duration
+	_, err = s.msgServer.AddEmergencyBlock(ctx, types.NewMsgAddEmergencyBlock(s.emergency, msgSendURL, 48*time.Hour))
+	s.Require().ErrorIs(err, types.ErrInvalidEmergencyBlock)
+	_, err = s.msgServer.AddEmergencyBlock(ctx, types.NewMsgAddEmergencyBlock(authority, msgSendURL, 48*time.Hour))
+	s.Require().NoError(err)
+	_, err = s.msgServer.AddEmergencyBlock(ctx, types.NewMsgAddEmergencyBlock(s.emergency, msgTransferURL, 24*time.Hour))
+	s.Require().NoError(err)
+	s.Require().Len(s.keeper.GetAllEmergencyBlocks(s.ctx), 2)
+
+	_, err = s.msgServer.RemoveEmergencyBlock(ctx, types.NewMsgRemoveEmergencyBlock(other, msgSendURL))
+	s.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+	_, err = s.msgServer.RemoveEmergencyBlock(ctx, types.NewMsgRemoveEmergencyBlock(s.emergency, msgSendURL))
+	s.Require().NoError(err)
+	_, err = s.msgServer.RemoveEmergencyBlock(ctx, types.NewMsgRemoveEmergencyBlock(authority, msgTransferURL))
+	s.Require().NoError(err)
+	_, err = s.msgServer.RemoveEmergencyBlock(ctx, types.NewMsgRemoveEmergencyBlock(authority, msgTransferURL))
+	s.Require().ErrorIs(err, types.ErrEmergencyBlockNotFound)
+
+	s.Require().Empty(s.keeper.GetAllEmergencyBlocks(s.ctx))
+}
+
+func (s *KeeperTestSuite) TestMsgAddEmergencyBlockUnblockable() {
+	authority := sdk.MustAccAddressFromBech32(s.authority)
+	ctx := sdk.WrapSDKContext(s.ctx)
+
+	// the messages needed to lift a block are never blocked
+	for _, typeURL := range []string{
+		"/cosmos.gov.v1.MsgSubmitProposal",
+		"/cosmos.gov.v1.MsgVote",
+		"/cosmos.gov.v1.MsgVoteWeighted",
+		"/cosmos.gov.v1.MsgDeposit",
+		"/cosmos.gov.v1.MsgExecLegacyContent",
+		"/cosmos.gov.v1beta1.MsgSubmitProposal",
+		"/cosmos.gov.v1beta1.MsgVote",
+		"/cosmos.gov.v1beta1.MsgVoteWeighted",
+		"/cosmos.gov.v1beta1.MsgDeposit",
+		"/cosmos.authz.v1beta1.MsgExec",
+		"/nolus.msgfilter.v1beta1.MsgRemoveEmergencyBlock",
+		"/nolus.msgfilter.v1beta1.MsgUpdateParams",
+	} {
+		_, err := s.msgServer.AddEmergencyBlock(ctx, types.NewMsgAddEmergencyBlock(s.emergency, typeURL, time.Hour))
+		s.Require().ErrorIs(err, types.ErrUnblockableMsg)
+		_, err = s.msgServer.AddEmergencyBlock(ctx, types.NewMsgAddEmergencyBlock(authority, typeURL, time.Hour))
+		s.Require().ErrorIs(err, types.ErrUnblockableMsg)
+	}
+	s.Require().Empty(s.keeper.GetAllEmergencyBlocks(s.ctx))
+}
+
+func (s *KeeperTestSuite) TestMsgAddEmergencyBlockExtend() {
+	authority := sdk.MustAccAddressFromBech32(s.authority)
+	ctx := sdk.WrapSDKContext(s.ctx)
+
+	_, err := s.msgServer.AddEmergencyBlock(ctx, types.NewMsgAddEmergencyBlock(s.emergency, msgSendURL, time.Hour))
+	s.Require().NoError(err)
+
+	// only the governance authority may extend an active block
+	_, err = s.msgServer.AddEmergencyBlock(ctx, types.NewMsgAddEmergencyBlock(s.emergency, msgSendURL, time.Hour))
+	s.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+	_, err = s.msgServer.AddEmergencyBlock(ctx, types.NewMsgAddEmergencyBlock(authority, msgSendURL, 48*time.Hour))
+	s.Require().NoError(err)
+
+	block, found := s.keeper.GetEmergencyBlock(s.ctx, msgSendURL)
+	s.Require().True(found)
+	s.Require().Equal(s.ctx.BlockTime().Add(48*time.Hour), block.ExpiresAt)
+
+	// the emergency address blocks the message again once the block expired
+	s.passTime(48 * time.Hour)
+	_, err = s.msgServer.AddEmergencyBlock(sdk.WrapSDKContext(s.ctx), types.NewMsgAddEmergencyBlock(s.emergency, msgSendURL, time.Hour))
+	s.Require().NoError(err)
+}
+
+func (s *KeeperTestSuite) TestMsgUpdateParams() {
+	ctx := sdk.WrapSDKContext(s.ctx)
+	params := types.NewParams([]string{msgSendURL}, "", time.Hour)
+
+	// the emergency address may not update the params
+	_, err := s.msgServer.UpdateParams(ctx, &types.MsgUpdateParams{Authority: s.emergency.String(), Params: params})
+	s.Require().ErrorIs(err, govtypes.ErrInvalidSigner)
+
+	_, err = s.msgServer.UpdateParams(ctx, &types.MsgUpdateParams{Authority: s.authority, Params: types.NewParams([]string{"invalid"}, "", time.Hour)})
+	s.Require().Error(err)
+
+	_, err = s.msgServer.UpdateParams(ctx, &types.MsgUpdateParams{Authority: s.authority, Params: params})
+	s.Require().NoError(err)
+	s.Require().Equal(params, s.keeper.GetParams(s.ctx))
+}
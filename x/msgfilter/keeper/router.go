@@ -0,0 +1,39 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MessageRouter routes the messages dispatched by the wasm contracts.
+type MessageRouter interface {
+	Handler(msg sdk.Msg) baseapp.MsgServiceHandler
+}
+
+// FilteredMessageRouter rejects the blocked messages before routing them, so
+// contracts can not dispatch messages blocked at the transaction level.
+type FilteredMessageRouter struct {
+	router MessageRouter
+	keeper Keeper
+}
+
+// NewFilteredMessageRouter wraps a router with the message filter.
+func NewFilteredMessageRouter(router MessageRouter, keeper Keeper) FilteredMessageRouter {
+	return FilteredMessageRouter{router: router, keeper: keeper}
+}
+
+// Handler returns the handler of the message, checking the message against the filter first.
+func (r FilteredMessageRouter) Handler(msg sdk.Msg) baseapp.MsgServiceHandler {
+	handler := r.router.Handler(msg)
+	if handler == nil {
+		return nil
+	}
+
+	return func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+		if err := r.keeper.CheckMsgs(ctx, []sdk.Msg{msg}); err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, msg)
+	}
+}
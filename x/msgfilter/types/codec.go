@@ -0,0 +1,25 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+)
+
+func RegisterCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&MsgAddEmergencyBlock{}, "nolus-core/x/msgfilter/MsgAddEmergencyBlock", nil)
+	cdc.RegisterConcrete(&MsgRemoveEmergencyBlock{}, "nolus-core/x/msgfilter/MsgRemoveEmergencyBlock", nil)
+	cdc.RegisterConcrete(&MsgUpdateParams{}, "nolus-core/x/msgfilter/MsgUpdateParams", nil)
+}
+
+func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgAddEmergencyBlock{},
+		&MsgRemoveEmergencyBlock{},
+		&MsgUpdateParams{},
+	)
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
+
+var ModuleCdc = codec.NewProtoCodec(cdctypes.NewInterfaceRegistry())
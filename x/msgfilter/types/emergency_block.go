@@ -0,0 +1,39 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewEmergencyBlock creates a new EmergencyBlock instance.
+func NewEmergencyBlock(typeURL string, expiresAt time.Time, addedBy string) EmergencyBlock {
+	return EmergencyBlock{
+		TypeUrl:   typeURL,
+		ExpiresAt: expiresAt,
+		AddedBy:   addedBy,
+	}
+}
+
+// Validate performs a basic validation of the emergency block.
+func (b EmergencyBlock) Validate() error {
+	if err := ValidateBlockableTypeURL(b.TypeUrl); err != nil {
+		return err
+	}
+
+	if b.ExpiresAt.IsZero() {
+		return fmt.Errorf("expiry time cannot be empty")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(b.AddedBy); err != nil {
+		return fmt.Errorf("invalid added by address %s: %w", b.AddedBy, err)
+	}
+
+	return nil
+}
+
+// IsActive returns whether the message is still blocked at the given block time.
+func (b EmergencyBlock) IsActive(blockTime time.Time) bool {
+	return blockTime.Before(b.ExpiresAt)
+}
@@ -0,0 +1,437 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: nolus/msgfilter/v1beta1/emergency_block.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// EmergencyBlock is a message type URL blocked temporarily.
+type EmergencyBlock struct {
+	// type_url is the type URL of the blocked message.
+	TypeUrl string `protobuf:"bytes,1,opt,name=type_url,json=typeUrl,proto3" json:"type_url,omitempty" yaml:"type_url"`
+	// expires_at is the block time at which the message is allowed again.
+	ExpiresAt time.Time `protobuf:"bytes,2,opt,name=expires_at,json=expiresAt,proto3,stdtime" json:"expires_at" yaml:"expires_at"`
+	// added_by is the address which blocked the message.
+	AddedBy string `protobuf:"bytes,3,opt,name=added_by,json=addedBy,proto3" json:"added_by,omitempty" yaml:"added_by"`
+}
+
+func (m *EmergencyBlock) Reset()         { *m = EmergencyBlock{} }
+func (m *EmergencyBlock) String() string { return proto.CompactTextString(m) }
+func (*EmergencyBlock) ProtoMessage()    {}
+func (*EmergencyBlock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14f0efec7f052d5c, []int{0}
+}
+func (m *EmergencyBlock) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EmergencyBlock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EmergencyBlock.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EmergencyBlock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EmergencyBlock.Merge(m, src)
+}
+func (m *EmergencyBlock) XXX_Size() int {
+	return m.Size()
+}
+func (m *EmergencyBlock) XXX_DiscardUnknown() {
+	xxx_messageInfo_EmergencyBlock.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EmergencyBlock proto.InternalMessageInfo
+
+func (m *EmergencyBlock) GetTypeUrl() string {
+	if m != nil {
+		return m.TypeUrl
+	}
+	return ""
+}
+
+func (m *EmergencyBlock) GetExpiresAt() time.Time {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return time.Time{}
+}
+
+func (m *EmergencyBlock) GetAddedBy() string {
+	if m != nil {
+		return m.AddedBy
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*EmergencyBlock)(nil), "nolus.msgfilter.v1beta1.EmergencyBlock")
+}
+
+func init() {
+	proto.RegisterFile("nolus/msgfilter/v1beta1/emergency_block.proto", fileDescriptor_14f0efec7f052d5c)
+}
+
+var fileDescriptor_14f0efec7f052d5c = []byte{
+	// 318 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x91, 0x41, 0x4b, 0xf3, 0x30,
+	0x18, 0xc7, 0x9b, 0xf7, 0x05, 0xe7, 0x2a, 0x28, 0x4e, 0xc1, 0x31, 0xb0, 0x1d, 0x3d, 0xed, 0xb2,
+	0x84, 0x29, 0x78, 0xf0, 0x66, 0xc1, 0xab, 0x8c, 0xa1, 0x20, 0x5e, 0x4a, 0xd3, 0x3d, 0x8b, 0xc5,
+	0x64, 0x29, 0x69, 0x2a, 0xeb, 0xb7, 0xd8, 0xc7, 0x1a, 0x9e, 0x76, 0xf4, 0x34, 0x65, 0xfb, 0x06,
+	0xfb, 0x04, 0x92, 0x74, 0x55, 0xf1, 0xf6, 0x24, 0xcf, 0x2f, 0xf9, 0xfd, 0xf3, 0xc4, 0xed, 0x4f,
+	0x25, 0x2f, 0x72, 0x22, 0x72, 0x36, 0x49, 0xb9, 0x06, 0x45, 0x5e, 0x07, 0x14, 0x74, 0x3c, 0x20,
+	0x20, 0x40, 0x31, 0x98, 0x26, 0x65, 0x44, 0xb9, 0x4c, 0x5e, 0x70, 0xa6, 0xa4, 0x96, 0xad, 0x33,
+	0x8b, 0xe3, 0x6f, 0x1c, 0xef, 0xf0, 0xce, 0x29, 0x93, 0x4c, 0x5a, 0x86, 0x98, 0xaa, 0xc2, 0x3b,
+	0x3e, 0x93, 0x92, 0x71, 0x20, 0x76, 0x45, 0x8b, 0x09, 0xd1, 0xa9, 0x80, 0x5c, 0xc7, 0x22, 0xab,
+	0x80, 0xe0, 0x0d, 0xb9, 0x87, 0xb7, 0xb5, 0x29, 0x34, 0xa2, 0x16, 0x76, 0xf7, 0x75, 0x99, 0x41,
+	0x54, 0x28, 0xde, 0x46, 0x5d, 0xd4, 0x6b, 0x86, 0x27, 0xdb, 0x95, 0x7f, 0x54, 0xc6, 0x82, 0x5f,
+	0x07, 0x75, 0x27, 0x18, 0x35, 0x4c, 0xf9, 0xa0, 0x78, 0xeb, 0xd1, 0x75, 0x61, 0x96, 0xa5, 0x0a,
+	0xf2, 0x28, 0xd6, 0xed, 0x7f, 0x5d, 0xd4, 0x3b, 0xb8, 0xe8, 0xe0, 0x4a, 0x8c, 0x6b, 0x31, 0xbe,
+	0xaf, 0xc5, 0xe1, 0xf9, 0x62, 0xe5, 0x3b, 0xdb, 0x95, 0x7f, 0x5c, 0xdd, 0xf8, 0x73, 0x36, 0x98,
+	0x7f, 0xf8, 0x68, 0xd4, 0xdc, 0x6d, 0xdc, 0x68, 0x93, 0x24, 0x1e, 0x8f, 0x61, 0x1c, 0xd1, 0xb2,
+	0xfd, 0xff, 0x6f, 0x92, 0xba, 0x13, 0x8c, 0x1a, 0xb6, 0x0c, 0xcb, 0x70, 0xb8, 0x58, 0x7b, 0x68,
+	0xb9, 0xf6, 0xd0, 0xe7, 0xda, 0x43, 0xf3, 0x8d, 0xe7, 0x2c, 0x37, 0x9e, 0xf3, 0xbe, 0xf1, 0x9c,
+	0xa7, 0x2b, 0x96, 0xea, 0xe7, 0x82, 0xe2, 0x44, 0x0a, 0x72, 0x67, 0x26, 0xd8, 0x1f, 0x9a, 0x60,
+	0x89, 0xe4, 0xc4, 0x0e, 0xb4, 0x9f, 0x48, 0x05, 0x64, 0xf6, 0xeb, 0x1b, 0xcc, 0xeb, 0x72, 0xba,
+	0x67, 0xf3, 0x5f, 0x7e, 0x0d, 0x00, 0x8f, 0xc1, 0xec, 0xbd, 0xa6, 0x01, 0x00, 0x00,
+}
+
+func (m *EmergencyBlock) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EmergencyBlock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EmergencyBlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AddedBy) > 0 {
+		i -= len(m.AddedBy)
+		copy(dAtA[i:], m.AddedBy)
+		i = encodeVarintEmergencyBlock(dAtA, i, uint64(len(m.AddedBy)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ExpiresAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExpiresAt):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintEmergencyBlock(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x12
+	if len(m.TypeUrl) > 0 {
+		i -= len(m.TypeUrl)
+		copy(dAtA[i:], m.TypeUrl)
+		i = encodeVarintEmergencyBlock(dAtA, i, uint64(len(m.TypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEmergencyBlock(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEmergencyBlock(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EmergencyBlock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TypeUrl)
+	if l > 0 {
+		n += 1 + l + sovEmergencyBlock(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExpiresAt)
+	n += 1 + l + sovEmergencyBlock(uint64(l))
+	l = len(m.AddedBy)
+	if l > 0 {
+		n += 1 + l + sovEmergencyBlock(uint64(l))
+	}
+	return n
+}
+
+func sovEmergencyBlock(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEmergencyBlock(x uint64) (n int) {
+	return sovEmergencyBlock(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EmergencyBlock) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEmergencyBlock
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EmergencyBlock: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EmergencyBlock: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmergencyBlock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEmergencyBlock
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEmergencyBlock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmergencyBlock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEmergencyBlock
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEmergencyBlock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ExpiresAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmergencyBlock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEmergencyBlock
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEmergencyBlock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AddedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEmergencyBlock(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEmergencyBlock
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipEmergencyBlock(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowEmergencyBlock
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEmergencyBlock
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEmergencyBlock
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthEmergencyBlock
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupEmergencyBlock
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthEmergencyBlock
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthEmergencyBlock        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowEmergencyBlock          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupEmergencyBlock = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,16 @@
+package types
+
+// DONTCOVER
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// x/msgfilter module sentinel errors.
+var (
+	ErrMessageBlocked         = errorsmod.Register(ModuleName, 1, "message is blocked")
+	ErrEmergencyBlockNotFound = errorsmod.Register(ModuleName, 2, "emergency block not found")
+	ErrInvalidTypeURL         = errorsmod.Register(ModuleName, 3, "invalid message type url")
+	ErrInvalidEmergencyBlock  = errorsmod.Register(ModuleName, 4, "invalid emergency block")
+	ErrUnblockableMsg         = errorsmod.Register(ModuleName, 5, "message may not be blocked")
+)
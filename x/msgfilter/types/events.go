@@ -0,0 +1,13 @@
+package types
+
+const (
+	EventTypeAddEmergencyBlock    = "add_emergency_block"
+	EventTypeRemoveEmergencyBlock = "remove_emergency_block"
+	EventTypeExpireEmergencyBlock = "expire_emergency_block"
+
+	AttributeKeyTypeURL   = "type_url"
+	AttributeKeyExpiresAt = "expires_at"
+	AttributeKeyAuthority = "authority"
+
+	AttributeValueCategory = ModuleName
+)
@@ -0,0 +1,42 @@
+package types
+
+import (
+	"fmt"
+)
+
+// NewGenesisState creates a new GenesisState object.
+func NewGenesisState(params Params, emergencyBlocks []EmergencyBlock) *GenesisState {
+	return &GenesisState{
+		Params:          params,
+		EmergencyBlocks: emergencyBlocks,
+	}
+}
+
+// DefaultGenesis returns the default msgfilter genesis state.
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{
+		Params: DefaultParams(),
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	seen := make(map[string]bool, len(gs.EmergencyBlocks))
+	for _, block := range gs.EmergencyBlocks {
+		if err := block.Validate(); err != nil {
+			return fmt.Errorf("invalid emergency block %s: %w", block.TypeUrl, err)
+		}
+
+		if seen[block.TypeUrl] {
+			return fmt.Errorf("duplicate emergency block %s", block.TypeUrl)
+		}
+		seen[block.TypeUrl] = true
+	}
+
+	return nil
+}
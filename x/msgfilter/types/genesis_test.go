@@ -0,0 +1,105 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Nolus-Protocol/nolus-core/app/params"
+	"github.com/Nolus-Protocol/nolus-core/x/msgfilter/types"
+)
+
+const msgSendURL = "/cosmos.bank.v1beta1.MsgSend"
+
+func TestGenesisState_Validate(t *testing.T) {
+	params.SetAddressPrefixes()
+	emergency := sdk.AccAddress("emergency___________").String()
+	block := types.NewEmergencyBlock(msgSendURL, time.Unix(1000, 0).UTC(), emergency)
+
+	for _, tc := range []struct {
+		desc     string
+		genState *types.GenesisState
+		valid    bool
+	}{
+		{
+			desc:     "default is valid",
+			genState: types.DefaultGenesis(),
+			valid:    true,
+		},
+		{
+			desc:     "valid genesis state",
+			genState: types.NewGenesisState(types.NewParams([]string{msgSendURL}, emergency, time.Hour), []types.EmergencyBlock{block}),
+			valid:    true,
+		},
+		{
+			desc:     "invalid blocked type url",
+			genState: types.NewGenesisState(types.NewParams([]string{"cosmos.bank.v1beta1.MsgSend"}, "", time.Hour), nil),
+			valid:    false,
+		},
+		{
+			desc:     "duplicate blocked type url",
+			genState: types.NewGenesisState(types.NewParams([]string{msgSendURL, msgSendURL}, "", time.Hour), nil),
+			valid:    false,
+		},
+		{
+			desc:     "blocked governance message",
+			genState: types.NewGenesisState(types.NewParams([]string{"/cosmos.gov.v1.MsgVote"}, "", time.Hour), nil),
+			valid:    false,
+		},
+		{
+			desc:     "blocked params update",
+			genState: types.NewGenesisState(types.NewParams([]string{"/nolus.msgfilter.v1beta1.MsgUpdateParams"}, "", time.Hour), nil),
+			valid:    false,
+		},
+		{
+			desc:     "invalid emergency address",
+			genState: types.NewGenesisState(types.NewParams(nil, "invalid_address", time.Hour), nil),
+			valid:    false,
+		},
+		{
+			desc:     "zero max emergency duration",
+			genState: types.NewGenesisState(types.NewParams(nil, "", 0), nil),
+			valid:    false,
+		},
+		{
+			desc:     "duplicate emergency block",
+			genState: types.NewGenesisState(types.DefaultParams(), []types.EmergencyBlock{block, block}),
+			valid:    false,
+		},
+		{
+			desc:     "emergency block without expiry",
+			genState: types.NewGenesisState(types.DefaultParams(), []types.EmergencyBlock{types.NewEmergencyBlock(msgSendURL, time.Time{}, emergency)}),
+			valid:    false,
+		},
+		{
+			desc:     "emergency block of a governance message",
+			genState: types.NewGenesisState(types.DefaultParams(), []types.EmergencyBlock{types.NewEmergencyBlock("/cosmos.gov.v1.MsgSubmitProposal", time.Unix(1000, 0), emergency)}),
+			valid:    false,
+		},
+		{
+			desc:     "emergency block with invalid added by",
+			genState: types.NewGenesisState(types.DefaultParams(), []types.EmergencyBlock{types.NewEmergencyBlock(msgSendURL, time.Unix(1000, 0), "")}),
+			valid:    false,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := tc.genState.Validate()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestEmergencyBlock_IsActive(t *testing.T) {
+	expiresAt := time.Unix(1000, 0)
+	block := types.NewEmergencyBlock(msgSendURL, expiresAt, "")
+
+	require.True(t, block.IsActive(expiresAt.Add(-time.Second)))
+	require.False(t, block.IsActive(expiresAt))
+	require.False(t, block.IsActive(expiresAt.Add(time.Second)))
+}
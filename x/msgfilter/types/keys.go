@@ -0,0 +1,35 @@
+package types
+
+var (
+	// ParamsKey is the key of the module params.
+	ParamsKey = []byte{0x00}
+
+	// EmergencyBlockKeyPrefix is the prefix of the emergency blocks, stored by message type URL.
+	EmergencyBlockKeyPrefix = []byte{0x01}
+)
+
+const (
+	// ModuleName defines the module name.
+	ModuleName = "msgfilter"
+
+	// StoreKey defines the primary module store key.
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for msgfilter.
+	RouterKey = ModuleName
+
+	// QuerierRoute defines the module's query routing key.
+	QuerierRoute = ModuleName
+
+	// MemStoreKey defines the in-memory store key.
+	MemStoreKey = "mem_msgfilter"
+)
+
+func KeyPrefix(p string) []byte {
+	return []byte(p)
+}
+
+// GetEmergencyBlockKey returns the store key of an emergency block.
+func GetEmergencyBlockKey(typeURL string) []byte {
+	return append(EmergencyBlockKeyPrefix, []byte(typeURL)...)
+}
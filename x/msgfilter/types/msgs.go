@@ -0,0 +1,99 @@
+package types
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	_ sdk.Msg = &MsgAddEmergencyBlock{}
+	_ sdk.Msg = &MsgRemoveEmergencyBlock{}
+	_ sdk.Msg = &MsgUpdateParams{}
+)
+
+// NewMsgAddEmergencyBlock returns a reference to a new MsgAddEmergencyBlock.
+func NewMsgAddEmergencyBlock(authority sdk.AccAddress, typeURL string, duration time.Duration) *MsgAddEmergencyBlock {
+	return &MsgAddEmergencyBlock{
+		Authority: authority.String(),
+		TypeUrl:   typeURL,
+		Duration:  duration,
+	}
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgAddEmergencyBlock) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgAddEmergencyBlock message.
+func (m *MsgAddEmergencyBlock) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgAddEmergencyBlock) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	if err := ValidateBlockableTypeURL(m.TypeUrl); err != nil {
+		return err
+	}
+
+	if m.Duration <= 0 {
+		return errorsmod.Wrap(ErrInvalidEmergencyBlock, "duration must be positive")
+	}
+
+	return nil
+}
+
+// NewMsgRemoveEmergencyBlock returns a reference to a new MsgRemoveEmergencyBlock.
+func NewMsgRemoveEmergencyBlock(authority sdk.AccAddress, typeURL string) *MsgRemoveEmergencyBlock {
+	return &MsgRemoveEmergencyBlock{
+		Authority: authority.String(),
+		TypeUrl:   typeURL,
+	}
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgRemoveEmergencyBlock) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgRemoveEmergencyBlock message.
+func (m *MsgRemoveEmergencyBlock) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgRemoveEmergencyBlock) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	return ValidateTypeURL(m.TypeUrl)
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgUpdateParams) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgUpdateParams message.
+func (m *MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	return m.Params.Validate()
+}
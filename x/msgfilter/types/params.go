@@ -0,0 +1,147 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"gopkg.in/yaml.v2"
+)
+
+// DefaultMaxEmergencyDuration is the default longest period the emergency address may block a message for.
+const DefaultMaxEmergencyDuration = 7 * 24 * time.Hour
+
+// unblockableMsgTypeURLs are the messages governance needs to pass a proposal
+// and to lift a block, so that a block can always be undone. authz MsgExec is
+// among them as validators may vote through grantees, while the messages it
+// executes are still filtered.
+var unblockableMsgTypeURLs = map[string]bool{
+	"/cosmos.gov.v1.MsgSubmitProposal":                 true,
+	"/cosmos.gov.v1.MsgVote":                           true,
+	"/cosmos.gov.v1.MsgVoteWeighted":                   true,
+	"/cosmos.gov.v1.MsgDeposit":                        true,
+	"/cosmos.gov.v1.MsgExecLegacyContent":              true,
+	"/cosmos.gov.v1beta1.MsgSubmitProposal":            true,
+	"/cosmos.gov.v1beta1.MsgVote":                      true,
+	"/cosmos.gov.v1beta1.MsgVoteWeighted":              true,
+	"/cosmos.gov.v1beta1.MsgDeposit":                   true,
+	"/cosmos.authz.v1beta1.MsgExec":                    true,
+	"/nolus.msgfilter.v1beta1.MsgRemoveEmergencyBlock": true,
+	"/nolus.msgfilter.v1beta1.MsgUpdateParams":         true,
+}
+
+// NewParams creates a new Params instance.
+func NewParams(blockedMsgTypeURLs []string, emergencyAddress string, maxEmergencyDuration time.Duration) Params {
+	return Params{
+		BlockedMsgTypeUrls:   blockedMsgTypeURLs,
+		EmergencyAddress:     emergencyAddress,
+		MaxEmergencyDuration: maxEmergencyDuration,
+	}
+}
+
+// DefaultParams returns default x/msgfilter module parameters, which block no
+// messages and leave the filter to governance.
+func DefaultParams() Params {
+	return Params{
+		BlockedMsgTypeUrls:   []string{},
+		EmergencyAddress:     "",
+		MaxEmergencyDuration: DefaultMaxEmergencyDuration,
+	}
+}
+
+// Validate validates the set of params.
+func (p Params) Validate() error {
+	if err := validateBlockedMsgTypeURLs(p.BlockedMsgTypeUrls); err != nil {
+		return err
+	}
+
+	if err := validateEmergencyAddress(p.EmergencyAddress); err != nil {
+		return err
+	}
+
+	if err := validateMaxEmergencyDuration(p.MaxEmergencyDuration); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String implements the Stringer interface.
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+// ValidateTypeURL checks that a message type URL is well-formed.
+func ValidateTypeURL(typeURL string) error {
+	if len(typeURL) < 2 || typeURL[0] != '/' {
+		return fmt.Errorf("%w: %q", ErrInvalidTypeURL, typeURL)
+	}
+
+	return nil
+}
+
+// ValidateBlockableTypeURL checks that a message type URL is well-formed and
+// is not one of the messages which may never be blocked.
+func ValidateBlockableTypeURL(typeURL string) error {
+	if err := ValidateTypeURL(typeURL); err != nil {
+		return err
+	}
+
+	if unblockableMsgTypeURLs[typeURL] {
+		return fmt.Errorf("%w: %s", ErrUnblockableMsg, typeURL)
+	}
+
+	return nil
+}
+
+func validateBlockedMsgTypeURLs(v interface{}) error {
+	typeURLs, ok := v.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	seen := make(map[string]bool, len(typeURLs))
+	for _, typeURL := range typeURLs {
+		if err := ValidateBlockableTypeURL(typeURL); err != nil {
+			return err
+		}
+
+		if seen[typeURL] {
+			return fmt.Errorf("duplicate blocked message type url %s", typeURL)
+		}
+		seen[typeURL] = true
+	}
+
+	return nil
+}
+
+func validateEmergencyAddress(v interface{}) error {
+	emergencyAddress, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if emergencyAddress == "" {
+		return nil
+	}
+
+	if _, err := sdk.AccAddressFromBech32(emergencyAddress); err != nil {
+		return fmt.Errorf("invalid emergency address %s: %w", emergencyAddress, err)
+	}
+
+	return nil
+}
+
+func validateMaxEmergencyDuration(v interface{}) error {
+	duration, ok := v.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if duration <= 0 {
+		return fmt.Errorf("max emergency duration must be positive")
+	}
+
+	return nil
+}
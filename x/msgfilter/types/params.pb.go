@@ -0,0 +1,447 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: nolus/msgfilter/v1beta1/params.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines the parameters for the module.
+type Params struct {
+	// blocked_msg_type_urls are the type URLs of the messages rejected by the
+	// ante handler until governance removes them.
+	BlockedMsgTypeUrls []string `protobuf:"bytes,1,rep,name=blocked_msg_type_urls,json=blockedMsgTypeUrls,proto3" json:"blocked_msg_type_urls,omitempty" yaml:"blocked_msg_type_urls"`
+	// emergency_address is the address, usually a multisig, which may block
+	// messages temporarily besides the governance authority. Messages may only
+	// be blocked through governance when it is empty.
+	EmergencyAddress string `protobuf:"bytes,2,opt,name=emergency_address,json=emergencyAddress,proto3" json:"emergency_address,omitempty" yaml:"emergency_address"`
+	// max_emergency_duration is the longest period the emergency address may
+	// block a message for.
+	MaxEmergencyDuration time.Duration `protobuf:"bytes,3,opt,name=max_emergency_duration,json=maxEmergencyDuration,proto3,stdduration" json:"max_emergency_duration" yaml:"max_emergency_duration"`
+}
+
+func (m *Params) Reset()      { *m = Params{} }
+func (*Params) ProtoMessage() {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0378922b6ccad602, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetBlockedMsgTypeUrls() []string {
+	if m != nil {
+		return m.BlockedMsgTypeUrls
+	}
+	return nil
+}
+
+func (m *Params) GetEmergencyAddress() string {
+	if m != nil {
+		return m.EmergencyAddress
+	}
+	return ""
+}
+
+func (m *Params) GetMaxEmergencyDuration() time.Duration {
+	if m != nil {
+		return m.MaxEmergencyDuration
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "nolus.msgfilter.v1beta1.Params")
+}
+
+func init() {
+	proto.RegisterFile("nolus/msgfilter/v1beta1/params.proto", fileDescriptor_0378922b6ccad602)
+}
+
+var fileDescriptor_0378922b6ccad602 = []byte{
+	// 359 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0xb1, 0x4e, 0xeb, 0x30,
+	0x18, 0x85, 0x93, 0xf6, 0xaa, 0x52, 0x73, 0x97, 0x7b, 0xa3, 0x02, 0xa1, 0x2a, 0x49, 0x14, 0x31,
+	0x94, 0xa1, 0xb1, 0x0a, 0x12, 0x43, 0x37, 0x22, 0x18, 0x18, 0x40, 0x55, 0x81, 0x85, 0x25, 0x72,
+	0x12, 0xd7, 0x54, 0xd8, 0x75, 0x64, 0x27, 0xa8, 0xe1, 0x29, 0x18, 0x3b, 0x32, 0xf3, 0x24, 0x1d,
+	0x3b, 0x32, 0x05, 0xd4, 0xbe, 0x41, 0x9f, 0x00, 0x25, 0x69, 0x0a, 0x12, 0xdd, 0xec, 0xf3, 0x7f,
+	0x3a, 0xfe, 0x8f, 0x8f, 0x72, 0x38, 0x66, 0x24, 0x16, 0x80, 0x0a, 0x3c, 0x1c, 0x91, 0x08, 0x71,
+	0xf0, 0xd4, 0xf5, 0x50, 0x04, 0xbb, 0x20, 0x84, 0x1c, 0x52, 0x61, 0x87, 0x9c, 0x45, 0x4c, 0xdd,
+	0xcb, 0x29, 0x7b, 0x43, 0xd9, 0x6b, 0xaa, 0xd9, 0xc0, 0x0c, 0xb3, 0x9c, 0x01, 0xd9, 0xa9, 0xc0,
+	0x9b, 0x3a, 0x66, 0x0c, 0x13, 0x04, 0xf2, 0x9b, 0x17, 0x0f, 0x41, 0x10, 0x73, 0x18, 0x8d, 0xd8,
+	0xb8, 0x98, 0x5b, 0x6f, 0x15, 0xa5, 0xd6, 0xcf, 0xfd, 0xd5, 0x1b, 0x65, 0xc7, 0x23, 0xcc, 0x7f,
+	0x44, 0x81, 0x4b, 0x05, 0x76, 0xa3, 0x24, 0x44, 0x6e, 0xcc, 0x89, 0xd0, 0x64, 0xb3, 0xda, 0xae,
+	0x3b, 0xe6, 0x2a, 0x35, 0x5a, 0x09, 0xa4, 0xa4, 0x67, 0x6d, 0xc5, 0xac, 0x81, 0xba, 0xd6, 0xaf,
+	0x04, 0xbe, 0x4d, 0x42, 0x74, 0xc7, 0x89, 0x50, 0x2f, 0x95, 0xff, 0x88, 0x22, 0x8e, 0xd1, 0xd8,
+	0x4f, 0x5c, 0x18, 0x04, 0x1c, 0x09, 0xa1, 0x55, 0x4c, 0xb9, 0x5d, 0x77, 0x5a, 0xab, 0xd4, 0xd0,
+	0x0a, 0xc3, 0x5f, 0x88, 0x35, 0xf8, 0xb7, 0xd1, 0xce, 0x0a, 0x49, 0x7d, 0x56, 0x76, 0x29, 0x9c,
+	0xb8, 0xdf, 0x6c, 0x19, 0x45, 0xab, 0x9a, 0x72, 0xfb, 0xef, 0xf1, 0xbe, 0x5d, 0x64, 0xb5, 0xcb,
+	0xac, 0xf6, 0xf9, 0x1a, 0x70, 0x8e, 0x66, 0xa9, 0x21, 0xad, 0x52, 0xe3, 0xa0, 0x78, 0x6e, 0xbb,
+	0x8d, 0x35, 0xfd, 0x30, 0xe4, 0x41, 0x83, 0xc2, 0xc9, 0x45, 0x39, 0x2b, 0x0d, 0x7a, 0x7f, 0xa6,
+	0xaf, 0x86, 0xe4, 0xf4, 0x67, 0x0b, 0x5d, 0x9e, 0x2f, 0x74, 0xf9, 0x73, 0xa1, 0xcb, 0x2f, 0x4b,
+	0x5d, 0x9a, 0x2f, 0x75, 0xe9, 0x7d, 0xa9, 0x4b, 0xf7, 0xa7, 0x78, 0x14, 0x3d, 0xc4, 0x9e, 0xed,
+	0x33, 0x0a, 0xae, 0xb3, 0x82, 0x3a, 0xfd, 0x6c, 0x09, 0x9f, 0x11, 0x90, 0xf7, 0xd5, 0xf1, 0x19,
+	0x47, 0x60, 0xf2, 0xa3, 0xdc, 0xec, 0xdf, 0x84, 0x57, 0xcb, 0x77, 0x3d, 0xf9, 0x1a, 0x00, 0xeb,
+	0x1e, 0x69, 0x97, 0xfc, 0x01, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MaxEmergencyDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxEmergencyDuration):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintParams(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.EmergencyAddress) > 0 {
+		i -= len(m.EmergencyAddress)
+		copy(dAtA[i:], m.EmergencyAddress)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.EmergencyAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BlockedMsgTypeUrls) > 0 {
+		for iNdEx := len(m.BlockedMsgTypeUrls) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.BlockedMsgTypeUrls[iNdEx])
+			copy(dAtA[i:], m.BlockedMsgTypeUrls[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.BlockedMsgTypeUrls[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.BlockedMsgTypeUrls) > 0 {
+		for _, s := range m.BlockedMsgTypeUrls {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	l = len(m.EmergencyAddress)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxEmergencyDuration)
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockedMsgTypeUrls", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockedMsgTypeUrls = append(m.BlockedMsgTypeUrls, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmergencyAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EmergencyAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxEmergencyDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.MaxEmergencyDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipParams(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthParams
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupParams
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthParams
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthParams        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowParams          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupParams = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,25 @@
+package ratelimit
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Nolus-Protocol/nolus-core/x/ratelimit/keeper"
+	"github.com/Nolus-Protocol/nolus-core/x/ratelimit/types"
+)
+
+// InitGenesis initializes the ratelimit module's state from a provided genesis
+// state.
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState) {
+	for _, rateLimit := range genState.RateLimits {
+		k.SetRateLimit(ctx, rateLimit)
+	}
+
+	for _, packet := range genState.PendingSendPackets {
+		k.SetPendingSendPacket(ctx, packet)
+	}
+}
+
+// ExportGenesis returns the ratelimit module's exported genesis.
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	return types.NewGenesisState(k.GetAllRateLimits(ctx), k.GetAllPendingSendPackets(ctx))
+}
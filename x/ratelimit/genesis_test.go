@@ -0,0 +1,46 @@
+package ratelimit_test
+
+import (
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Nolus-Protocol/nolus-core/app/params"
+	simulationapp "github.com/Nolus-Protocol/nolus-core/testutil/simapp"
+	"github.com/Nolus-Protocol/nolus-core/x/ratelimit"
+	"github.com/Nolus-Protocol/nolus-core/x/ratelimit/types"
+)
+
+func TestGenesis(t *testing.T) {
+	_ = params.SetAddressPrefixes()
+	app, err := simulationapp.TestSetup(t)
+	require.NoError(t, err)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{}).WithBlockTime(time.Now())
+
+	quota := types.NewQuota(sdkmath.LegacyNewDec(10), sdkmath.LegacyNewDec(5), time.Hour)
+	windowStart := time.Unix(1000, 0).UTC()
+	rateLimit := types.NewRateLimit(types.NewPath("unls", "channel-0"), quota, sdkmath.NewInt(1000), windowStart)
+	require.NoError(t, rateLimit.AddOutflow(sdkmath.NewInt(50)))
+
+	genesisState := types.GenesisState{
+		RateLimits: []types.RateLimit{
+			rateLimit,
+			types.NewRateLimit(types.NewPath("unls", "channel-1"), quota, sdkmath.NewInt(1000), windowStart),
+		},
+		PendingSendPackets: []types.PendingSendPacket{
+			{ChannelId: "channel-0", Sequence: 2, Denom: "unls"},
+			{ChannelId: "channel-0", Sequence: 10, Denom: "unls"},
+		},
+	}
+	require.NoError(t, genesisState.Validate())
+
+	ratelimit.InitGenesis(ctx, *app.RateLimitKeeper, genesisState)
+
+	got := ratelimit.ExportGenesis(ctx, *app.RateLimitKeeper)
+	require.NotNil(t, got)
+	require.Equal(t, genesisState.RateLimits, got.RateLimits)
+	require.Equal(t, genesisState.PendingSendPackets, got.PendingSendPackets)
+}
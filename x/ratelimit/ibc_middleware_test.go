@@ -0,0 +1,178 @@
+package ratelimit_test
+
+import (
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v7/testing"
+	"github.com/stretchr/testify/suite"
+
+	nolusapp "github.com/Nolus-Protocol/nolus-core/app"
+	"github.com/Nolus-Protocol/nolus-core/app/params"
+	"github.com/Nolus-Protocol/nolus-core/testutil/simapp"
+	"github.com/Nolus-Protocol/nolus-core/x/ratelimit/types"
+)
+
+const window = time.Hour
+
+type MiddlewareTestSuite struct {
+	suite.Suite
+
+	coordinator *ibctesting.Coordinator
+	chainA      *ibctesting.TestChain
+	chainB      *ibctesting.TestChain
+	path        *ibctesting.Path
+}
+
+// SetupTest opens a transfer channel between two in-process nolus chains.
+func (s *MiddlewareTestSuite) SetupTest() {
+	_ = params.SetAddressPrefixes()
+	ibctesting.DefaultTestingAppInit = simapp.SetupTestingApp
+
+	s.coordinator = ibctesting.NewCoordinator(s.T(), 2)
+	s.chainA = s.coordinator.GetChain(ibctesting.GetChainID(1))
+	s.chainB = s.coordinator.GetChain(ibctesting.GetChainID(2))
+
+	s.path = ibctesting.NewPath(s.chainA, s.chainB)
+	s.path.EndpointA.ChannelConfig.PortID = ibctesting.TransferPort
+	s.path.EndpointB.ChannelConfig.PortID = ibctesting.TransferPort
+	s.path.EndpointA.ChannelConfig.Version = transfertypes.Version
+	s.path.EndpointB.ChannelConfig.Version = transfertypes.Version
+	s.coordinator.Setup(s.path)
+}
+
+func nolus(chain *ibctesting.TestChain) *nolusapp.App {
+	return chain.App.(*nolusapp.App)
+}
+
+// addRateLimit limits the flow of a denom over the channel of the endpoint and returns the
+// largest amount of the denom which may flow in each direction.
+func (s *MiddlewareTestSuite) addRateLimit(endpoint *ibctesting.Endpoint, denom string, maxPercent int64) sdkmath.Int {
+	chain := endpoint.Chain
+	quota := types.NewQuota(sdkmath.LegacyNewDec(maxPercent), sdkmath.LegacyNewDec(maxPercent), window)
+	s.Require().NoError(nolus(chain).RateLimitKeeper.AddRateLimit(chain.GetContext(), types.NewPath(denom, endpoint.ChannelID), quota))
+	s.coordinator.CommitBlock(chain)
+
+	return s.rateLimit(endpoint, denom).Flow.ChannelValue.MulRaw(maxPercent).QuoRaw(100)
+}
+
+func (s *MiddlewareTestSuite) rateLimit(endpoint *ibctesting.Endpoint, denom string) types.RateLimit {
+	rateLimit, found := nolus(endpoint.Chain).RateLimitKeeper.GetRateLimit(endpoint.Chain.GetContext(), endpoint.ChannelID, denom)
+	s.Require().True(found)
+
+	return rateLimit
+}
+
+func (s *MiddlewareTestSuite) balance(chain *ibctesting.TestChain, denom string) sdkmath.Int {
+	return nolus(chain).BankKeeper.GetBalance(chain.GetContext(), chain.SenderAccount.GetAddress(), denom).Amount
+}
+
+// transferMsg returns a transfer from the sender of the chain of the endpoint to the sender of
+// the counterparty chain.
+func (s *MiddlewareTestSuite) transferMsg(endpoint *ibctesting.Endpoint, coin sdk.Coin, timeoutHeight clienttypes.Height) *transfertypes.MsgTransfer {
+	return transfertypes.NewMsgTransfer(endpoint.ChannelConfig.PortID, endpoint.ChannelID, coin,
+		endpoint.Chain.SenderAccount.GetAddress().String(), endpoint.Counterparty.Chain.SenderAccount.GetAddress().String(),
+		timeoutHeight, 0, "")
+}
+
+// transfer sends the coin over the endpoint and returns the packet.
+func (s *MiddlewareTestSuite) transfer(endpoint *ibctesting.Endpoint, coin sdk.Coin, timeoutHeight clienttypes.Height) channeltypes.Packet {
+	res, err := endpoint.Chain.SendMsgs(s.transferMsg(endpoint, coin, timeoutHeight))
+	s.Require().NoError(err)
+
+	packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	s.Require().NoError(err)
+
+	return packet
+}
+
+func (s *MiddlewareTestSuite) TestSendRateLimit() {
+	quota := s.addRateLimit(s.path.EndpointA, sdk.DefaultBondDenom, 1)
+
+	packet := s.transfer(s.path.EndpointA, sdk.NewCoin(sdk.DefaultBondDenom, quota), clienttypes.NewHeight(1, 110))
+	s.Require().NoError(s.path.RelayPacket(packet))
+	s.Require().Equal(quota, s.rateLimit(s.path.EndpointA, sdk.DefaultBondDenom).Flow.Outflow)
+
+	// the send exceeding the quota fails, the cached context stands for the reverted tx
+	ctx, _ := s.chainA.GetContext().CacheContext()
+	msg := s.transferMsg(s.path.EndpointA, sdk.NewInt64Coin(sdk.DefaultBondDenom, 1), clienttypes.NewHeight(1, 110))
+	_, err := nolus(s.chainA).TransferKeeper.Keeper.Transfer(sdk.WrapSDKContext(ctx), msg)
+	s.Require().ErrorIs(err, types.ErrRateLimitExceeded)
+
+	// the returning tokens make room for the outflow
+	voucher := transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom(s.path.EndpointB.ChannelConfig.PortID, s.path.EndpointB.ChannelID, sdk.DefaultBondDenom)).IBCDenom()
+	packet = s.transfer(s.path.EndpointB, sdk.NewInt64Coin(voucher, 10), clienttypes.NewHeight(1, 110))
+	s.Require().NoError(s.path.RelayPacket(packet))
+	s.Require().Equal(sdkmath.NewInt(10), s.rateLimit(s.path.EndpointA, sdk.DefaultBondDenom).Flow.Inflow)
+
+	packet = s.transfer(s.path.EndpointA, sdk.NewInt64Coin(sdk.DefaultBondDenom, 10), clienttypes.NewHeight(1, 110))
+	s.Require().NoError(s.path.RelayPacket(packet))
+	s.Require().Empty(nolus(s.chainA).RateLimitKeeper.GetAllPendingSendPackets(s.chainA.GetContext()))
+
+	// the flow is reset once the window ends
+	s.coordinator.IncrementTimeBy(window)
+	s.coordinator.CommitBlock(s.chainA)
+	rateLimit := s.rateLimit(s.path.EndpointA, sdk.DefaultBondDenom)
+	s.Require().True(rateLimit.Flow.Outflow.IsZero())
+	s.Require().True(rateLimit.Flow.Inflow.IsZero())
+}
+
+func (s *MiddlewareTestSuite) TestRecvRateLimit() {
+	voucher := transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom(s.path.EndpointB.ChannelConfig.PortID, s.path.EndpointB.ChannelID, sdk.DefaultBondDenom)).IBCDenom()
+
+	// the vouchers need a supply to be limited
+	packet := s.transfer(s.path.EndpointA, sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000), clienttypes.NewHeight(1, 110))
+	s.Require().NoError(s.path.RelayPacket(packet))
+	quota := s.addRateLimit(s.path.EndpointB, voucher, 10)
+	s.Require().Equal(sdkmath.NewInt(100), quota)
+
+	packet = s.transfer(s.path.EndpointA, sdk.NewCoin(sdk.DefaultBondDenom, quota), clienttypes.NewHeight(1, 110))
+	s.Require().NoError(s.path.RelayPacket(packet))
+	s.Require().Equal(quota, s.rateLimit(s.path.EndpointB, voucher).Flow.Inflow)
+	s.Require().Equal(sdkmath.NewInt(1100), s.balance(s.chainB, voucher))
+
+	// the packet exceeding the quota is acknowledged with an error and refunded
+	balance := s.balance(s.chainA, sdk.DefaultBondDenom)
+	packet = s.transfer(s.path.EndpointA, sdk.NewInt64Coin(sdk.DefaultBondDenom, 1), clienttypes.NewHeight(1, 110))
+	s.Require().NoError(s.path.RelayPacket(packet))
+	s.Require().Equal(quota, s.rateLimit(s.path.EndpointB, voucher).Flow.Inflow)
+	s.Require().Equal(sdkmath.NewInt(1100), s.balance(s.chainB, voucher))
+	s.Require().Equal(balance, s.balance(s.chainA, sdk.DefaultBondDenom))
+}
+
+func (s *MiddlewareTestSuite) TestFailedSendUndoesOutflow() {
+	s.addRateLimit(s.path.EndpointA, sdk.DefaultBondDenom, 1)
+	voucher := transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom(s.path.EndpointB.ChannelConfig.PortID, s.path.EndpointB.ChannelID, sdk.DefaultBondDenom)).IBCDenom()
+
+	// the packet fails on the counterparty, which blocks the inflow of the vouchers
+	packet := s.transfer(s.path.EndpointA, sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000), clienttypes.NewHeight(1, 110))
+	s.Require().NoError(s.path.RelayPacket(packet))
+	quota := types.NewQuota(sdkmath.LegacyNewDec(100), sdkmath.LegacyZeroDec(), window)
+	s.Require().NoError(nolus(s.chainB).RateLimitKeeper.AddRateLimit(s.chainB.GetContext(), types.NewPath(voucher, s.path.EndpointB.ChannelID), quota))
+	s.coordinator.CommitBlock(s.chainB)
+
+	packet = s.transfer(s.path.EndpointA, sdk.NewInt64Coin(sdk.DefaultBondDenom, 10), clienttypes.NewHeight(1, 110))
+	s.Require().Equal(sdkmath.NewInt(1010), s.rateLimit(s.path.EndpointA, sdk.DefaultBondDenom).Flow.Outflow)
+	s.Require().NoError(s.path.RelayPacket(packet))
+	s.Require().Equal(sdkmath.NewInt(1000), s.rateLimit(s.path.EndpointA, sdk.DefaultBondDenom).Flow.Outflow)
+
+	// the packet times out
+	timeoutHeight := clienttypes.GetSelfHeight(s.chainB.GetContext())
+	packet = s.transfer(s.path.EndpointA, sdk.NewInt64Coin(sdk.DefaultBondDenom, 20), timeoutHeight)
+	s.Require().Equal(sdkmath.NewInt(1020), s.rateLimit(s.path.EndpointA, sdk.DefaultBondDenom).Flow.Outflow)
+
+	s.coordinator.CommitNBlocks(s.chainB, 2)
+	s.Require().NoError(s.path.EndpointA.UpdateClient())
+	s.Require().NoError(s.path.EndpointA.TimeoutPacket(packet))
+	s.Require().Equal(sdkmath.NewInt(1000), s.rateLimit(s.path.EndpointA, sdk.DefaultBondDenom).Flow.Outflow)
+	s.Require().Empty(nolus(s.chainA).RateLimitKeeper.GetAllPendingSendPackets(s.chainA.GetContext()))
+}
+
+func TestMiddlewareTestSuite(t *testing.T) {
+	suite.Run(t, new(MiddlewareTestSuite))
+}
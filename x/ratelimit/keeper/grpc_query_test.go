@@ -0,0 +1,44 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Nolus-Protocol/nolus-core/x/ratelimit/types"
+)
+
+func (s *KeeperTestSuite) TestQueryRateLimits() {
+	s.mint("uatom", 1000)
+	for _, d := range []string{denom, "uatom"} {
+		s.Require().NoError(s.keeper.AddRateLimit(s.ctx, types.NewPath(d, channelID), quota()))
+	}
+	ctx := sdk.WrapSDKContext(s.ctx)
+
+	_, err := s.keeper.RateLimits(ctx, nil)
+	s.Require().Equal(codes.InvalidArgument, status.Code(err))
+
+	page, err := s.keeper.RateLimits(ctx, &types.QueryRateLimitsRequest{Pagination: &query.PageRequest{Limit: 1, CountTotal: true}})
+	s.Require().NoError(err)
+	s.Require().Len(page.RateLimits, 1)
+	s.Require().Equal(uint64(2), page.Pagination.Total)
+	s.Require().Equal("uatom", page.RateLimits[0].Path.Denom)
+
+	next, err := s.keeper.RateLimits(ctx, &types.QueryRateLimitsRequest{Pagination: &query.PageRequest{Key: page.Pagination.NextKey}})
+	s.Require().NoError(err)
+	s.Require().Len(next.RateLimits, 1)
+	s.Require().Equal(denom, next.RateLimits[0].Path.Denom)
+}
+
+func (s *KeeperTestSuite) TestQueryRateLimit() {
+	s.Require().NoError(s.keeper.AddRateLimit(s.ctx, types.NewPath(denom, channelID), quota()))
+	ctx := sdk.WrapSDKContext(s.ctx)
+
+	res, err := s.keeper.RateLimit(ctx, &types.QueryRateLimitRequest{ChannelId: channelID, Denom: denom})
+	s.Require().NoError(err)
+	s.Require().Equal(types.NewPath(denom, channelID), res.RateLimit.Path)
+
+	_, err = s.keeper.RateLimit(ctx, &types.QueryRateLimitRequest{ChannelId: channelID, Denom: "uatom"})
+	s.Require().Equal(codes.NotFound, status.Code(err))
+}
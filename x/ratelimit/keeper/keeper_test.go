@@ -0,0 +1,73 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	"github.com/stretchr/testify/suite"
+
+	nolusapp "github.com/Nolus-Protocol/nolus-core/app"
+	"github.com/Nolus-Protocol/nolus-core/app/params"
+	simulationapp "github.com/Nolus-Protocol/nolus-core/testutil/simapp"
+	minttypes "github.com/Nolus-Protocol/nolus-core/x/mint/types"
+	"github.com/Nolus-Protocol/nolus-core/x/ratelimit/keeper"
+	"github.com/Nolus-Protocol/nolus-core/x/ratelimit/types"
+)
+
+const (
+	denom     = "unls"
+	channelID = "channel-0"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+	ctx       sdk.Context
+	app       *nolusapp.App
+	keeper    keeper.Keeper
+	msgServer types.MsgServer
+	authority string
+}
+
+// SetupTest setups a new test, with an open transfer channel and a supply of 1000unls.
+func (s *KeeperTestSuite) SetupTest() {
+	var err error
+	_ = params.SetAddressPrefixes()
+	s.app, err = simulationapp.TestSetup(s.T())
+	s.Require().NoError(err)
+
+	header := tmproto.Header{Height: s.app.LastBlockHeight() + 1}
+	s.ctx = s.app.BaseApp.NewContext(false, header).WithBlockTime(time.Unix(1_000_000, 0).UTC())
+
+	s.keeper = *s.app.RateLimitKeeper
+	s.msgServer = keeper.NewMsgServerImpl(s.keeper)
+	s.authority = s.keeper.GetAuthority()
+
+	s.app.IBCKeeper.ChannelKeeper.SetChannel(s.ctx, transfertypes.PortID, channelID, channeltypes.NewChannel(
+		channeltypes.OPEN, channeltypes.UNORDERED, channeltypes.NewCounterparty(transfertypes.PortID, channelID), []string{"connection-0"}, transfertypes.Version,
+	))
+	s.mint(denom, 1000)
+}
+
+// mint increases the supply of a denom.
+func (s *KeeperTestSuite) mint(denom string, amount int64) {
+	s.Require().NoError(s.app.BankKeeper.MintCoins(s.ctx, minttypes.ModuleName, sdk.NewCoins(sdk.NewInt64Coin(denom, amount))))
+}
+
+// quota returns a quota of 10% in each direction over an hour.
+func quota() types.Quota {
+	return types.NewQuota(sdkmath.LegacyNewDec(10), sdkmath.LegacyNewDec(10), time.Hour)
+}
+
+// passTime moves the block time of the context forward.
+func (s *KeeperTestSuite) passTime(d time.Duration) {
+	s.ctx = s.ctx.WithBlockTime(s.ctx.BlockTime().Add(d))
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
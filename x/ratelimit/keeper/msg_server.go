@@ -0,0 +1,115 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/Nolus-Protocol/nolus-core/x/ratelimit/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+var _ types.MsgServer = msgServer{}
+
+// AddRateLimit limits the flow of a denom over a transfer channel.
+func (k msgServer) AddRateLimit(goCtx context.Context, req *types.MsgAddRateLimit) (*types.MsgAddRateLimitResponse, error) {
+	if err := k.validateAuthority(req); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.Keeper.AddRateLimit(ctx, req.Path(), req.Quota()); err != nil {
+		return nil, err
+	}
+
+	emitRateLimitEvent(ctx, types.EventTypeAddRateLimit, req.Path(), req.Authority)
+
+	return &types.MsgAddRateLimitResponse{}, nil
+}
+
+// UpdateRateLimit replaces the quota of a rate limit and resets its flow.
+func (k msgServer) UpdateRateLimit(goCtx context.Context, req *types.MsgUpdateRateLimit) (*types.MsgUpdateRateLimitResponse, error) {
+	if err := k.validateAuthority(req); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.Keeper.UpdateRateLimit(ctx, req.Path(), req.Quota()); err != nil {
+		return nil, err
+	}
+
+	emitRateLimitEvent(ctx, types.EventTypeUpdateRateLimit, req.Path(), req.Authority)
+
+	return &types.MsgUpdateRateLimitResponse{}, nil
+}
+
+// RemoveRateLimit lifts the rate limit of a denom over a channel.
+func (k msgServer) RemoveRateLimit(goCtx context.Context, req *types.MsgRemoveRateLimit) (*types.MsgRemoveRateLimitResponse, error) {
+	if err := k.validateAuthority(req); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.Keeper.RemoveRateLimit(ctx, req.Path()); err != nil {
+		return nil, err
+	}
+
+	emitRateLimitEvent(ctx, types.EventTypeRemoveRateLimit, req.Path(), req.Authority)
+
+	return &types.MsgRemoveRateLimitResponse{}, nil
+}
+
+// ResetRateLimit resets the flow of a rate limit and starts a new window.
+func (k msgServer) ResetRateLimit(goCtx context.Context, req *types.MsgResetRateLimit) (*types.MsgResetRateLimitResponse, error) {
+	if err := k.validateAuthority(req); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.Keeper.ResetRateLimit(ctx, req.Path()); err != nil {
+		return nil, err
+	}
+
+	emitRateLimitEvent(ctx, types.EventTypeResetRateLimit, req.Path(), req.Authority)
+
+	return &types.MsgResetRateLimitResponse{}, nil
+}
+
+// validateAuthority checks the message and that it is signed by the governance authority.
+func (k msgServer) validateAuthority(msg interface {
+	ValidateBasic() error
+	GetAuthority() string
+},
+) error {
+	if err := msg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if k.authority != msg.GetAuthority() {
+		return errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.GetAuthority())
+	}
+
+	return nil
+}
+
+func emitRateLimitEvent(ctx sdk.Context, eventType string, path types.Path, authority string) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			eventType,
+			sdk.NewAttribute(types.AttributeKeyDenom, path.Denom),
+			sdk.NewAttribute(types.AttributeKeyChannelID, path.ChannelId),
+			sdk.NewAttribute(types.AttributeKeyAuthority, authority),
+		),
+	)
+}
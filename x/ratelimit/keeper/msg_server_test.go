@@ -0,0 +1,52 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/Nolus-Protocol/nolus-core/x/ratelimit/types"
+)
+
+func (s *KeeperTestSuite) TestMsgRateLimits() {
+	authority := sdk.MustAccAddressFromBech32(s.authority)
+	other := sdk.AccAddress("other_______________")
+	path := types.NewPath(denom, channelID)
+	s.ctx = s.ctx.WithEventManager(sdk.NewEventManager())
+	ctx := sdk.WrapSDKContext(s.ctx)
+
+	_, err := s.msgServer.AddRateLimit(ctx, types.NewMsgAddRateLimit(other, path, quota()))
+	s.Require().ErrorIs(err, govtypes.ErrInvalidSigner)
+	_, err = s.msgServer.AddRateLimit(ctx, types.NewMsgAddRateLimit(authority, path, types.NewQuota(sdkmath.LegacyZeroDec(), sdkmath.LegacyZeroDec(), 0)))
+	s.Require().ErrorIs(err, types.ErrInvalidRateLimit)
+	_, err = s.msgServer.AddRateLimit(ctx, types.NewMsgAddRateLimit(authority, path, quota()))
+	s.Require().NoError(err)
+
+	_, err = s.msgServer.UpdateRateLimit(ctx, types.NewMsgUpdateRateLimit(other, path, quota()))
+	s.Require().ErrorIs(err, govtypes.ErrInvalidSigner)
+	_, err = s.msgServer.UpdateRateLimit(ctx, types.NewMsgUpdateRateLimit(authority, path, quota()))
+	s.Require().NoError(err)
+
+	_, err = s.msgServer.ResetRateLimit(ctx, types.NewMsgResetRateLimit(other, path))
+	s.Require().ErrorIs(err, govtypes.ErrInvalidSigner)
+	_, err = s.msgServer.ResetRateLimit(ctx, types.NewMsgResetRateLimit(authority, path))
+	s.Require().NoError(err)
+
+	_, err = s.msgServer.RemoveRateLimit(ctx, types.NewMsgRemoveRateLimit(other, path))
+	s.Require().ErrorIs(err, govtypes.ErrInvalidSigner)
+	_, err = s.msgServer.RemoveRateLimit(ctx, types.NewMsgRemoveRateLimit(authority, path))
+	s.Require().NoError(err)
+	_, err = s.msgServer.RemoveRateLimit(ctx, types.NewMsgRemoveRateLimit(authority, path))
+	s.Require().ErrorIs(err, types.ErrRateLimitNotFound)
+
+	var eventTypes []string
+	for _, event := range s.ctx.EventManager().Events() {
+		eventTypes = append(eventTypes, event.Type)
+	}
+	s.Require().Equal([]string{
+		types.EventTypeAddRateLimit,
+		types.EventTypeUpdateRateLimit,
+		types.EventTypeResetRateLimit,
+		types.EventTypeRemoveRateLimit,
+	}, eventTypes)
+}
@@ -0,0 +1,191 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v7/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v7/modules/core/exported"
+
+	"github.com/Nolus-Protocol/nolus-core/x/ratelimit/types"
+)
+
+var _ porttypes.ICS4Wrapper = Keeper{}
+
+// SendPacket adds the amount of a transfer packet to the outflow of its path before passing
+// the packet to the channel. The packet is rejected if the net outflow exceeds the quota.
+func (k Keeper) SendPacket(
+	ctx sdk.Context,
+	chanCap *capabilitytypes.Capability,
+	sourcePort string,
+	sourceChannel string,
+	timeoutHeight clienttypes.Height,
+	timeoutTimestamp uint64,
+	data []byte,
+) (uint64, error) {
+	var packetData transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(data, &packetData); err != nil {
+		return k.ics4Wrapper.SendPacket(ctx, chanCap, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, data)
+	}
+
+	// the denom of the packet is the trace of the local denom
+	denom := transfertypes.ParseDenomTrace(packetData.Denom).IBCDenom()
+	rateLimit, found := k.GetRateLimit(ctx, sourceChannel, denom)
+	if !found {
+		return k.ics4Wrapper.SendPacket(ctx, chanCap, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, data)
+	}
+
+	amount, err := parseAmount(packetData.Amount)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := rateLimit.AddOutflow(amount); err != nil {
+		emitRateLimitExceeded(ctx, rateLimit, types.DirectionSend, amount)
+		return 0, err
+	}
+
+	sequence, err := k.ics4Wrapper.SendPacket(ctx, chanCap, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, data)
+	if err != nil {
+		return 0, err
+	}
+
+	k.SetRateLimit(ctx, rateLimit)
+	k.SetPendingSendPacket(ctx, types.PendingSendPacket{
+		ChannelId: sourceChannel,
+		Sequence:  sequence,
+		Denom:     denom,
+	})
+
+	return sequence, nil
+}
+
+// WriteAcknowledgement passes the acknowledgement to the channel.
+func (k Keeper) WriteAcknowledgement(
+	ctx sdk.Context,
+	chanCap *capabilitytypes.Capability,
+	packet ibcexported.PacketI,
+	ack ibcexported.Acknowledgement,
+) error {
+	return k.ics4Wrapper.WriteAcknowledgement(ctx, chanCap, packet, ack)
+}
+
+// GetAppVersion returns the application version of the channel.
+func (k Keeper) GetAppVersion(ctx sdk.Context, portID, channelID string) (string, bool) {
+	return k.ics4Wrapper.GetAppVersion(ctx, portID, channelID)
+}
+
+// ReceivePacket adds the amount of a received transfer packet to the inflow of its path.
+// It fails if the net inflow exceeds the quota.
+func (k Keeper) ReceivePacket(ctx sdk.Context, packet channeltypes.Packet) error {
+	var packetData transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &packetData); err != nil {
+		// the transfer application rejects the packet
+		return nil
+	}
+
+	denom := receivedDenom(packet, packetData.Denom)
+	rateLimit, found := k.GetRateLimit(ctx, packet.GetDestChannel(), denom)
+	if !found {
+		return nil
+	}
+
+	amount, err := parseAmount(packetData.Amount)
+	if err != nil {
+		return err
+	}
+
+	if err := rateLimit.AddInflow(amount); err != nil {
+		emitRateLimitExceeded(ctx, rateLimit, types.DirectionRecv, amount)
+		return err
+	}
+
+	k.SetRateLimit(ctx, rateLimit)
+	return nil
+}
+
+// AcknowledgeSendPacket undoes the outflow of a packet sent in the current window of its path
+// if the counterparty failed to receive it.
+func (k Keeper) AcknowledgeSendPacket(ctx sdk.Context, packet channeltypes.Packet, acknowledgement []byte) error {
+	var ack channeltypes.Acknowledgement
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal ICS-20 transfer packet acknowledgement: %v", err)
+	}
+
+	if ack.Success() {
+		k.RemovePendingSendPacket(ctx, packet.GetSourceChannel(), packet.GetSequence())
+		return nil
+	}
+
+	return k.UndoSendPacket(ctx, packet)
+}
+
+// UndoSendPacket undoes the outflow of a packet which failed or timed out, if it was sent in the
+// current window of its path.
+func (k Keeper) UndoSendPacket(ctx sdk.Context, packet channeltypes.Packet) error {
+	pending, found := k.GetPendingSendPacket(ctx, packet.GetSourceChannel(), packet.GetSequence())
+	if !found {
+		return nil
+	}
+	k.RemovePendingSendPacket(ctx, pending.ChannelId, pending.Sequence)
+
+	rateLimit, found := k.GetRateLimit(ctx, pending.ChannelId, pending.Denom)
+	if !found {
+		return nil
+	}
+
+	var packetData transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &packetData); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal ICS-20 transfer packet data: %s", err.Error())
+	}
+
+	amount, err := parseAmount(packetData.Amount)
+	if err != nil {
+		return err
+	}
+
+	rateLimit.UndoOutflow(amount)
+	k.SetRateLimit(ctx, rateLimit)
+
+	return nil
+}
+
+// receivedDenom returns the local denom of the tokens of a received packet, following the
+// transfer application.
+func receivedDenom(packet channeltypes.Packet, packetDenom string) string {
+	if transfertypes.ReceiverChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), packetDenom) {
+		// the tokens return, the prefix added by the counterparty is removed
+		voucherPrefix := transfertypes.GetDenomPrefix(packet.GetSourcePort(), packet.GetSourceChannel())
+		return transfertypes.ParseDenomTrace(packetDenom[len(voucherPrefix):]).IBCDenom()
+	}
+
+	prefixedDenom := transfertypes.GetDenomPrefix(packet.GetDestPort(), packet.GetDestChannel()) + packetDenom
+	return transfertypes.ParseDenomTrace(prefixedDenom).IBCDenom()
+}
+
+func parseAmount(amount string) (sdkmath.Int, error) {
+	parsed, ok := sdkmath.NewIntFromString(amount)
+	if !ok || !parsed.IsPositive() {
+		return sdkmath.Int{}, errorsmod.Wrapf(transfertypes.ErrInvalidAmount, "unable to parse transfer amount %s into math.Int", amount)
+	}
+
+	return parsed, nil
+}
+
+func emitRateLimitExceeded(ctx sdk.Context, rateLimit types.RateLimit, direction string, amount sdkmath.Int) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRateLimitExceeded,
+			sdk.NewAttribute(types.AttributeKeyDenom, rateLimit.Path.Denom),
+			sdk.NewAttribute(types.AttributeKeyChannelID, rateLimit.Path.ChannelId),
+			sdk.NewAttribute(types.AttributeKeyDirection, direction),
+			sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+			sdk.NewAttribute(types.AttributeKeyChannelValue, rateLimit.Flow.ChannelValue.String()),
+		),
+	)
+}
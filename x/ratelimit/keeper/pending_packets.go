@@ -0,0 +1,70 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Nolus-Protocol/nolus-core/x/ratelimit/types"
+)
+
+// SetPendingSendPacket stores a packet sent in the current window of its path.
+func (k Keeper) SetPendingSendPacket(ctx sdk.Context, packet types.PendingSendPacket) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PendingSendPacketKeyPrefix)
+	store.Set(types.GetPendingSendPacketKey(packet.ChannelId, packet.Sequence), k.cdc.MustMarshal(&packet))
+}
+
+// GetPendingSendPacket returns a packet sent in the current window of its path.
+func (k Keeper) GetPendingSendPacket(ctx sdk.Context, channelID string, sequence uint64) (types.PendingSendPacket, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PendingSendPacketKeyPrefix)
+	bz := store.Get(types.GetPendingSendPacketKey(channelID, sequence))
+	if bz == nil {
+		return types.PendingSendPacket{}, false
+	}
+
+	var packet types.PendingSendPacket
+	k.cdc.MustUnmarshal(bz, &packet)
+	return packet, true
+}
+
+// RemovePendingSendPacket removes a packet once it is acknowledged or timed out.
+func (k Keeper) RemovePendingSendPacket(ctx sdk.Context, channelID string, sequence uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PendingSendPacketKeyPrefix)
+	store.Delete(types.GetPendingSendPacketKey(channelID, sequence))
+}
+
+// GetAllPendingSendPackets returns all the pending packets ordered by channel and sequence.
+func (k Keeper) GetAllPendingSendPackets(ctx sdk.Context) []types.PendingSendPacket {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PendingSendPacketKeyPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var packets []types.PendingSendPacket
+	for ; iterator.Valid(); iterator.Next() {
+		var packet types.PendingSendPacket
+		k.cdc.MustUnmarshal(iterator.Value(), &packet)
+		packets = append(packets, packet)
+	}
+
+	return packets
+}
+
+// removePendingSendPackets removes the pending packets of a path, whose outflow belongs to a
+// window which is over.
+func (k Keeper) removePendingSendPackets(ctx sdk.Context, path types.Path) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PendingSendPacketKeyPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, types.GetChannelKey(path.ChannelId))
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		var packet types.PendingSendPacket
+		k.cdc.MustUnmarshal(iterator.Value(), &packet)
+		if packet.Denom == path.Denom {
+			keys = append(keys, iterator.Key())
+		}
+	}
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
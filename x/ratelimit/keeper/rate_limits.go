@@ -0,0 +1,173 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+
+	"github.com/Nolus-Protocol/nolus-core/x/ratelimit/types"
+)
+
+// AddRateLimit limits the flow of a denom over a transfer channel, starting a window at the
+// current block time. The channel value is the current supply of the denom.
+func (k Keeper) AddRateLimit(ctx sdk.Context, path types.Path, quota types.Quota) error {
+	if _, found := k.GetRateLimit(ctx, path.ChannelId, path.Denom); found {
+		return errorsmod.Wrapf(types.ErrRateLimitAlreadyExist, "%s over %s", path.Denom, path.ChannelId)
+	}
+
+	if _, found := k.channelKeeper.GetChannel(ctx, transfertypes.PortID, path.ChannelId); !found {
+		return errorsmod.Wrapf(types.ErrChannelNotFound, "channel %s", path.ChannelId)
+	}
+
+	channelValue, err := k.channelValue(ctx, path.Denom)
+	if err != nil {
+		return err
+	}
+
+	rateLimit := types.NewRateLimit(path, quota, channelValue, ctx.BlockTime())
+	if err := rateLimit.Validate(); err != nil {
+		return err
+	}
+
+	k.SetRateLimit(ctx, rateLimit)
+	return nil
+}
+
+// UpdateRateLimit replaces the quota of a rate limit and starts a new window.
+func (k Keeper) UpdateRateLimit(ctx sdk.Context, path types.Path, quota types.Quota) error {
+	rateLimit, found := k.GetRateLimit(ctx, path.ChannelId, path.Denom)
+	if !found {
+		return errorsmod.Wrapf(types.ErrRateLimitNotFound, "%s over %s", path.Denom, path.ChannelId)
+	}
+
+	rateLimit.Quota = quota
+	if err := rateLimit.Quota.Validate(); err != nil {
+		return errorsmod.Wrap(types.ErrInvalidRateLimit, err.Error())
+	}
+
+	return k.startWindow(ctx, rateLimit)
+}
+
+// ResetRateLimit clears the flow of a rate limit and starts a new window.
+func (k Keeper) ResetRateLimit(ctx sdk.Context, path types.Path) error {
+	rateLimit, found := k.GetRateLimit(ctx, path.ChannelId, path.Denom)
+	if !found {
+		return errorsmod.Wrapf(types.ErrRateLimitNotFound, "%s over %s", path.Denom, path.ChannelId)
+	}
+
+	return k.startWindow(ctx, rateLimit)
+}
+
+// RemoveRateLimit lifts the rate limit of a path.
+func (k Keeper) RemoveRateLimit(ctx sdk.Context, path types.Path) error {
+	if _, found := k.GetRateLimit(ctx, path.ChannelId, path.Denom); !found {
+		return errorsmod.Wrapf(types.ErrRateLimitNotFound, "%s over %s", path.Denom, path.ChannelId)
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.RateLimitKeyPrefix)
+	store.Delete(types.GetRateLimitKey(path.ChannelId, path.Denom))
+	k.removePendingSendPackets(ctx, path)
+
+	return nil
+}
+
+// ResetExpiredRateLimits starts a new window for the rate limits whose window ended at the
+// current block time.
+func (k Keeper) ResetExpiredRateLimits(ctx sdk.Context) {
+	var expired []types.RateLimit
+	k.IterateRateLimits(ctx, func(rateLimit types.RateLimit) bool {
+		if rateLimit.IsWindowExpired(ctx.BlockTime()) {
+			expired = append(expired, rateLimit)
+		}
+		return false
+	})
+
+	for _, rateLimit := range expired {
+		// the supply of a denom may drop to zero, the flow of its path is blocked until it recovers
+		channelValue := k.bankKeeper.GetSupply(ctx, rateLimit.Path.Denom).Amount
+		rateLimit.StartWindow(channelValue, ctx.BlockTime())
+		k.SetRateLimit(ctx, rateLimit)
+		k.removePendingSendPackets(ctx, rateLimit.Path)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeResetRateLimit,
+				sdk.NewAttribute(types.AttributeKeyDenom, rateLimit.Path.Denom),
+				sdk.NewAttribute(types.AttributeKeyChannelID, rateLimit.Path.ChannelId),
+				sdk.NewAttribute(types.AttributeKeyChannelValue, channelValue.String()),
+			),
+		)
+	}
+}
+
+// SetRateLimit stores a rate limit.
+func (k Keeper) SetRateLimit(ctx sdk.Context, rateLimit types.RateLimit) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.RateLimitKeyPrefix)
+	store.Set(types.GetRateLimitKey(rateLimit.Path.ChannelId, rateLimit.Path.Denom), k.cdc.MustMarshal(&rateLimit))
+}
+
+// GetRateLimit returns the rate limit of a denom over a channel.
+func (k Keeper) GetRateLimit(ctx sdk.Context, channelID, denom string) (types.RateLimit, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.RateLimitKeyPrefix)
+	bz := store.Get(types.GetRateLimitKey(channelID, denom))
+	if bz == nil {
+		return types.RateLimit{}, false
+	}
+
+	var rateLimit types.RateLimit
+	k.cdc.MustUnmarshal(bz, &rateLimit)
+	return rateLimit, true
+}
+
+// IterateRateLimits iterates over the rate limits ordered by channel and denom and stops
+// when cb returns true.
+func (k Keeper) IterateRateLimits(ctx sdk.Context, cb func(rateLimit types.RateLimit) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.RateLimitKeyPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var rateLimit types.RateLimit
+		k.cdc.MustUnmarshal(iterator.Value(), &rateLimit)
+		if cb(rateLimit) {
+			break
+		}
+	}
+}
+
+// GetAllRateLimits returns all the rate limits.
+func (k Keeper) GetAllRateLimits(ctx sdk.Context) []types.RateLimit {
+	var rateLimits []types.RateLimit
+	k.IterateRateLimits(ctx, func(rateLimit types.RateLimit) bool {
+		rateLimits = append(rateLimits, rateLimit)
+		return false
+	})
+
+	return rateLimits
+}
+
+// startWindow clears the flow of a rate limit and takes the current supply as its channel value.
+func (k Keeper) startWindow(ctx sdk.Context, rateLimit types.RateLimit) error {
+	channelValue, err := k.channelValue(ctx, rateLimit.Path.Denom)
+	if err != nil {
+		return err
+	}
+
+	rateLimit.StartWindow(channelValue, ctx.BlockTime())
+	k.SetRateLimit(ctx, rateLimit)
+	k.removePendingSendPackets(ctx, rateLimit.Path)
+
+	return nil
+}
+
+// channelValue returns the supply of a denom, which must not be zero.
+func (k Keeper) channelValue(ctx sdk.Context, denom string) (sdkmath.Int, error) {
+	supply := k.bankKeeper.GetSupply(ctx, denom).Amount
+	if supply.IsZero() {
+		return supply, errorsmod.Wrapf(types.ErrZeroChannelValue, "no supply of %s", denom)
+	}
+
+	return supply, nil
+}
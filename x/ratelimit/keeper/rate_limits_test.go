@@ -0,0 +1,91 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/Nolus-Protocol/nolus-core/x/ratelimit/types"
+)
+
+func (s *KeeperTestSuite) TestAddRateLimit() {
+	path := types.NewPath(denom, channelID)
+
+	s.Require().ErrorIs(s.keeper.AddRateLimit(s.ctx, types.NewPath(denom, "channel-1"), quota()), types.ErrChannelNotFound)
+	s.Require().ErrorIs(s.keeper.AddRateLimit(s.ctx, types.NewPath("uatom", channelID), quota()), types.ErrZeroChannelValue)
+
+	s.Require().NoError(s.keeper.AddRateLimit(s.ctx, path, quota()))
+	s.Require().ErrorIs(s.keeper.AddRateLimit(s.ctx, path, quota()), types.ErrRateLimitAlreadyExist)
+
+	rateLimit, found := s.keeper.GetRateLimit(s.ctx, channelID, denom)
+	s.Require().True(found)
+	s.Require().Equal(types.NewRateLimit(path, quota(), sdkmath.NewInt(1000), s.ctx.BlockTime()), rateLimit)
+}
+
+func (s *KeeperTestSuite) TestUpdateResetRemoveRateLimit() {
+	path := types.NewPath(denom, channelID)
+	s.Require().ErrorIs(s.keeper.UpdateRateLimit(s.ctx, path, quota()), types.ErrRateLimitNotFound)
+	s.Require().ErrorIs(s.keeper.ResetRateLimit(s.ctx, path), types.ErrRateLimitNotFound)
+	s.Require().ErrorIs(s.keeper.RemoveRateLimit(s.ctx, path), types.ErrRateLimitNotFound)
+
+	s.Require().NoError(s.keeper.AddRateLimit(s.ctx, path, quota()))
+	s.addOutflow(path, 100, 1)
+
+	// the update starts a new window with the current supply
+	s.mint(denom, 1000)
+	s.passTime(time.Minute)
+	updated := types.NewQuota(sdkmath.LegacyNewDec(20), sdkmath.LegacyNewDec(5), 2*time.Hour)
+	s.Require().NoError(s.keeper.UpdateRateLimit(s.ctx, path, updated))
+	rateLimit, _ := s.keeper.GetRateLimit(s.ctx, channelID, denom)
+	s.Require().Equal(types.NewRateLimit(path, updated, sdkmath.NewInt(2000), s.ctx.BlockTime()), rateLimit)
+	s.Require().Empty(s.keeper.GetAllPendingSendPackets(s.ctx))
+
+	s.addOutflow(path, 100, 2)
+	s.passTime(time.Minute)
+	s.Require().NoError(s.keeper.ResetRateLimit(s.ctx, path))
+	rateLimit, _ = s.keeper.GetRateLimit(s.ctx, channelID, denom)
+	s.Require().Equal(types.NewRateLimit(path, updated, sdkmath.NewInt(2000), s.ctx.BlockTime()), rateLimit)
+	s.Require().Empty(s.keeper.GetAllPendingSendPackets(s.ctx))
+
+	s.addOutflow(path, 100, 3)
+	s.Require().NoError(s.keeper.RemoveRateLimit(s.ctx, path))
+	s.Require().Empty(s.keeper.GetAllRateLimits(s.ctx))
+	s.Require().Empty(s.keeper.GetAllPendingSendPackets(s.ctx))
+}
+
+func (s *KeeperTestSuite) TestResetExpiredRateLimits() {
+	path := types.NewPath(denom, channelID)
+	other := types.NewPath(denom+"2", channelID)
+	s.Require().NoError(s.keeper.AddRateLimit(s.ctx, path, quota()))
+	s.mint(other.Denom, 500)
+	s.Require().NoError(s.keeper.AddRateLimit(s.ctx, other, types.NewQuota(sdkmath.LegacyNewDec(10), sdkmath.LegacyNewDec(10), 2*time.Hour)))
+	s.addOutflow(path, 100, 1)
+	s.addOutflow(other, 50, 2)
+
+	s.passTime(time.Hour - time.Second)
+	s.keeper.ResetExpiredRateLimits(s.ctx)
+	rateLimit, _ := s.keeper.GetRateLimit(s.ctx, channelID, denom)
+	s.Require().Equal(sdkmath.NewInt(100), rateLimit.Flow.Outflow)
+
+	s.passTime(time.Second)
+	s.keeper.ResetExpiredRateLimits(s.ctx)
+	rateLimit, _ = s.keeper.GetRateLimit(s.ctx, channelID, denom)
+	s.Require().Equal(types.NewRateLimit(path, quota(), sdkmath.NewInt(1000), s.ctx.BlockTime()), rateLimit)
+
+	// the window of the other path has not ended yet
+	rateLimit, _ = s.keeper.GetRateLimit(s.ctx, channelID, other.Denom)
+	s.Require().Equal(sdkmath.NewInt(50), rateLimit.Flow.Outflow)
+	s.Require().Equal([]types.PendingSendPacket{{ChannelId: channelID, Sequence: 2, Denom: other.Denom}}, s.keeper.GetAllPendingSendPackets(s.ctx))
+
+	events := s.ctx.EventManager().Events()
+	s.Require().Equal(types.EventTypeResetRateLimit, events[len(events)-1].Type)
+}
+
+// addOutflow records a packet sent over the path.
+func (s *KeeperTestSuite) addOutflow(path types.Path, amount int64, sequence uint64) {
+	rateLimit, found := s.keeper.GetRateLimit(s.ctx, path.ChannelId, path.Denom)
+	s.Require().True(found)
+	s.Require().NoError(rateLimit.AddOutflow(sdkmath.NewInt(amount)))
+	s.keeper.SetRateLimit(s.ctx, rateLimit)
+	s.keeper.SetPendingSendPacket(s.ctx, types.PendingSendPacket{ChannelId: path.ChannelId, Sequence: sequence, Denom: path.Denom})
+}
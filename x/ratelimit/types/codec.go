@@ -0,0 +1,27 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+)
+
+func RegisterCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&MsgAddRateLimit{}, "nolus-core/x/ratelimit/MsgAddRateLimit", nil)
+	cdc.RegisterConcrete(&MsgUpdateRateLimit{}, "nolus-core/x/ratelimit/MsgUpdateRateLimit", nil)
+	cdc.RegisterConcrete(&MsgRemoveRateLimit{}, "nolus-core/x/ratelimit/MsgRemoveRateLimit", nil)
+	cdc.RegisterConcrete(&MsgResetRateLimit{}, "nolus-core/x/ratelimit/MsgResetRateLimit", nil)
+}
+
+func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgAddRateLimit{},
+		&MsgUpdateRateLimit{},
+		&MsgRemoveRateLimit{},
+		&MsgResetRateLimit{},
+	)
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
+
+var ModuleCdc = codec.NewProtoCodec(cdctypes.NewInterfaceRegistry())
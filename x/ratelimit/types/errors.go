@@ -0,0 +1,17 @@
+package types
+
+// DONTCOVER
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// x/ratelimit module sentinel errors.
+var (
+	ErrRateLimitExceeded     = errorsmod.Register(ModuleName, 1, "rate limit exceeded")
+	ErrRateLimitNotFound     = errorsmod.Register(ModuleName, 2, "rate limit not found")
+	ErrRateLimitAlreadyExist = errorsmod.Register(ModuleName, 3, "rate limit already exists")
+	ErrInvalidRateLimit      = errorsmod.Register(ModuleName, 4, "invalid rate limit")
+	ErrChannelNotFound       = errorsmod.Register(ModuleName, 5, "channel not found")
+	ErrZeroChannelValue      = errorsmod.Register(ModuleName, 6, "channel value is zero")
+)
@@ -0,0 +1,18 @@
+package types
+
+const (
+	EventTypeAddRateLimit      = "add_rate_limit"
+	EventTypeUpdateRateLimit   = "update_rate_limit"
+	EventTypeRemoveRateLimit   = "remove_rate_limit"
+	EventTypeResetRateLimit    = "reset_rate_limit"
+	EventTypeRateLimitExceeded = "rate_limit_exceeded"
+
+	AttributeKeyDenom        = "denom"
+	AttributeKeyChannelID    = "channel_id"
+	AttributeKeyAuthority    = "authority"
+	AttributeKeyDirection    = "direction"
+	AttributeKeyAmount       = "amount"
+	AttributeKeyChannelValue = "channel_value"
+
+	AttributeValueCategory = ModuleName
+)
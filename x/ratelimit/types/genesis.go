@@ -0,0 +1,50 @@
+package types
+
+import (
+	"fmt"
+)
+
+// NewGenesisState creates a new GenesisState object.
+func NewGenesisState(rateLimits []RateLimit, pendingSendPackets []PendingSendPacket) *GenesisState {
+	return &GenesisState{
+		RateLimits:         rateLimits,
+		PendingSendPackets: pendingSendPackets,
+	}
+}
+
+// DefaultGenesis returns the default ratelimit genesis state, which limits no path.
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	paths := make(map[string]bool, len(gs.RateLimits))
+	for _, rateLimit := range gs.RateLimits {
+		if err := rateLimit.Validate(); err != nil {
+			return err
+		}
+
+		key := string(GetRateLimitKey(rateLimit.Path.ChannelId, rateLimit.Path.Denom))
+		if paths[key] {
+			return fmt.Errorf("duplicate rate limit of %s over %s", rateLimit.Path.Denom, rateLimit.Path.ChannelId)
+		}
+		paths[key] = true
+	}
+
+	packets := make(map[string]bool, len(gs.PendingSendPackets))
+	for _, packet := range gs.PendingSendPackets {
+		if !paths[string(GetRateLimitKey(packet.ChannelId, packet.Denom))] {
+			return fmt.Errorf("pending packet %s/%d of %s has no rate limit", packet.ChannelId, packet.Sequence, packet.Denom)
+		}
+
+		key := string(GetPendingSendPacketKey(packet.ChannelId, packet.Sequence))
+		if packets[key] {
+			return fmt.Errorf("duplicate pending packet %s/%d", packet.ChannelId, packet.Sequence)
+		}
+		packets[key] = true
+	}
+
+	return nil
+}
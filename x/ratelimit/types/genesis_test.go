@@ -0,0 +1,66 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Nolus-Protocol/nolus-core/x/ratelimit/types"
+)
+
+func TestGenesisState_Validate(t *testing.T) {
+	quota := types.NewQuota(sdkmath.LegacyNewDec(10), sdkmath.LegacyNewDec(10), time.Hour)
+	rateLimit := types.NewRateLimit(types.NewPath("unls", "channel-0"), quota, sdkmath.NewInt(1000), time.Unix(1000, 0).UTC())
+	packet := types.PendingSendPacket{ChannelId: "channel-0", Sequence: 1, Denom: "unls"}
+
+	invalidFlow := rateLimit
+	invalidFlow.Flow.Outflow = sdkmath.NewInt(-1)
+
+	for _, tc := range []struct {
+		desc     string
+		genState *types.GenesisState
+		valid    bool
+	}{
+		{
+			desc:     "default is valid",
+			genState: types.DefaultGenesis(),
+			valid:    true,
+		},
+		{
+			desc:     "valid genesis state",
+			genState: types.NewGenesisState([]types.RateLimit{rateLimit}, []types.PendingSendPacket{packet}),
+			valid:    true,
+		},
+		{
+			desc:     "duplicate rate limit",
+			genState: types.NewGenesisState([]types.RateLimit{rateLimit, rateLimit}, nil),
+			valid:    false,
+		},
+		{
+			desc:     "negative flow",
+			genState: types.NewGenesisState([]types.RateLimit{invalidFlow}, nil),
+			valid:    false,
+		},
+		{
+			desc:     "pending packet without rate limit",
+			genState: types.NewGenesisState(nil, []types.PendingSendPacket{packet}),
+			valid:    false,
+		},
+		{
+			desc:     "duplicate pending packet",
+			genState: types.NewGenesisState([]types.RateLimit{rateLimit}, []types.PendingSendPacket{packet, packet}),
+			valid:    false,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := tc.genState.Validate()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,49 @@
+package types
+
+import (
+	"encoding/binary"
+)
+
+var (
+	// RateLimitKeyPrefix is the prefix of the rate limits, stored by channel and denom.
+	RateLimitKeyPrefix = []byte{0x01}
+
+	// PendingSendPacketKeyPrefix is the prefix of the packets sent in the current window of
+	// their path, stored by channel and sequence.
+	PendingSendPacketKeyPrefix = []byte{0x02}
+)
+
+const (
+	// ModuleName defines the module name.
+	ModuleName = "ratelimit"
+
+	// StoreKey defines the primary module store key.
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for ratelimit.
+	RouterKey = ModuleName
+
+	// QuerierRoute defines the module's query routing key.
+	QuerierRoute = ModuleName
+
+	// MemStoreKey defines the in-memory store key.
+	MemStoreKey = "mem_ratelimit"
+)
+
+// keySeparator separates the channel from the denom or the sequence. Channel ids never contain it.
+const keySeparator = "/"
+
+// GetChannelKey returns the key prefix of the rate limits or the pending packets of a channel.
+func GetChannelKey(channelID string) []byte {
+	return []byte(channelID + keySeparator)
+}
+
+// GetRateLimitKey returns the store key of a rate limit, relative to RateLimitKeyPrefix.
+func GetRateLimitKey(channelID, denom string) []byte {
+	return append(GetChannelKey(channelID), []byte(denom)...)
+}
+
+// GetPendingSendPacketKey returns the store key of a pending packet, relative to PendingSendPacketKeyPrefix.
+func GetPendingSendPacketKey(channelID string, sequence uint64) []byte {
+	return binary.BigEndian.AppendUint64(GetChannelKey(channelID), sequence)
+}
@@ -0,0 +1,173 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	_ sdk.Msg = &MsgAddRateLimit{}
+	_ sdk.Msg = &MsgUpdateRateLimit{}
+	_ sdk.Msg = &MsgRemoveRateLimit{}
+	_ sdk.Msg = &MsgResetRateLimit{}
+)
+
+// NewMsgAddRateLimit returns a reference to a new MsgAddRateLimit.
+func NewMsgAddRateLimit(authority sdk.AccAddress, path Path, quota Quota) *MsgAddRateLimit {
+	return &MsgAddRateLimit{
+		Authority:      authority.String(),
+		Denom:          path.Denom,
+		ChannelId:      path.ChannelId,
+		MaxPercentSend: quota.MaxPercentSend,
+		MaxPercentRecv: quota.MaxPercentRecv,
+		Duration:       quota.Duration,
+	}
+}
+
+// Path returns the path the rate limit applies to.
+func (m *MsgAddRateLimit) Path() Path {
+	return NewPath(m.Denom, m.ChannelId)
+}
+
+// Quota returns the quota of the rate limit.
+func (m *MsgAddRateLimit) Quota() Quota {
+	return NewQuota(m.MaxPercentSend, m.MaxPercentRecv, m.Duration)
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgAddRateLimit) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgAddRateLimit message.
+func (m *MsgAddRateLimit) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgAddRateLimit) ValidateBasic() error {
+	return validateRateLimitMsg(m.Authority, m.Path(), m.Quota())
+}
+
+// NewMsgUpdateRateLimit returns a reference to a new MsgUpdateRateLimit.
+func NewMsgUpdateRateLimit(authority sdk.AccAddress, path Path, quota Quota) *MsgUpdateRateLimit {
+	return &MsgUpdateRateLimit{
+		Authority:      authority.String(),
+		Denom:          path.Denom,
+		ChannelId:      path.ChannelId,
+		MaxPercentSend: quota.MaxPercentSend,
+		MaxPercentRecv: quota.MaxPercentRecv,
+		Duration:       quota.Duration,
+	}
+}
+
+// Path returns the path the rate limit applies to.
+func (m *MsgUpdateRateLimit) Path() Path {
+	return NewPath(m.Denom, m.ChannelId)
+}
+
+// Quota returns the new quota of the rate limit.
+func (m *MsgUpdateRateLimit) Quota() Quota {
+	return NewQuota(m.MaxPercentSend, m.MaxPercentRecv, m.Duration)
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgUpdateRateLimit) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgUpdateRateLimit message.
+func (m *MsgUpdateRateLimit) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgUpdateRateLimit) ValidateBasic() error {
+	return validateRateLimitMsg(m.Authority, m.Path(), m.Quota())
+}
+
+// NewMsgRemoveRateLimit returns a reference to a new MsgRemoveRateLimit.
+func NewMsgRemoveRateLimit(authority sdk.AccAddress, path Path) *MsgRemoveRateLimit {
+	return &MsgRemoveRateLimit{
+		Authority: authority.String(),
+		Denom:     path.Denom,
+		ChannelId: path.ChannelId,
+	}
+}
+
+// Path returns the path of the rate limit.
+func (m *MsgRemoveRateLimit) Path() Path {
+	return NewPath(m.Denom, m.ChannelId)
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgRemoveRateLimit) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgRemoveRateLimit message.
+func (m *MsgRemoveRateLimit) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgRemoveRateLimit) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	return m.Path().Validate()
+}
+
+// NewMsgResetRateLimit returns a reference to a new MsgResetRateLimit.
+func NewMsgResetRateLimit(authority sdk.AccAddress, path Path) *MsgResetRateLimit {
+	return &MsgResetRateLimit{
+		Authority: authority.String(),
+		Denom:     path.Denom,
+		ChannelId: path.ChannelId,
+	}
+}
+
+// Path returns the path of the rate limit.
+func (m *MsgResetRateLimit) Path() Path {
+	return NewPath(m.Denom, m.ChannelId)
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgResetRateLimit) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgResetRateLimit message.
+func (m *MsgResetRateLimit) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgResetRateLimit) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	return m.Path().Validate()
+}
+
+func validateRateLimitMsg(authority string, path Path, quota Quota) error {
+	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	if err := path.Validate(); err != nil {
+		return errorsmod.Wrap(ErrInvalidRateLimit, err.Error())
+	}
+
+	if err := quota.Validate(); err != nil {
+		return errorsmod.Wrap(ErrInvalidRateLimit, err.Error())
+	}
+
+	return nil
+}
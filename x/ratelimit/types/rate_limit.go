@@ -0,0 +1,162 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+)
+
+const (
+	// DirectionSend is the flow of the packets sent to the counterparty.
+	DirectionSend = "send"
+	// DirectionRecv is the flow of the packets received from the counterparty.
+	DirectionRecv = "recv"
+)
+
+var maxPercent = sdkmath.LegacyNewDec(100)
+
+// NewPath returns the path of a denom over a channel.
+func NewPath(denom, channelID string) Path {
+	return Path{
+		Denom:     denom,
+		ChannelId: channelID,
+	}
+}
+
+// Validate checks the denom and the channel id of the path.
+func (p Path) Validate() error {
+	if err := sdk.ValidateDenom(p.Denom); err != nil {
+		return err
+	}
+
+	return host.ChannelIdentifierValidator(p.ChannelId)
+}
+
+// NewQuota returns a quota allowing the given percentages of the channel value per window.
+func NewQuota(maxPercentSend, maxPercentRecv sdkmath.LegacyDec, duration time.Duration) Quota {
+	return Quota{
+		MaxPercentSend: maxPercentSend,
+		MaxPercentRecv: maxPercentRecv,
+		Duration:       duration,
+	}
+}
+
+// Validate checks that the percentages are within [0, 100], at least one of them is not zero
+// and the window is not empty.
+func (q Quota) Validate() error {
+	if err := validatePercent(q.MaxPercentSend); err != nil {
+		return fmt.Errorf("max percent send: %w", err)
+	}
+
+	if err := validatePercent(q.MaxPercentRecv); err != nil {
+		return fmt.Errorf("max percent recv: %w", err)
+	}
+
+	if q.MaxPercentSend.IsZero() && q.MaxPercentRecv.IsZero() {
+		return fmt.Errorf("max percent send and max percent recv can not be both zero")
+	}
+
+	if q.Duration <= 0 {
+		return fmt.Errorf("duration must be positive")
+	}
+
+	return nil
+}
+
+func validatePercent(percent sdkmath.LegacyDec) error {
+	if percent.IsNil() {
+		return fmt.Errorf("percent can not be nil")
+	}
+
+	if percent.IsNegative() || percent.GT(maxPercent) {
+		return fmt.Errorf("percent %s must be within [0, 100]", percent)
+	}
+
+	return nil
+}
+
+// NewRateLimit returns the rate limit of a path with a window starting at windowStart.
+func NewRateLimit(path Path, quota Quota, channelValue sdkmath.Int, windowStart time.Time) RateLimit {
+	rateLimit := RateLimit{
+		Path:  path,
+		Quota: quota,
+	}
+	rateLimit.StartWindow(channelValue, windowStart)
+
+	return rateLimit
+}
+
+// Validate performs a basic validation of the rate limit.
+func (r RateLimit) Validate() error {
+	if err := r.Path.Validate(); err != nil {
+		return errorsmod.Wrap(ErrInvalidRateLimit, err.Error())
+	}
+
+	if err := r.Quota.Validate(); err != nil {
+		return errorsmod.Wrap(ErrInvalidRateLimit, err.Error())
+	}
+
+	for _, amount := range []sdkmath.Int{r.Flow.Inflow, r.Flow.Outflow, r.Flow.ChannelValue} {
+		if amount.IsNil() || amount.IsNegative() {
+			return errorsmod.Wrapf(ErrInvalidRateLimit, "flow amount %s must not be negative", amount)
+		}
+	}
+
+	return nil
+}
+
+// StartWindow clears the flow and starts a new window with the given channel value.
+func (r *RateLimit) StartWindow(channelValue sdkmath.Int, windowStart time.Time) {
+	r.Flow = Flow{
+		Inflow:       sdkmath.ZeroInt(),
+		Outflow:      sdkmath.ZeroInt(),
+		ChannelValue: channelValue,
+	}
+	r.WindowStart = windowStart
+}
+
+// IsWindowExpired returns true if the window of the rate limit ended at the block time.
+func (r RateLimit) IsWindowExpired(blockTime time.Time) bool {
+	return !blockTime.Before(r.WindowStart.Add(r.Quota.Duration))
+}
+
+// AddOutflow adds a sent amount to the flow. It fails if the net outflow exceeds the quota.
+func (r *RateLimit) AddOutflow(amount sdkmath.Int) error {
+	outflow := r.Flow.Outflow.Add(amount)
+	if err := r.checkQuota(DirectionSend, outflow.Sub(r.Flow.Inflow), r.Quota.MaxPercentSend); err != nil {
+		return err
+	}
+
+	r.Flow.Outflow = outflow
+	return nil
+}
+
+// AddInflow adds a received amount to the flow. It fails if the net inflow exceeds the quota.
+func (r *RateLimit) AddInflow(amount sdkmath.Int) error {
+	inflow := r.Flow.Inflow.Add(amount)
+	if err := r.checkQuota(DirectionRecv, inflow.Sub(r.Flow.Outflow), r.Quota.MaxPercentRecv); err != nil {
+		return err
+	}
+
+	r.Flow.Inflow = inflow
+	return nil
+}
+
+// UndoOutflow removes the amount of a failed send from the flow.
+func (r *RateLimit) UndoOutflow(amount sdkmath.Int) {
+	r.Flow.Outflow = sdkmath.MaxInt(r.Flow.Outflow.Sub(amount), sdkmath.ZeroInt())
+}
+
+func (r RateLimit) checkQuota(direction string, netFlow sdkmath.Int, maxPercent sdkmath.LegacyDec) error {
+	threshold := sdkmath.LegacyNewDecFromInt(r.Flow.ChannelValue).Mul(maxPercent).QuoInt64(100).TruncateInt()
+	if netFlow.GT(threshold) {
+		return errorsmod.Wrapf(ErrRateLimitExceeded, "%s of %s over %s: net flow %s exceeds the quota %s",
+			direction, r.Path.Denom, r.Path.ChannelId, netFlow, threshold)
+	}
+
+	return nil
+}
@@ -0,0 +1,120 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Nolus-Protocol/nolus-core/x/ratelimit/types"
+)
+
+func TestQuota_Validate(t *testing.T) {
+	for _, tc := range []struct {
+		desc  string
+		quota types.Quota
+		valid bool
+	}{
+		{
+			desc:  "valid quota",
+			quota: types.NewQuota(sdkmath.LegacyNewDec(10), sdkmath.LegacyNewDec(100), time.Hour),
+			valid: true,
+		},
+		{
+			desc:  "blocked outflow",
+			quota: types.NewQuota(sdkmath.LegacyZeroDec(), sdkmath.LegacyNewDec(5), time.Hour),
+			valid: true,
+		},
+		{
+			desc:  "blocked inflow and outflow",
+			quota: types.NewQuota(sdkmath.LegacyZeroDec(), sdkmath.LegacyZeroDec(), time.Hour),
+			valid: false,
+		},
+		{
+			desc:  "percent above 100",
+			quota: types.NewQuota(sdkmath.LegacyNewDec(101), sdkmath.LegacyNewDec(5), time.Hour),
+			valid: false,
+		},
+		{
+			desc:  "negative percent",
+			quota: types.NewQuota(sdkmath.LegacyNewDec(5), sdkmath.LegacyNewDec(-1), time.Hour),
+			valid: false,
+		},
+		{
+			desc:  "nil percent",
+			quota: types.Quota{MaxPercentSend: sdkmath.LegacyNewDec(5), Duration: time.Hour},
+			valid: false,
+		},
+		{
+			desc:  "zero duration",
+			quota: types.NewQuota(sdkmath.LegacyNewDec(5), sdkmath.LegacyNewDec(5), 0),
+			valid: false,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := tc.quota.Validate()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestPath_Validate(t *testing.T) {
+	require.NoError(t, types.NewPath("unls", "channel-0").Validate())
+	require.NoError(t, types.NewPath("ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2", "channel-0").Validate())
+	require.Error(t, types.NewPath("", "channel-0").Validate())
+	require.Error(t, types.NewPath("unls", "").Validate())
+	require.Error(t, types.NewPath("unls", "channel/0").Validate())
+}
+
+// The quota applies to the net flow, 10% of a channel value of 1000 in each direction.
+func TestRateLimit_Flow(t *testing.T) {
+	windowStart := time.Unix(1000, 0).UTC()
+	rateLimit := types.NewRateLimit(
+		types.NewPath("unls", "channel-0"),
+		types.NewQuota(sdkmath.LegacyNewDec(10), sdkmath.LegacyNewDec(10), time.Hour),
+		sdkmath.NewInt(1000),
+		windowStart,
+	)
+	require.NoError(t, rateLimit.Validate())
+
+	require.NoError(t, rateLimit.AddOutflow(sdkmath.NewInt(60)))
+	require.NoError(t, rateLimit.AddOutflow(sdkmath.NewInt(40)))
+	require.ErrorIs(t, rateLimit.AddOutflow(sdkmath.NewInt(1)), types.ErrRateLimitExceeded)
+	require.Equal(t, sdkmath.NewInt(100), rateLimit.Flow.Outflow)
+
+	// the inflow makes room for the outflow
+	require.NoError(t, rateLimit.AddInflow(sdkmath.NewInt(50)))
+	require.NoError(t, rateLimit.AddOutflow(sdkmath.NewInt(50)))
+	require.ErrorIs(t, rateLimit.AddOutflow(sdkmath.NewInt(1)), types.ErrRateLimitExceeded)
+
+	require.NoError(t, rateLimit.AddInflow(sdkmath.NewInt(200)))
+	require.ErrorIs(t, rateLimit.AddInflow(sdkmath.NewInt(1)), types.ErrRateLimitExceeded)
+	require.Equal(t, sdkmath.NewInt(250), rateLimit.Flow.Inflow)
+
+	rateLimit.UndoOutflow(sdkmath.NewInt(200))
+	require.Equal(t, sdkmath.ZeroInt(), rateLimit.Flow.Outflow)
+
+	require.False(t, rateLimit.IsWindowExpired(windowStart.Add(time.Hour-time.Nanosecond)))
+	require.True(t, rateLimit.IsWindowExpired(windowStart.Add(time.Hour)))
+
+	rateLimit.StartWindow(sdkmath.NewInt(2000), windowStart.Add(time.Hour))
+	require.Equal(t, types.Flow{Inflow: sdkmath.ZeroInt(), Outflow: sdkmath.ZeroInt(), ChannelValue: sdkmath.NewInt(2000)}, rateLimit.Flow)
+	require.NoError(t, rateLimit.AddOutflow(sdkmath.NewInt(200)))
+}
+
+func TestRateLimit_BlockedDirection(t *testing.T) {
+	rateLimit := types.NewRateLimit(
+		types.NewPath("unls", "channel-0"),
+		types.NewQuota(sdkmath.LegacyZeroDec(), sdkmath.LegacyNewDec(100), time.Hour),
+		sdkmath.NewInt(1000),
+		time.Unix(1000, 0).UTC(),
+	)
+
+	require.ErrorIs(t, rateLimit.AddOutflow(sdkmath.OneInt()), types.ErrRateLimitExceeded)
+	require.NoError(t, rateLimit.AddInflow(sdkmath.NewInt(1000)))
+}
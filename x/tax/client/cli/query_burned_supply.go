@@ -0,0 +1,34 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/Nolus-Protocol/nolus-core/x/tax/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+)
+
+func CmdQueryBurnedSupply() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "burned-supply",
+		Short: "shows the total of the base denom burned from the tax",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.BurnedSupply(context.Background(), &types.QueryBurnedSupplyRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
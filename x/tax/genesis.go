@@ -13,12 +13,15 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 	if err != nil {
 		ctx.Logger().Error("failed to set tax module params", "error", err)
 	}
+
+	k.SetBurnedSupply(ctx, genState.BurnedSupply)
 }
 
 // ExportGenesis returns the capability module's exported genesis.
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	genesis := types.DefaultGenesis()
 	genesis.Params = k.GetParams(ctx)
+	genesis.BurnedSupply = k.GetBurnedSupply(ctx)
 
 	return genesis
 }
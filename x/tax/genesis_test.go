@@ -2,6 +2,9 @@ package tax_test
 
 import (
 	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
 
 	keepertest "github.com/Nolus-Protocol/nolus-core/testutil/keeper"
 	"github.com/Nolus-Protocol/nolus-core/testutil/nullify"
@@ -16,12 +19,17 @@ import (
 func TestGenesis(t *testing.T) {
 	genesisState := types.GenesisState{
 		Params: types.DefaultParams(),
+		BurnedSupply: types.BurnedSupply{
+			Amount: sdkmath.NewInt(1000),
+			Since:  time.Unix(1_700_000_000, 0).UTC(),
+		},
 	}
 
 	k, ctx, _ := keepertest.TaxKeeper(t, false, sdk.DecCoins{})
 	tax.InitGenesis(ctx, *k, genesisState)
 	got := tax.ExportGenesis(ctx, *k)
 	require.NotNil(t, got)
+	require.Equal(t, genesisState.BurnedSupply, got.BurnedSupply)
 
 	nullify.Fill(&genesisState)
 	nullify.Fill(got)
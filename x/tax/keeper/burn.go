@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Nolus-Protocol/nolus-core/x/tax/types"
+)
+
+// annualDuration is the length of the 12 months the minter predicts the annual inflation for.
+const annualDuration = 12 * 30 * 24 * time.Hour
+
+// GetBurnedSupply returns the total of the base denom burned from the tax.
+func (k Keeper) GetBurnedSupply(ctx sdk.Context) types.BurnedSupply {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.BurnedSupplyKey)
+	if bz == nil {
+		return types.NewBurnedSupply()
+	}
+
+	var burnedSupply types.BurnedSupply
+	k.cdc.MustUnmarshal(bz, &burnedSupply)
+	return burnedSupply
+}
+
+// SetBurnedSupply stores the total of the base denom burned from the tax.
+func (k Keeper) SetBurnedSupply(ctx sdk.Context, burnedSupply types.BurnedSupply) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.BurnedSupplyKey, k.cdc.MustMarshal(&burnedSupply))
+}
+
+// AddBurnedSupply adds amount to the burned supply, starting it at the block time on the first burn.
+func (k Keeper) AddBurnedSupply(ctx sdk.Context, amount sdkmath.Int) {
+	burnedSupply := k.GetBurnedSupply(ctx)
+	if burnedSupply.Amount.IsZero() {
+		burnedSupply.Since = ctx.BlockTime()
+	}
+
+	burnedSupply.Amount = burnedSupply.Amount.Add(amount)
+	k.SetBurnedSupply(ctx, burnedSupply)
+}
+
+// AnnualBurn returns the amount expected to be burned from the tax over the next 12 months,
+// extrapolated from the average rate since the first burn. It is zero until the block after
+// the first burn.
+func (k Keeper) AnnualBurn(ctx sdk.Context) sdkmath.Int {
+	burnedSupply := k.GetBurnedSupply(ctx)
+	elapsed := ctx.BlockTime().Sub(burnedSupply.Since)
+	if burnedSupply.Amount.IsZero() || elapsed <= 0 {
+		return sdkmath.ZeroInt()
+	}
+
+	return burnedSupply.Amount.Mul(sdkmath.NewInt(int64(annualDuration))).Quo(sdkmath.NewInt(int64(elapsed)))
+}
@@ -0,0 +1,57 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Nolus-Protocol/nolus-core/app/params"
+	testkeeper "github.com/Nolus-Protocol/nolus-core/testutil/keeper"
+	"github.com/Nolus-Protocol/nolus-core/x/tax/types"
+)
+
+func TestBurnedSupply(t *testing.T) {
+	params.SetAddressPrefixes()
+	k, ctx, _ := testkeeper.TaxKeeper(t, false, sdk.DecCoins{})
+
+	// nothing is burned yet
+	require.Equal(t, types.NewBurnedSupply(), k.GetBurnedSupply(ctx))
+	require.True(t, k.AnnualBurn(ctx).IsZero())
+
+	start := time.Unix(1_700_000_000, 0).UTC()
+	ctx = ctx.WithBlockTime(start)
+	k.AddBurnedSupply(ctx, sdkmath.NewInt(100))
+	// the rate is unknown within the block of the first burn
+	require.True(t, k.AnnualBurn(ctx).IsZero())
+
+	ctx = ctx.WithBlockTime(start.Add(30 * 24 * time.Hour))
+	k.AddBurnedSupply(ctx, sdkmath.NewInt(50))
+	require.Equal(t, types.BurnedSupply{Amount: sdkmath.NewInt(150), Since: start}, k.GetBurnedSupply(ctx))
+	// 150 burned in a month are 1800 over the next 12 months
+	require.Equal(t, sdkmath.NewInt(1800), k.AnnualBurn(ctx))
+
+	resp, err := k.BurnedSupply(ctx, &types.QueryBurnedSupplyRequest{})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin(types.DefaultBaseDenom, 150), resp.Burned)
+	require.Equal(t, start, resp.Since)
+
+	_, err = k.BurnedSupply(ctx, nil)
+	require.Error(t, err)
+}
+
+func TestCalculateBurn(t *testing.T) {
+	params.SetAddressPrefixes()
+	k, ctx, _ := testkeeper.TaxKeeper(t, false, sdk.DecCoins{})
+
+	taxParams := k.GetParams(ctx)
+	taxParams.BurnRatio = sdkmath.LegacyNewDecWithPrec(25, 2)
+	require.NoError(t, k.SetParams(ctx, taxParams))
+
+	require.Equal(t, sdk.NewInt64Coin(types.DefaultBaseDenom, 25), k.CalculateBurn(ctx, sdk.NewInt64Coin(types.DefaultBaseDenom, 101)))
+	// only the tax of fees paid in the base denom is burned
+	denom := taxParams.FeeParams[0].AcceptedDenoms[0].Denom
+	require.Equal(t, sdk.NewInt64Coin(denom, 0), k.CalculateBurn(ctx, sdk.NewInt64Coin(denom, 100)))
+}
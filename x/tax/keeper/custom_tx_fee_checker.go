@@ -23,6 +23,11 @@ func (k Keeper) CustomTxFeeChecker(ctx sdk.Context, tx sdk.Tx) (sdk.Coins, int64
 	feeCoins := feeTx.GetFee()
 	gas := feeTx.GetGas()
 
+	// IBC relay transactions within the relay gas budget do not have to meet the minimum gas prices
+	if k.IsFeeFreeRelay(ctx, feeTx) {
+		return feeCoins, getTxPriority(feeCoins, int64(gas)), nil
+	}
+
 	// Ensure that the provided fees meet a minimum threshold for the validator,
 	// if this is a CheckTx. This is only for local mempool purposes, and thus
 	// is only ran on check tx.
@@ -0,0 +1,23 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/Nolus-Protocol/nolus-core/x/tax/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) BurnedSupply(c context.Context, req *types.QueryBurnedSupplyRequest) (*types.QueryBurnedSupplyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	burnedSupply := k.GetBurnedSupply(ctx)
+
+	return &types.QueryBurnedSupplyResponse{
+		Burned: sdk.NewCoin(k.BaseDenom(ctx), burnedSupply.Amount),
+		Since:  burnedSupply.Since,
+	}, nil
+}
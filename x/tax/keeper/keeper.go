@@ -12,9 +12,10 @@ import (
 )
 
 type Keeper struct {
-	cdc      codec.BinaryCodec
-	storeKey sdktypes.StoreKey
-	memKey   sdktypes.StoreKey
+	cdc       codec.BinaryCodec
+	storeKey  sdktypes.StoreKey
+	memKey    sdktypes.StoreKey
+	tStoreKey sdktypes.StoreKey
 
 	wasmKeeper types.WasmKeeper
 	// the address capable of executing a MsgUpdateParams message. Typically, this
@@ -25,7 +26,8 @@ type Keeper struct {
 func NewKeeper(
 	cdc codec.BinaryCodec,
 	storeKey,
-	memKey sdktypes.StoreKey,
+	memKey,
+	tStoreKey sdktypes.StoreKey,
 	wasmKeeper types.WasmKeeper,
 	authority string,
 ) Keeper {
@@ -33,11 +35,19 @@ func NewKeeper(
 		cdc:        cdc,
 		storeKey:   storeKey,
 		memKey:     memKey,
+		tStoreKey:  tStoreKey,
 		wasmKeeper: wasmKeeper,
 		authority:  authority,
 	}
 }
 
+// WithWasmKeeper returns a copy of the keeper which queries the oracle contracts through wasmKeeper.
+// The simulation uses it to stand in for the oracles.
+func (k Keeper) WithWasmKeeper(wasmKeeper types.WasmKeeper) Keeper {
+	k.wasmKeeper = wasmKeeper
+	return k
+}
+
 // GetAuthority returns the x/tax module's authority.
 func (k Keeper) GetAuthority() string {
 	return k.authority
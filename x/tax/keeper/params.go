@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	sdkmath "cosmossdk.io/math"
 	"github.com/Nolus-Protocol/nolus-core/x/tax/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -68,3 +69,14 @@ func (k Keeper) BaseDenom(ctx sdk.Context) (res string) {
 	k.cdc.MustUnmarshal(bz, &p)
 	return p.BaseDenom
 }
+
+// BurnRatio returns the share of the base denom tax which is burned.
+// The ratio is zero until the params are set with one.
+func (k Keeper) BurnRatio(ctx sdk.Context) sdkmath.LegacyDec {
+	burnRatio := k.GetParams(ctx).BurnRatio
+	if burnRatio.IsNil() {
+		return sdkmath.LegacyZeroDec()
+	}
+
+	return burnRatio
+}
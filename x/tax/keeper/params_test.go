@@ -3,6 +3,7 @@ package keeper_test
 import (
 	"testing"
 
+	sdkmath "cosmossdk.io/math"
 	"github.com/Nolus-Protocol/nolus-core/app/params"
 	testkeeper "github.com/Nolus-Protocol/nolus-core/testutil/keeper"
 	"github.com/Nolus-Protocol/nolus-core/x/tax/types"
@@ -27,12 +28,23 @@ func (s *KeeperTestSuite) TestParams() {
 			},
 			expectErr: true,
 		},
+		{
+			name: "set burn ratio over one",
+			input: types.Params{
+				FeeRate:         1,
+				ContractAddress: "nolus14hj2tavq8fpesdwxxcu44rty3hh90vhujrvcmstl4zr3txmfvw9s0k0puz",
+				BaseDenom:       "nolus",
+				BurnRatio:       sdkmath.LegacyNewDec(2),
+			},
+			expectErr: true,
+		},
 		{
 			name: "set full valid params",
 			input: types.Params{
 				FeeRate:         1,
 				ContractAddress: "nolus14hj2tavq8fpesdwxxcu44rty3hh90vhujrvcmstl4zr3txmfvw9s0k0puz",
 				BaseDenom:       "nolus",
+				BurnRatio:       sdkmath.LegacyNewDecWithPrec(5, 1),
 			},
 			expectErr: false,
 		},
@@ -67,4 +79,5 @@ func TestGetParams(t *testing.T) {
 	require.EqualValues(t, params.FeeRate, k.FeeRate(ctx))
 	require.EqualValues(t, params.ContractAddress, k.ContractAddress(ctx))
 	require.EqualValues(t, params.BaseDenom, k.BaseDenom(ctx))
+	require.EqualValues(t, params.BurnRatio, k.BurnRatio(ctx))
 }
@@ -0,0 +1,87 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+
+	"github.com/Nolus-Protocol/nolus-core/x/tax/types"
+)
+
+// IsRelayTx returns true if the messages consist solely of IBC core relay messages and
+// relay at least one packet. Client updates alone are not relay transactions.
+func IsRelayTx(msgs []sdk.Msg) bool {
+	var packets int
+	for _, msg := range msgs {
+		switch msg.(type) {
+		case *clienttypes.MsgUpdateClient:
+		case *channeltypes.MsgRecvPacket,
+			*channeltypes.MsgAcknowledgement,
+			*channeltypes.MsgTimeout,
+			*channeltypes.MsgTimeoutOnClose:
+			packets++
+		default:
+			return false
+		}
+	}
+
+	return packets > 0
+}
+
+// IsFeeFreeRelay returns true if the transaction is relayed without paying the minimum fee and tax.
+// Only relay transactions whose gas fits both the per tx limit and the gas left in the block budget qualify.
+func (k Keeper) IsFeeFreeRelay(ctx sdk.Context, tx sdk.FeeTx) bool {
+	params := k.GetParams(ctx)
+	gas := tx.GetGas()
+	if params.MaxRelayGasPerTx == 0 || gas > params.MaxRelayGasPerTx || !IsRelayTx(tx.GetMsgs()) {
+		return false
+	}
+
+	return k.GetRelayGasUsed(ctx)+gas <= params.MaxRelayGasPerBlock
+}
+
+// GetRelayGasUsed returns the gas used by the fee-free relay transactions in the current block.
+// The counter is kept in the transient store, so it starts from zero in every block.
+func (k Keeper) GetRelayGasUsed(ctx sdk.Context) uint64 {
+	bz := ctx.TransientStore(k.tStoreKey).Get(types.RelayGasKey)
+	if bz == nil {
+		return 0
+	}
+
+	return binary.BigEndian.Uint64(bz)
+}
+
+// ConsumeRelayGas adds the gas of a fee-free relay transaction to the block budget.
+func (k Keeper) ConsumeRelayGas(ctx sdk.Context, gas uint64) {
+	ctx.TransientStore(k.tStoreKey).Set(types.RelayGasKey, sdk.Uint64ToBigEndian(k.GetRelayGasUsed(ctx)+gas))
+}
+
+// ConsumeRelayGasDecorator adds the gas of the fee-free relay transactions to the block budget.
+// It must follow the ibc RedundantRelayDecorator, so that only the transactions relaying at
+// least one new packet use up the budget.
+type ConsumeRelayGasDecorator struct {
+	tk Keeper
+}
+
+func NewConsumeRelayGasDecorator(tk Keeper) ConsumeRelayGasDecorator {
+	return ConsumeRelayGasDecorator{
+		tk: tk,
+	}
+}
+
+func (crgd ConsumeRelayGasDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, errorsmod.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	if crgd.tk.IsFeeFreeRelay(ctx, feeTx) {
+		crgd.tk.ConsumeRelayGas(ctx, feeTx.GetGas())
+	}
+
+	return next(ctx, tx, simulate)
+}
@@ -0,0 +1,131 @@
+package keeper_test
+
+import (
+	"errors"
+	"testing"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/Nolus-Protocol/nolus-core/testutil/keeper"
+	"github.com/Nolus-Protocol/nolus-core/x/tax/keeper"
+	"github.com/Nolus-Protocol/nolus-core/x/tax/types"
+)
+
+func relayTx(gas uint64, fee sdk.Coins) keepertest.MockFeeTx {
+	return keepertest.MockFeeTx{
+		Msgs: []sdk.Msg{&clienttypes.MsgUpdateClient{}, &channeltypes.MsgRecvPacket{}},
+		Gas:  gas,
+		Fee:  fee,
+	}
+}
+
+func TestIsRelayTx(t *testing.T) {
+	require.False(t, keeper.IsRelayTx(nil))
+	require.True(t, keeper.IsRelayTx([]sdk.Msg{
+		&clienttypes.MsgUpdateClient{},
+		&channeltypes.MsgRecvPacket{},
+		&channeltypes.MsgAcknowledgement{},
+		&channeltypes.MsgTimeout{},
+		&channeltypes.MsgTimeoutOnClose{},
+	}))
+	require.False(t, keeper.IsRelayTx([]sdk.Msg{&channeltypes.MsgRecvPacket{}, &banktypes.MsgSend{}}))
+	require.False(t, keeper.IsRelayTx([]sdk.Msg{&clienttypes.MsgCreateClient{}}))
+
+	// client updates relay no packet
+	require.False(t, keeper.IsRelayTx([]sdk.Msg{&clienttypes.MsgUpdateClient{}}))
+	require.False(t, keeper.IsRelayTx([]sdk.Msg{&clienttypes.MsgUpdateClient{}, &clienttypes.MsgUpdateClient{}}))
+}
+
+func TestIsFeeFreeRelay(t *testing.T) {
+	taxKeeper, ctx, _ := keepertest.TaxKeeper(t, true, sdk.DecCoins{})
+	params := taxKeeper.GetParams(ctx)
+	params.MaxRelayGasPerTx = 100
+	params.MaxRelayGasPerBlock = 250
+	require.NoError(t, taxKeeper.SetParams(ctx, params))
+
+	require.True(t, taxKeeper.IsFeeFreeRelay(ctx, relayTx(100, nil)))
+	require.False(t, taxKeeper.IsFeeFreeRelay(ctx, relayTx(101, nil)))
+	require.False(t, taxKeeper.IsFeeFreeRelay(ctx, keepertest.MockFeeTx{Msgs: []sdk.Msg{&banktypes.MsgSend{}}, Gas: 100}))
+	require.False(t, taxKeeper.IsFeeFreeRelay(ctx, keepertest.MockFeeTx{Msgs: []sdk.Msg{&clienttypes.MsgUpdateClient{}}, Gas: 100}))
+
+	// the block budget is exhausted
+	taxKeeper.ConsumeRelayGas(ctx, 100)
+	taxKeeper.ConsumeRelayGas(ctx, 100)
+	require.Equal(t, uint64(200), taxKeeper.GetRelayGasUsed(ctx))
+	require.True(t, taxKeeper.IsFeeFreeRelay(ctx, relayTx(50, nil)))
+	require.False(t, taxKeeper.IsFeeFreeRelay(ctx, relayTx(51, nil)))
+
+	// the budget is renewed in the next block, as the transient store is
+	// cleared on commit
+	ctx.MultiStore().(storetypes.CommitMultiStore).Commit()
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	require.Zero(t, taxKeeper.GetRelayGasUsed(ctx))
+	require.True(t, taxKeeper.IsFeeFreeRelay(ctx, relayTx(100, nil)))
+
+	// zero disables the fee-free relaying
+	params.MaxRelayGasPerTx = 0
+	require.NoError(t, taxKeeper.SetParams(ctx, params))
+	require.False(t, taxKeeper.IsFeeFreeRelay(ctx, relayTx(100, nil)))
+}
+
+func TestCustomTxFeeCheckerFeeFreeRelay(t *testing.T) {
+	taxKeeper, ctx, _ := keepertest.TaxKeeper(t, true, sdk.DecCoins{sdk.NewDecCoin("unls", sdk.NewInt(1))})
+
+	feeCoins, priority, err := taxKeeper.CustomTxFeeChecker(ctx, relayTx(types.DefaultMaxRelayGasPerTx, nil))
+	require.NoError(t, err)
+	require.Empty(t, feeCoins)
+	require.Zero(t, priority)
+
+	// relay transactions over the per tx limit pay the minimum fee
+	_, _, err = taxKeeper.CustomTxFeeChecker(ctx, relayTx(types.DefaultMaxRelayGasPerTx+1, nil))
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFee)
+}
+
+func (s *KeeperTestSuite) TestTaxDecoratorFeeFreeRelay() {
+	s.SetupTest(true)
+
+	params := types.DefaultParams()
+	params.MaxRelayGasPerTx = 100
+	params.MaxRelayGasPerBlock = 100
+	s.Require().NoError(s.app.TaxKeeper.SetParams(s.ctx, params))
+
+	dtd := keeper.NewDeductTaxDecorator(s.app.AccountKeeper, s.app.BankKeeper, *s.app.TaxKeeper)
+	crgd := keeper.NewConsumeRelayGasDecorator(*s.app.TaxKeeper)
+	tx := relayTx(100, sdk.NewCoins(sdk.NewInt64Coin(params.BaseDenom, 1000)))
+
+	// a relay rejected before the budget is consumed, e.g. as redundant, leaves it intact
+	redundant := errors.New("redundant relay")
+	reject := func(ctx sdk.Context, _ sdk.Tx, _ bool, _ sdk.AnteHandler) (sdk.Context, error) {
+		return ctx, redundant
+	}
+	_, err := sdk.ChainAnteDecorators(dtd, anteDecoratorFunc(reject), crgd)(s.ctx, tx, false)
+	s.Require().ErrorIs(err, redundant)
+	s.Require().Zero(s.app.TaxKeeper.GetRelayGasUsed(s.ctx))
+
+	// the fee collector holds no fees, so only an untaxed transaction passes
+	anteHandler := sdk.ChainAnteDecorators(dtd, crgd)
+	_, err = anteHandler(s.ctx, tx, false)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(100), s.app.TaxKeeper.GetRelayGasUsed(s.ctx))
+
+	treasuryAddr, err := sdk.AccAddressFromBech32(params.ContractAddress)
+	s.Require().NoError(err)
+	s.Require().True(s.app.BankKeeper.GetAllBalances(s.ctx, treasuryAddr).IsZero())
+
+	// the block budget is exhausted
+	_, err = anteHandler(s.ctx, tx, false)
+	s.Require().ErrorIs(err, sdkerrors.ErrInsufficientFunds)
+}
+
+// anteDecoratorFunc adapts a function to the sdk.AnteDecorator interface.
+type anteDecoratorFunc func(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error)
+
+func (f anteDecoratorFunc) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	return f(ctx, tx, simulate, next)
+}
@@ -0,0 +1,61 @@
+package keeper
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// CalculateTax returns the tax deducted from a fee paid in feeCoin and the address it is sent to.
+// The tax of fees paid in the base denom goes to the treasury, otherwise to the profit address
+// of the fee param accepting the denom.
+func (k Keeper) CalculateTax(ctx sdk.Context, feeCoin sdk.Coin) (sdk.Coin, sdk.AccAddress, error) {
+	if err := feeCoin.Validate(); err != nil {
+		return sdk.Coin{}, nil, err
+	}
+
+	params := k.GetParams(ctx)
+	if params.BaseDenom == feeCoin.Denom {
+		treasuryAddr, err := sdk.AccAddressFromBech32(params.ContractAddress)
+		if err != nil {
+			return sdk.Coin{}, nil, errorsmod.Wrap(sdkerrors.ErrUnknownAddress, fmt.Sprintf("invalid treasury smart contract address: %s", err.Error()))
+		}
+
+		return calculateTax(params.FeeRate, feeCoin), treasuryAddr, nil
+	}
+
+	feeParam, err := getFeeParamBasedOnDenom(params.FeeParams, sdk.NewCoins(feeCoin))
+	if err != nil {
+		return sdk.Coin{}, nil, err
+	}
+
+	profitAddr, err := sdk.AccAddressFromBech32(feeParam.ProfitAddress)
+	if err != nil {
+		return sdk.Coin{}, nil, errorsmod.Wrap(sdkerrors.ErrUnknownAddress, fmt.Sprintf("invalid profit smart contract address: %s", err.Error()))
+	}
+
+	return calculateTax(params.FeeRate, feeCoin), profitAddr, nil
+}
+
+// CalculateBurn returns the share of tax which is burned instead of sent to its recipient.
+// Only the tax of fees paid in the base denom is burned.
+func (k Keeper) CalculateBurn(ctx sdk.Context, tax sdk.Coin) sdk.Coin {
+	if tax.Denom != k.BaseDenom(ctx) {
+		return sdk.NewCoin(tax.Denom, sdkmath.ZeroInt())
+	}
+
+	return calculateBurn(k.BurnRatio(ctx), tax)
+}
+
+// calculateTax returns feeRate percent of feeCoin, truncated to an integer amount.
+func calculateTax(feeRate int32, feeCoin sdk.Coin) sdk.Coin {
+	return sdk.NewCoin(feeCoin.Denom, sdk.NewDec(int64(feeRate)).MulInt(feeCoin.Amount).Quo(HUNDRED_DEC).TruncateInt())
+}
+
+// calculateBurn returns the burnRatio share of tax, truncated to an integer amount.
+func calculateBurn(burnRatio sdkmath.LegacyDec, tax sdk.Coin) sdk.Coin {
+	return sdk.NewCoin(tax.Denom, burnRatio.MulInt(tax.Amount).TruncateInt())
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
 	"github.com/Nolus-Protocol/nolus-core/x/tax/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -13,7 +14,7 @@ import (
 var HUNDRED_DEC = sdk.NewDec(100)
 
 // DeductTaxDecorator deducts tax by a given fee rate from the standard collected fee.
-// The tax is sent to a treasury account
+// The tax is sent to a treasury account, less the burn ratio share of the base denom tax, which is burned
 // Call next AnteHandler if tax successfully sent to treasury or no fee provided
 // CONTRACT: Tx must implement FeeTx interface to use DeductTaxDecorator.
 type DeductTaxDecorator struct {
@@ -36,6 +37,12 @@ func (dtd DeductTaxDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bo
 		return ctx, errorsmod.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
 	}
 
+	// IBC relay transactions within the relay gas budget are not taxed. Their gas is added
+	// to the budget by the ConsumeRelayGasDecorator once they are known not to be redundant.
+	if dtd.tk.IsFeeFreeRelay(ctx, feeTx) {
+		return next(ctx, tx, simulate)
+	}
+
 	// If fees are not specified we call the next AnteHandler
 	txFees := feeTx.GetFee()
 	if txFees.Empty() {
@@ -79,12 +86,12 @@ func (dtd DeductTaxDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bo
 		}
 
 		// since it's not baseDenom, send it to the profit
-		if err = deductTax(ctx, dtd.tk, dtd.bk, feeCoin, profitAddr); err != nil {
+		if err = deductTax(ctx, dtd.tk, dtd.bk, feeCoin, profitAddr, sdkmath.LegacyZeroDec()); err != nil {
 			return ctx, err
 		}
 	} else {
-		// if it's baseDenom, then we send it to the treasury
-		if err = deductTax(ctx, dtd.tk, dtd.bk, feeCoin, treasuryAddr); err != nil {
+		// if it's baseDenom, then we burn the burn ratio share and send the rest to the treasury
+		if err = deductTax(ctx, dtd.tk, dtd.bk, feeCoin, treasuryAddr, dtd.tk.BurnRatio(ctx)); err != nil {
 			return ctx, err
 		}
 	}
@@ -98,14 +105,14 @@ func (dtd DeductTaxDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bo
 	return next(ctx, tx, simulate)
 }
 
-func deductTax(ctx sdk.Context, taxKeeper Keeper, bankKeeper types.BankKeeper, feeCoin sdk.Coin, treasuryAddr sdk.AccAddress) error {
-	feeRate := sdk.NewDec(int64(taxKeeper.FeeRate(ctx)))
+func deductTax(ctx sdk.Context, taxKeeper Keeper, bankKeeper types.BankKeeper, feeCoin sdk.Coin, treasuryAddr sdk.AccAddress, burnRatio sdkmath.LegacyDec) error {
+	feeRate := taxKeeper.FeeRate(ctx)
 	// if feeRate is 0 - we won't deduct any tax
-	if feeRate.IsZero() {
+	if feeRate == 0 {
 		return nil
 	}
 
-	tax := sdk.NewCoin(feeCoin.Denom, feeRate.MulInt(feeCoin.Amount).Quo(HUNDRED_DEC).TruncateInt())
+	tax := calculateTax(feeRate, feeCoin)
 	// There are cases where the tax calculation could result in a number between 0 and 1.
 	// In those cases, the tax will be 0, since the lowest registered unit we have is 1unls
 	// **Note - this case probably won't be reached in reality, because we enforce minimum fees(500 currently). So the feeAmount is always expected to be > 500.
@@ -117,10 +124,29 @@ func deductTax(ctx sdk.Context, taxKeeper Keeper, bankKeeper types.BankKeeper, f
 		return types.ErrInvalidTax
 	}
 
-	ctx.Logger().Info(fmt.Sprintf("Deducted %s tax to treasury %s, final fee: %s", tax, treasuryAddr, feeCoin.Sub(tax)))
+	burn := calculateBurn(burnRatio, tax)
+	ctx.Logger().Info(fmt.Sprintf("Deducted %s tax to treasury %s, burned %s, final fee: %s", tax.Sub(burn), treasuryAddr, burn, feeCoin.Sub(tax)))
+
+	// Burn the share of the tax through the module account, since the fee collector cannot burn
+	if burn.IsPositive() {
+		err := bankKeeper.SendCoinsFromModuleToModule(ctx, authtypes.FeeCollectorName, types.ModuleName, sdk.Coins{burn})
+		if err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrInsufficientFunds, err.Error())
+		}
+
+		if err = bankKeeper.BurnCoins(ctx, types.ModuleName, sdk.Coins{burn}); err != nil {
+			return err
+		}
+
+		taxKeeper.AddBurnedSupply(ctx, burn.Amount)
+	}
+
+	if burn.Equal(tax) {
+		return nil
+	}
 
-	// Send tax from fee collector to the treasury smart contract address
-	err := bankKeeper.SendCoinsFromModuleToAccount(ctx, authtypes.FeeCollectorName, treasuryAddr, sdk.Coins{tax})
+	// Send the rest of the tax from fee collector to the treasury smart contract address
+	err := bankKeeper.SendCoinsFromModuleToAccount(ctx, authtypes.FeeCollectorName, treasuryAddr, sdk.Coins{tax.Sub(burn)})
 	if err != nil {
 		return errorsmod.Wrapf(sdkerrors.ErrInsufficientFunds, err.Error())
 	}
@@ -218,3 +218,87 @@ func isAllowedDenom(params types.Params, denom string) bool {
 	}
 	return false
 }
+
+func (suite *KeeperTestSuite) TestTaxDecoratorBurn() {
+	const osmoAllowedDenom = "ibc/C4CFF46FD6DE35CA4CF4CE031E643C8FDC9BA4B99AE598E9B0ED98FE3A2319F9y"
+
+	testCases := []struct {
+		title       string
+		feeDenom    string
+		burnRatio   sdkmath.LegacyDec
+		expBurned   int64
+		expReceived int64
+	}{
+		{
+			title:       "half of the base denom tax is burned",
+			feeDenom:    types.DefaultBaseDenom,
+			burnRatio:   sdkmath.LegacyNewDecWithPrec(5, 1),
+			expBurned:   200,
+			expReceived: 200,
+		},
+		{
+			title:       "the burned share is truncated",
+			feeDenom:    types.DefaultBaseDenom,
+			burnRatio:   sdkmath.LegacyNewDecWithPrec(3333, 4),
+			expBurned:   133,
+			expReceived: 267,
+		},
+		{
+			title:       "all of the base denom tax is burned",
+			feeDenom:    types.DefaultBaseDenom,
+			burnRatio:   sdkmath.LegacyOneDec(),
+			expBurned:   400,
+			expReceived: 0,
+		},
+		{
+			title:       "the tax of fees paid in other denoms is not burned",
+			feeDenom:    osmoAllowedDenom,
+			burnRatio:   sdkmath.LegacyOneDec(),
+			expBurned:   0,
+			expReceived: 400,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.SetupTest(true)
+
+		suite.Run(tc.title, func() {
+			suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+
+			accs := suite.CreateTestAccounts(1)
+			addr := accs[0].acc.GetAddress()
+			suite.FundAcc(addr, sdk.NewCoins(sdk.NewInt64Coin(tc.feeDenom, 1000)))
+			suite.app.AccountKeeper.SetAccount(suite.ctx, accs[0].acc)
+
+			suite.txBuilder.SetGasLimit(sdktestutil.NewTestGasLimit())
+			suite.Require().NoError(suite.txBuilder.SetMsgs(sdktestutil.NewTestMsg(addr)))
+			suite.txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin(tc.feeDenom, 1000)))
+			tx, err := suite.CreateTestTx([]cryptotypes.PrivKey{accs[0].priv}, []uint64{0}, []uint64{0}, suite.ctx.ChainID())
+			suite.Require().NoError(err)
+
+			params := types.DefaultParams()
+			params.BurnRatio = tc.burnRatio
+			suite.Require().NoError(suite.app.TaxKeeper.SetParams(suite.ctx, params))
+
+			recipient := sdk.MustAccAddressFromBech32(params.ContractAddress)
+			if tc.feeDenom != params.BaseDenom {
+				recipient = sdk.MustAccAddressFromBech32(params.FeeParams[0].ProfitAddress)
+			}
+			supply := suite.app.BankKeeper.GetSupply(suite.ctx, tc.feeDenom)
+
+			dfd := ante.NewDeductFeeDecorator(suite.app.AccountKeeper, suite.app.BankKeeper, nil, nil)
+			dtd := keeper.NewDeductTaxDecorator(suite.app.AccountKeeper, suite.app.BankKeeper, *suite.app.TaxKeeper)
+			_, err = sdk.ChainAnteDecorators(dfd, dtd)(suite.ctx, tx, false)
+			suite.Require().NoError(err)
+
+			suite.Require().Equal(tc.expReceived, suite.app.BankKeeper.GetBalance(suite.ctx, recipient, tc.feeDenom).Amount.Int64())
+			suite.Require().Equal(supply.SubAmount(sdkmath.NewInt(tc.expBurned)), suite.app.BankKeeper.GetSupply(suite.ctx, tc.feeDenom))
+
+			burnedSupply := suite.app.TaxKeeper.GetBurnedSupply(suite.ctx)
+			suite.Require().Equal(sdkmath.NewInt(tc.expBurned), burnedSupply.Amount)
+			if tc.expBurned > 0 {
+				suite.Require().Equal(suite.ctx.BlockTime(), burnedSupply.Since)
+			}
+		})
+	}
+}
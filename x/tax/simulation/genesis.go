@@ -4,25 +4,101 @@ import (
 	"encoding/json"
 	"fmt"
 	"math/rand"
+	"strings"
 
-	"github.com/Nolus-Protocol/nolus-core/x/tax/types"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+
+	"github.com/Nolus-Protocol/nolus-core/x/tax/types"
+)
+
+// Simulation parameter constants.
+const (
+	FeeParams = "fee_params"
+	BurnRatio = "burn_ratio"
 )
 
+// StableTicker is the ticker the simulated oracles quote the prices in.
+const StableTicker = "USDC"
+
+// simTickers are the tickers the accepted denoms of the simulated fee params are drawn from.
+var simTickers = []string{StableTicker, "OSMO", "ATOM", "WETH", "WBTC", "AKT", "JUNO", "EVMOS"}
+
 // GenRandomFeeRate generates random FeeRate in range [0-50].
 func GenRandomFeeRate(r *rand.Rand) int32 {
 	return int32(r.Intn(51))
 }
 
+// GenRandomBurnRatio generates random BurnRatio in range [0-1].
+func GenRandomBurnRatio(r *rand.Rand) sdkmath.LegacyDec {
+	return sdkmath.LegacyNewDecWithPrec(int64(r.Intn(101)), 2)
+}
+
+// GenRandomFeeParams generates between one and three fee params, one per simulated DEX.
+// Every DEX accepts a random subset of the simulation tickers as IBC denoms received over its own channel,
+// so a denom is never accepted by two fee params.
+func GenRandomFeeParams(r *rand.Rand) []*types.FeeParam {
+	feeParams := make([]*types.FeeParam, 1+r.Intn(3))
+	for i := range feeParams {
+		tickers := make([]string, len(simTickers))
+		copy(tickers, simTickers)
+		r.Shuffle(len(tickers), func(a, b int) { tickers[a], tickers[b] = tickers[b], tickers[a] })
+
+		acceptedDenoms := make([]*types.DenomTicker, 1+r.Intn(len(tickers)))
+		for j := range acceptedDenoms {
+			acceptedDenoms[j] = &types.DenomTicker{
+				Denom:  ibcDenom(fmt.Sprintf("channel-%d", i), tickers[j]),
+				Ticker: tickers[j],
+			}
+		}
+
+		feeParams[i] = &types.FeeParam{
+			OracleAddress:  sdk.AccAddress(address.Module(types.ModuleName, []byte(fmt.Sprintf("oracle-%d", i)))).String(),
+			ProfitAddress:  sdk.AccAddress(address.Module(types.ModuleName, []byte(fmt.Sprintf("profit-%d", i)))).String(),
+			AcceptedDenoms: acceptedDenoms,
+		}
+	}
+
+	return feeParams
+}
+
+func ibcDenom(channel, ticker string) string {
+	return ibctransfertypes.DenomTrace{
+		Path:      fmt.Sprintf("%s/%s", ibctransfertypes.PortID, channel),
+		BaseDenom: "u" + strings.ToLower(ticker),
+	}.IBCDenom()
+}
+
 // RandomizedGenState generates a random GenesisState for tax.
 func RandomizedGenState(simState *module.SimulationState) {
-	var feeRate int32
+	var (
+		feeRate   int32
+		feeParams []*types.FeeParam
+		burnRatio sdkmath.LegacyDec
+	)
 
 	simState.AppParams.GetOrGenerate(
 		simState.Cdc, string(types.KeyFeeRate), &feeRate, simState.Rand,
 		func(r *rand.Rand) { feeRate = GenRandomFeeRate(r) },
 	)
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, FeeParams, &feeParams, simState.Rand,
+		func(r *rand.Rand) { feeParams = GenRandomFeeParams(r) },
+	)
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, BurnRatio, &burnRatio, simState.Rand,
+		func(r *rand.Rand) { burnRatio = GenRandomBurnRatio(r) },
+	)
 	params := types.NewParams(feeRate, types.DefaultContractAddress, types.DefaultBaseDenom)
+	params.FeeParams = feeParams
+	params.MaxRelayGasPerTx = types.DefaultMaxRelayGasPerTx
+	params.MaxRelayGasPerBlock = types.DefaultMaxRelayGasPerBlock
+	params.BurnRatio = burnRatio
 
 	taxGenesis := types.NewGenesisState(params)
 
@@ -32,4 +108,47 @@ func RandomizedGenState(simState *module.SimulationState) {
 	}
 	fmt.Printf("Selected randomly generated tax parameters:\n%s\n", bz)
 	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(taxGenesis)
+
+	fundAccounts(simState, feeParams)
+}
+
+// fundAccounts gives the simulation accounts random balances of the accepted denoms, so that they can pay fees in them.
+// The bank genesis state has to be generated before the tax one; the accounts are not funded without it.
+func fundAccounts(simState *module.SimulationState, feeParams []*types.FeeParam) {
+	bz, found := simState.GenState[banktypes.ModuleName]
+	if !found {
+		return
+	}
+
+	var bankGenesis banktypes.GenesisState
+	simState.Cdc.MustUnmarshalJSON(bz, &bankGenesis)
+
+	balances := make(map[string]int, len(bankGenesis.Balances))
+	for i, balance := range bankGenesis.Balances {
+		balances[balance.Address] = i
+	}
+
+	for _, account := range simState.Accounts {
+		var coins sdk.Coins
+		for _, feeParam := range feeParams {
+			for _, accepted := range feeParam.AcceptedDenoms {
+				coins = coins.Add(sdk.NewCoin(accepted.Denom, sdkmath.NewInt(int64(simtypes.RandIntBetween(simState.Rand, 1e6, 1e12)))))
+			}
+		}
+
+		addr := account.Address.String()
+		if i, ok := balances[addr]; ok {
+			bankGenesis.Balances[i].Coins = bankGenesis.Balances[i].Coins.Add(coins...)
+		} else {
+			balances[addr] = len(bankGenesis.Balances)
+			bankGenesis.Balances = append(bankGenesis.Balances, banktypes.Balance{Address: addr, Coins: coins})
+		}
+
+		// an empty supply is computed from the balances
+		if !bankGenesis.Supply.Empty() {
+			bankGenesis.Supply = bankGenesis.Supply.Add(coins...)
+		}
+	}
+
+	simState.GenState[banktypes.ModuleName] = simState.Cdc.MustMarshalJSON(&bankGenesis)
 }
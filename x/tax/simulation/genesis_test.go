@@ -10,8 +10,11 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
 
 	"github.com/Nolus-Protocol/nolus-core/x/tax/simulation"
 	"github.com/Nolus-Protocol/nolus-core/x/tax/types"
@@ -45,6 +48,74 @@ func TestRandomizedGenState(t *testing.T) {
 	require.GreaterOrEqual(t, taxGenesis.Params.FeeRate, int32(1))
 	require.GreaterOrEqual(t, int32(100), taxGenesis.Params.FeeRate)
 	require.Equal(t, "nolus14hj2tavq8fpesdwxxcu44rty3hh90vhujrvcmstl4zr3txmfvw9s0k0puz", taxGenesis.Params.ContractAddress)
+	require.NotEmpty(t, taxGenesis.Params.FeeParams)
+	require.False(t, taxGenesis.Params.BurnRatio.IsNegative())
+	require.True(t, taxGenesis.Params.BurnRatio.LTE(sdkmath.LegacyOneDec()))
+	require.True(t, taxGenesis.BurnedSupply.Amount.IsZero())
+	require.NoError(t, taxGenesis.Validate())
+}
+
+// TestRandomizedGenStateFundsAccounts tests that the simulation accounts are funded with the accepted denoms.
+func TestRandomizedGenStateFundsAccounts(t *testing.T) {
+	interfaceRegistry := codectypes.NewInterfaceRegistry()
+	cdc := codec.NewProtoCodec(interfaceRegistry)
+
+	r := rand.New(rand.NewSource(1))
+	accounts := simtypes.RandomAccounts(r, 3)
+	supply := sdk.NewCoins(sdk.NewCoin("unls", sdkmath.NewInt(3000)))
+	bankGenesis := banktypes.GenesisState{
+		Balances: []banktypes.Balance{{Address: accounts[0].Address.String(), Coins: supply}},
+		Supply:   supply,
+	}
+
+	simState := module.SimulationState{
+		AppParams:    make(simtypes.AppParams),
+		Cdc:          cdc,
+		Rand:         r,
+		NumBonded:    3,
+		Accounts:     accounts,
+		InitialStake: sdkmath.NewInt(1000),
+		GenState:     map[string]json.RawMessage{banktypes.ModuleName: cdc.MustMarshalJSON(&bankGenesis)},
+	}
+
+	simulation.RandomizedGenState(&simState)
+
+	var taxGenesis types.GenesisState
+	cdc.MustUnmarshalJSON(simState.GenState[types.ModuleName], &taxGenesis)
+	cdc.MustUnmarshalJSON(simState.GenState[banktypes.ModuleName], &bankGenesis)
+
+	require.Len(t, bankGenesis.Balances, len(accounts))
+	require.Equal(t, supply.AmountOf("unls"), bankGenesis.Balances[0].Coins.AmountOf("unls"))
+
+	total := sdk.NewCoins()
+	for _, balance := range bankGenesis.Balances {
+		for _, feeParam := range taxGenesis.Params.FeeParams {
+			for _, accepted := range feeParam.AcceptedDenoms {
+				require.True(t, balance.Coins.AmountOf(accepted.Denom).IsPositive())
+			}
+		}
+		total = total.Add(balance.Coins...)
+	}
+	require.Equal(t, total, bankGenesis.Supply)
+}
+
+// TestGenRandomFeeParams tests that no denom is accepted by more than one generated fee param.
+func TestGenRandomFeeParams(t *testing.T) {
+	for seed := int64(0); seed < 20; seed++ {
+		feeParams := simulation.GenRandomFeeParams(rand.New(rand.NewSource(seed)))
+		require.NotEmpty(t, feeParams)
+
+		denoms := make(map[string]bool)
+		for _, feeParam := range feeParams {
+			require.NotEmpty(t, feeParam.AcceptedDenoms)
+			require.NotEqual(t, feeParam.OracleAddress, feeParam.ProfitAddress)
+			for _, accepted := range feeParam.AcceptedDenoms {
+				require.NoError(t, ibctransfertypes.ValidateIBCDenom(accepted.Denom))
+				require.False(t, denoms[accepted.Denom])
+				denoms[accepted.Denom] = true
+			}
+		}
+	}
 }
 
 // TestRandomizedGenState tests abnormal scenarios of applying RandomizedGenState.
@@ -0,0 +1,166 @@
+package simulation
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/Nolus-Protocol/nolus-core/x/tax/keeper"
+	"github.com/Nolus-Protocol/nolus-core/x/tax/types"
+)
+
+// Simulation operation weights constants.
+const (
+	DefaultWeightMsgSendWithForeignFee int = 50
+
+	OpWeightMsgSendWithForeignFee = "op_weight_msg_send_with_foreign_fee" //nolint:gosec
+)
+
+// minGasPrice is the minimum gas price in the base denom the fee checker is run with.
+var minGasPrice = sdk.NewDecWithPrec(25, 4)
+
+// WeightedOperations returns all the operations from the module with their respective weights.
+func WeightedOperations(
+	appParams simtypes.AppParams, cdc codec.JSONCodec, ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper,
+) simulation.WeightedOperations {
+	var weightMsgSendWithForeignFee int
+	appParams.GetOrGenerate(cdc, OpWeightMsgSendWithForeignFee, &weightMsgSendWithForeignFee, nil,
+		func(_ *rand.Rand) {
+			weightMsgSendWithForeignFee = DefaultWeightMsgSendWithForeignFee
+		},
+	)
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(
+			weightMsgSendWithForeignFee,
+			SimulateMsgSendWithForeignFee(ak, bk, k),
+		),
+	}
+}
+
+// SimulateMsgSendWithForeignFee sends a MsgSend paying the fee in a random accepted denom.
+//
+// The fee checker is run first as in CheckTx, with the oracles replaced by a PriceSource quoting random prices.
+// The fee is drawn between half and three times the minimum, so the checker has to both accept and reject fees.
+// The accepted transactions are delivered, after which the tax received by the profit address of the denom
+// and the fee kept by the fee collector have to add up to the fee paid.
+func SimulateMsgSendWithForeignFee(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&banktypes.MsgSend{})
+
+		params := k.GetParams(ctx)
+		if len(params.FeeParams) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no fee params"), nil, nil
+		}
+
+		feeParam := params.FeeParams[r.Intn(len(params.FeeParams))]
+		if len(feeParam.AcceptedDenoms) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no accepted denoms"), nil, nil
+		}
+		accepted := feeParam.AcceptedDenoms[r.Intn(len(feeParam.AcceptedDenoms))]
+
+		from, _ := simtypes.RandomAcc(r, accs)
+		to, _ := simtypes.RandomAcc(r, accs)
+		balance := bk.SpendableCoins(ctx, from.Address).AmountOf(accepted.Denom)
+
+		// the value of the fee is computed with the oracle prices like the fee checker does
+		prices := NewRandomPriceSource(r, params.FeeParams)
+		oracleData, _ := prices.Prices(feeParam.OracleAddress)
+		gas := uint64(simtestutil.DefaultGenTxGas)
+		requiredFee := minGasPrice.MulInt64(int64(gas)).Ceil().RoundInt()
+		_, requiredFeeInDenom, err := oracleData.CalculateValueInBaseAsset(accepted.Ticker, StableTicker, 0, requiredFee)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "failed to price the fee"), nil, err
+		}
+
+		feeAmount := sdkmath.NewInt(int64(math.Ceil(requiredFeeInDenom * (0.5 + 2.5*r.Float64()))))
+		if !balance.GT(feeAmount) {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "insufficient funds for the fee"), nil, nil
+		}
+		feeCoin := sdk.NewCoin(accepted.Denom, feeAmount)
+
+		feeValue, _, err := oracleData.CalculateValueInBaseAsset(accepted.Ticker, StableTicker, float64(feeAmount.Int64()), requiredFee)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "failed to price the fee"), nil, err
+		}
+		sufficient := feeValue > float64(requiredFee.Int64())
+
+		sendAmount, err := simtypes.RandPositiveInt(r, balance.Sub(feeAmount))
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "nothing to send"), nil, nil
+		}
+		coins := sdk.NewCoins(sdk.NewCoin(accepted.Denom, sendAmount))
+		if err := bk.IsSendEnabledCoins(ctx, coins...); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, err.Error()), nil, nil
+		}
+
+		msg := banktypes.NewMsgSend(from.Address, to.Address, coins)
+		account := ak.GetAccount(ctx, from.Address)
+		txGen := moduletestutil.MakeTestEncodingConfig().TxConfig
+		tx, err := simtestutil.GenSignedMockTx(
+			r,
+			txGen,
+			[]sdk.Msg{msg},
+			sdk.NewCoins(feeCoin),
+			gas,
+			chainID,
+			[]uint64{account.GetAccountNumber()},
+			[]uint64{account.GetSequence()},
+			from.PrivKey,
+		)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to generate mock tx"), nil, err
+		}
+
+		checkCtx, _ := ctx.CacheContext()
+		checkCtx = checkCtx.WithIsCheckTx(true).WithMinGasPrices(sdk.NewDecCoins(sdk.NewDecCoinFromDec(params.BaseDenom, minGasPrice)))
+		_, _, err = k.WithWasmKeeper(prices).CustomTxFeeChecker(checkCtx, tx)
+		switch {
+		case sufficient && err != nil:
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "fee rejected"), nil,
+				fmt.Errorf("fee %s worth %f%s of the required %s%s was rejected: %w", feeCoin, feeValue, params.BaseDenom, requiredFee, params.BaseDenom, err)
+		case !sufficient && err == nil:
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "fee accepted"), nil,
+				fmt.Errorf("fee %s worth %f%s of the required %s%s was accepted", feeCoin, feeValue, params.BaseDenom, requiredFee, params.BaseDenom)
+		case !sufficient:
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "insufficient fee rejected"), nil, nil
+		}
+
+		tax, recipient, err := k.CalculateTax(ctx, feeCoin)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "failed to calculate the tax"), nil, err
+		}
+
+		feeCollector := ak.GetModuleAddress(authtypes.FeeCollectorName)
+		recipientBefore := bk.SpendableCoins(ctx, recipient).AmountOf(feeCoin.Denom)
+		feeCollectorBefore := bk.SpendableCoins(ctx, feeCollector).AmountOf(feeCoin.Denom)
+
+		if _, _, err := app.SimDeliver(txGen.TxEncoder(), tx); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to deliver tx"), nil, err
+		}
+
+		if received := bk.SpendableCoins(ctx, recipient).AmountOf(feeCoin.Denom).Sub(recipientBefore); !received.Equal(tax.Amount) {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "wrong tax"), nil,
+				fmt.Errorf("tax recipient %s received %s%s instead of the %s tax of the fee %s", recipient, received, feeCoin.Denom, tax, feeCoin)
+		}
+		if kept := bk.SpendableCoins(ctx, feeCollector).AmountOf(feeCoin.Denom).Sub(feeCollectorBefore); !kept.Add(tax.Amount).Equal(feeAmount) {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "wrong fee"), nil,
+				fmt.Errorf("fee collector kept %s%s of the fee %s taxed with %s", kept, feeCoin.Denom, feeCoin, tax)
+		}
+
+		return simtypes.NewOperationMsg(msg, true, "", nil), nil, nil
+	}
+}
@@ -0,0 +1,75 @@
+package simulation
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"strconv"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Nolus-Protocol/nolus-core/app/params"
+	"github.com/Nolus-Protocol/nolus-core/x/tax/types"
+)
+
+// baseAssetTicker is the ticker the fee checker looks up the price of the base asset by.
+var baseAssetTicker = strings.ToUpper(params.HumanCoinUnit)
+
+// PriceSource stands in for the oracle contracts of the fee params in the simulation.
+// It implements the wasm keeper expected by x/tax and answers the prices query of every
+// oracle it knows with the prices of the denoms accepted by the oracle's fee param.
+type PriceSource struct {
+	prices map[string]types.OracleData
+}
+
+var _ types.WasmKeeper = PriceSource{}
+
+// NewRandomPriceSource returns a PriceSource quoting random prices for every fee param.
+// Every price, the base asset included, is quoted in StableTicker.
+func NewRandomPriceSource(r *rand.Rand, feeParams []*types.FeeParam) PriceSource {
+	source := PriceSource{prices: make(map[string]types.OracleData, len(feeParams))}
+	for _, feeParam := range feeParams {
+		// the first price determines the stable ticker of the oracle
+		data := types.OracleData{Prices: []types.Price{randomPrice(r, baseAssetTicker)}}
+		for _, accepted := range feeParam.AcceptedDenoms {
+			if accepted.Ticker != StableTicker {
+				data.Prices = append(data.Prices, randomPrice(r, accepted.Ticker))
+			}
+		}
+
+		source.prices[feeParam.OracleAddress] = data
+	}
+
+	return source
+}
+
+func randomPrice(r *rand.Rand, ticker string) types.Price {
+	return types.Price{
+		Amount:      types.PriceFeed{Amount: strconv.FormatInt(1e6+r.Int63n(1e9), 10), Ticker: ticker},
+		AmountQuote: types.PriceFeed{Amount: strconv.FormatInt(1e5+r.Int63n(1e9), 10), Ticker: StableTicker},
+	}
+}
+
+// Prices returns the prices quoted by the oracle at oracleAddress.
+func (s PriceSource) Prices(oracleAddress string) (types.OracleData, bool) {
+	data, found := s.prices[oracleAddress]
+	return data, found
+}
+
+// QuerySmart answers the prices query of the oracles. Any other query fails.
+func (s PriceSource) QuerySmart(_ sdk.Context, contractAddr sdk.AccAddress, req []byte) ([]byte, error) {
+	var query struct {
+		Prices *struct{} `json:"prices"`
+	}
+	if err := json.Unmarshal(req, &query); err != nil || query.Prices == nil {
+		return nil, fmt.Errorf("unsupported query %s", req)
+	}
+
+	data, found := s.prices[contractAddr.String()]
+	if !found {
+		return nil, fmt.Errorf("no oracle at %s", contractAddr)
+	}
+
+	return json.Marshal(data)
+}
@@ -0,0 +1,52 @@
+package simulation_test
+
+import (
+	"encoding/json"
+	"math/rand"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Nolus-Protocol/nolus-core/x/tax/simulation"
+	"github.com/Nolus-Protocol/nolus-core/x/tax/types"
+)
+
+// TestPriceSource tests that the prices of every accepted denom can be queried and priced in the base asset.
+func TestPriceSource(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	feeParams := simulation.GenRandomFeeParams(r)
+	source := simulation.NewRandomPriceSource(r, feeParams)
+
+	for _, feeParam := range feeParams {
+		oracleAddress, err := sdk.AccAddressFromBech32(feeParam.OracleAddress)
+		require.NoError(t, err)
+
+		bz, err := source.QuerySmart(sdk.Context{}, oracleAddress, []byte(`{"prices":{}}`))
+		require.NoError(t, err)
+
+		var prices types.OracleData
+		require.NoError(t, json.Unmarshal(bz, &prices))
+		require.Equal(t, simulation.StableTicker, prices.Prices[0].AmountQuote.Ticker)
+
+		for _, accepted := range feeParam.AcceptedDenoms {
+			value, required, err := prices.CalculateValueInBaseAsset(accepted.Ticker, simulation.StableTicker, 1000, sdkmath.NewInt(500))
+			require.NoError(t, err)
+			require.Positive(t, value)
+			require.Positive(t, required)
+		}
+	}
+
+	// unknown oracles and queries fail
+	profitAddress, err := sdk.AccAddressFromBech32(feeParams[0].ProfitAddress)
+	require.NoError(t, err)
+	_, err = source.QuerySmart(sdk.Context{}, profitAddress, []byte(`{"prices":{}}`))
+	require.Error(t, err)
+
+	oracleAddress, err := sdk.AccAddressFromBech32(feeParams[0].OracleAddress)
+	require.NoError(t, err)
+	_, err = source.QuerySmart(sdk.Context{}, oracleAddress, []byte(`{"config":{}}`))
+	require.Error(t, err)
+}
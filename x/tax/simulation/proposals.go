@@ -38,6 +38,7 @@ func SimulateMsgUpdateParams(r *rand.Rand, _ sdk.Context, _ []simtypes.Account)
 	params.BaseDenom = simtypes.RandStringOfLength(r, 10)
 	params.ContractAddress = simtypes.RandStringOfLength(r, 20)
 	params.FeeRate = GenRandomFeeRate(r)
+	params.BurnRatio = GenRandomBurnRatio(r)
 
 	return &types.MsgUpdateParams{
 		Authority: authority.String(),
@@ -1,24 +1,48 @@
 package types
 
+import (
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+)
+
 // DefaultIndex is the default capability global index.
 const DefaultIndex uint64 = 1
 
 // NewGenesisState creates a new GenesisState object.
 func NewGenesisState(params Params) *GenesisState {
 	return &GenesisState{
-		Params: params,
+		Params:       params,
+		BurnedSupply: NewBurnedSupply(),
 	}
 }
 
 // DefaultGenesis returns the default Capability genesis state.
 func DefaultGenesis() *GenesisState {
-	return &GenesisState{
-		Params: DefaultParams(),
-	}
+	return NewGenesisState(DefaultParams())
 }
 
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
-	return gs.Params.Validate()
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	return gs.BurnedSupply.Validate()
+}
+
+// NewBurnedSupply returns the burned supply before the first burn.
+func NewBurnedSupply() BurnedSupply {
+	return BurnedSupply{Amount: sdkmath.ZeroInt()}
+}
+
+// Validate checks that the burned amount is not negative. A missing amount, as in the genesis
+// exported before the tax was burned, stands for nothing burned.
+func (b BurnedSupply) Validate() error {
+	if !b.Amount.IsNil() && b.Amount.IsNegative() {
+		return fmt.Errorf("invalid burned supply amount: %s", b.Amount)
+	}
+
+	return nil
 }
@@ -4,18 +4,24 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -25,7 +31,8 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState defines the tax module's genesis state.
 type GenesisState struct {
-	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	Params       Params       `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	BurnedSupply BurnedSupply `protobuf:"bytes,2,opt,name=burned_supply,json=burnedSupply,proto3" json:"burned_supply" yaml:"burned_supply"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -68,27 +75,94 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetBurnedSupply() BurnedSupply {
+	if m != nil {
+		return m.BurnedSupply
+	}
+	return BurnedSupply{}
+}
+
+// BurnedSupply is the total of the base denom burned from the tax.
+type BurnedSupply struct {
+	// amount is the total amount burned since the first burn.
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+	// since is the block time of the first burn.
+	Since time.Time `protobuf:"bytes,2,opt,name=since,proto3,stdtime" json:"since"`
+}
+
+func (m *BurnedSupply) Reset()         { *m = BurnedSupply{} }
+func (m *BurnedSupply) String() string { return proto.CompactTextString(m) }
+func (*BurnedSupply) ProtoMessage()    {}
+func (*BurnedSupply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_83b207e27c37cd0d, []int{1}
+}
+func (m *BurnedSupply) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BurnedSupply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BurnedSupply.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BurnedSupply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BurnedSupply.Merge(m, src)
+}
+func (m *BurnedSupply) XXX_Size() int {
+	return m.Size()
+}
+func (m *BurnedSupply) XXX_DiscardUnknown() {
+	xxx_messageInfo_BurnedSupply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BurnedSupply proto.InternalMessageInfo
+
+func (m *BurnedSupply) GetSince() time.Time {
+	if m != nil {
+		return m.Since
+	}
+	return time.Time{}
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "nolus.tax.v1beta1.GenesisState")
+	proto.RegisterType((*BurnedSupply)(nil), "nolus.tax.v1beta1.BurnedSupply")
 }
 
 func init() { proto.RegisterFile("nolus/tax/v1beta1/genesis.proto", fileDescriptor_83b207e27c37cd0d) }
 
 var fileDescriptor_83b207e27c37cd0d = []byte{
-	// 208 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xcf, 0xcb, 0xcf, 0x29,
-	0x2d, 0xd6, 0x2f, 0x49, 0xac, 0xd0, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4, 0x4f, 0x4f,
-	0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x04, 0x2b, 0xd0,
-	0x2b, 0x49, 0xac, 0xd0, 0x83, 0x2a, 0x90, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0xcb, 0xea, 0x83,
-	0x58, 0x10, 0x85, 0x52, 0x72, 0x98, 0x26, 0x15, 0x24, 0x16, 0x25, 0xe6, 0x42, 0x0d, 0x52, 0x72,
-	0xe7, 0xe2, 0x71, 0x87, 0x98, 0x1c, 0x5c, 0x92, 0x58, 0x92, 0x2a, 0x64, 0xce, 0xc5, 0x06, 0x91,
-	0x97, 0x60, 0x54, 0x60, 0xd4, 0xe0, 0x36, 0x92, 0xd4, 0xc3, 0xb0, 0x49, 0x2f, 0x00, 0xac, 0xc0,
-	0x89, 0xe5, 0xc4, 0x3d, 0x79, 0x86, 0x20, 0xa8, 0x72, 0x27, 0xaf, 0x13, 0x8f, 0xe4, 0x18, 0x2f,
-	0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86, 0x0b, 0x8f, 0xe5, 0x18,
-	0x6e, 0x3c, 0x96, 0x63, 0x88, 0x32, 0x48, 0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0xd2, 0x4b, 0xce, 0xcf,
-	0xd5, 0xf7, 0x03, 0x19, 0xa6, 0x1b, 0x00, 0xb2, 0x3a, 0x39, 0x3f, 0x47, 0x1f, 0x6c, 0xb6, 0x6e,
-	0x72, 0x7e, 0x51, 0xaa, 0x7e, 0x05, 0xd8, 0x8d, 0x25, 0x95, 0x05, 0xa9, 0xc5, 0x49, 0x6c, 0x60,
-	0xb7, 0x19, 0x03, 0x02, 0x00, 0x00, 0xff, 0xff, 0x8a, 0xf9, 0x32, 0x50, 0x07, 0x01, 0x00, 0x00,
+	// 381 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0xb1, 0x6a, 0xe3, 0x30,
+	0x1c, 0xc6, 0xad, 0xe3, 0x2e, 0xdc, 0xe9, 0x72, 0xc3, 0x99, 0x1c, 0x24, 0xe1, 0xb0, 0x0f, 0x4f,
+	0x07, 0x25, 0x52, 0xd3, 0x0e, 0x85, 0x8c, 0xee, 0x50, 0xd2, 0xa1, 0x04, 0xa7, 0x53, 0x97, 0x20,
+	0x3b, 0xaa, 0x63, 0x6a, 0x59, 0xc6, 0x92, 0x4b, 0xf2, 0x14, 0xcd, 0x7b, 0x74, 0xed, 0x43, 0x64,
+	0x0c, 0x9d, 0x4a, 0x87, 0xb4, 0x24, 0x6f, 0xd0, 0x27, 0x28, 0x96, 0x14, 0x48, 0x49, 0x37, 0xff,
+	0xf9, 0x7e, 0xdf, 0xe7, 0x8f, 0x4f, 0xd0, 0xcd, 0x78, 0x5a, 0x0a, 0x2c, 0xc9, 0x14, 0xdf, 0x76,
+	0x43, 0x2a, 0x49, 0x17, 0xc7, 0x34, 0xa3, 0x22, 0x11, 0x28, 0x2f, 0xb8, 0xe4, 0xf6, 0x6f, 0x05,
+	0x20, 0x49, 0xa6, 0xc8, 0x00, 0xed, 0x46, 0xcc, 0x63, 0xae, 0x54, 0x5c, 0x7d, 0x69, 0xb0, 0xdd,
+	0x8a, 0xb8, 0x60, 0x5c, 0x8c, 0xb4, 0xa0, 0x0f, 0x23, 0xb9, 0x31, 0xe7, 0x71, 0x4a, 0xb1, 0xba,
+	0xc2, 0xf2, 0x1a, 0xcb, 0x84, 0x51, 0x21, 0x09, 0xcb, 0x0d, 0xe0, 0xec, 0xb7, 0xc8, 0x49, 0x41,
+	0x98, 0x09, 0xf0, 0xee, 0x01, 0xac, 0x9f, 0xe9, 0x5a, 0x43, 0x49, 0x24, 0xb5, 0x4f, 0x60, 0x4d,
+	0x03, 0x4d, 0xf0, 0x0f, 0xfc, 0xff, 0x79, 0xd4, 0x42, 0x7b, 0x35, 0xd1, 0x40, 0x01, 0xfe, 0xd7,
+	0xc5, 0xca, 0xb5, 0x02, 0x83, 0xdb, 0x21, 0xfc, 0x15, 0x96, 0x45, 0x46, 0xc7, 0x23, 0x51, 0xe6,
+	0x79, 0x3a, 0x6b, 0x7e, 0x51, 0x7e, 0xf7, 0x13, 0xbf, 0xaf, 0xb8, 0xa1, 0xc2, 0xfc, 0xbf, 0x55,
+	0xca, 0xdb, 0xca, 0x6d, 0xcc, 0x08, 0x4b, 0x7b, 0xde, 0x87, 0x0c, 0x2f, 0xa8, 0x87, 0x3b, 0xac,
+	0x77, 0x07, 0x60, 0x7d, 0xd7, 0x6c, 0x9f, 0xc2, 0x1a, 0x61, 0xbc, 0xcc, 0xa4, 0x6a, 0xfb, 0xc3,
+	0x3f, 0xa8, 0xc2, 0x9e, 0x57, 0xee, 0x1f, 0xbd, 0x92, 0x18, 0xdf, 0xa0, 0x84, 0x63, 0x46, 0xe4,
+	0x04, 0xf5, 0x33, 0xf9, 0xf8, 0xd0, 0x81, 0x66, 0xbe, 0x7e, 0x26, 0x03, 0x63, 0xb5, 0x7b, 0xf0,
+	0x9b, 0x48, 0xb2, 0x88, 0x9a, 0xc6, 0x6d, 0xa4, 0x47, 0x45, 0xdb, 0x51, 0xd1, 0xe5, 0x76, 0x54,
+	0xff, 0x7b, 0x95, 0x3f, 0x7f, 0x71, 0x41, 0xa0, 0x2d, 0xfe, 0xf9, 0x62, 0xed, 0x80, 0xe5, 0xda,
+	0x01, 0xaf, 0x6b, 0x07, 0xcc, 0x37, 0x8e, 0xb5, 0xdc, 0x38, 0xd6, 0xd3, 0xc6, 0xb1, 0xae, 0x0e,
+	0xe3, 0x44, 0x4e, 0xca, 0x10, 0x45, 0x9c, 0xe1, 0x8b, 0x6a, 0x82, 0xce, 0xa0, 0xca, 0x8b, 0x78,
+	0x8a, 0xd5, 0x22, 0x9d, 0x88, 0x17, 0x14, 0x4f, 0xd5, 0xd3, 0xc8, 0x59, 0x4e, 0x45, 0x58, 0x53,
+	0x3f, 0x3c, 0x7e, 0x1f, 0x00, 0x64, 0x9a, 0x60, 0xd8, 0x3a, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -111,6 +185,16 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.BurnedSupply.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -124,6 +208,47 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *BurnedSupply) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BurnedSupply) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BurnedSupply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Since, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Since):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintGenesis(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -143,6 +268,21 @@ func (m *GenesisState) Size() (n int) {
 	_ = l
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	l = m.BurnedSupply.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *BurnedSupply) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Since)
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -214,6 +354,156 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnedSupply", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BurnedSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BurnedSupply) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BurnedSupply: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BurnedSupply: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Since", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Since, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -2,6 +2,9 @@ package types_test
 
 import (
 	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
 
 	"github.com/Nolus-Protocol/nolus-core/app/params"
 	"github.com/Nolus-Protocol/nolus-core/x/tax/types"
@@ -25,6 +28,52 @@ func TestGenesisState_Validate(t *testing.T) {
 			genState: &types.GenesisState{Params: types.NewParams(types.DefaultFeeRate, types.DefaultContractAddress, types.DefaultBaseDenom)},
 			valid:    true,
 		},
+		{
+			desc: "relay gas per tx over the block budget",
+			genState: func() *types.GenesisState {
+				genState := types.DefaultGenesis()
+				genState.Params.MaxRelayGasPerTx = genState.Params.MaxRelayGasPerBlock + 1
+				return genState
+			}(),
+			valid: false,
+		},
+		{
+			desc: "all of the tax burned",
+			genState: func() *types.GenesisState {
+				genState := types.DefaultGenesis()
+				genState.Params.BurnRatio = sdkmath.LegacyOneDec()
+				genState.BurnedSupply = types.BurnedSupply{Amount: sdkmath.NewInt(1000), Since: time.Unix(1, 0)}
+				return genState
+			}(),
+			valid: true,
+		},
+		{
+			desc: "burn ratio over one",
+			genState: func() *types.GenesisState {
+				genState := types.DefaultGenesis()
+				genState.Params.BurnRatio = sdkmath.LegacyNewDecWithPrec(101, 2)
+				return genState
+			}(),
+			valid: false,
+		},
+		{
+			desc: "negative burn ratio",
+			genState: func() *types.GenesisState {
+				genState := types.DefaultGenesis()
+				genState.Params.BurnRatio = sdkmath.LegacyNewDecWithPrec(-1, 2)
+				return genState
+			}(),
+			valid: false,
+		},
+		{
+			desc: "negative burned supply",
+			genState: func() *types.GenesisState {
+				genState := types.DefaultGenesis()
+				genState.BurnedSupply.Amount = sdkmath.NewInt(-1)
+				return genState
+			}(),
+			valid: false,
+		},
 		{
 			desc:     "invalid genesis state",
 			genState: &types.GenesisState{},
@@ -15,12 +15,21 @@ const (
 
 	// MemStoreKey defines the in-memory store key.
 	MemStoreKey = "mem_tax"
+
+	// TStoreKey defines the transient store key, whose state is discarded at
+	// the end of every block.
+	TStoreKey = "transient_tax"
 )
 
 var (
 	// TaxKey is the key to use for the keeper store.
 	TaxKey    = []byte{0x00}
 	ParamsKey = []byte{0x01}
+	// RelayGasKey stores the gas used by the fee-free relay transactions in the current block
+	// in the transient store.
+	RelayGasKey = []byte{0x02}
+	// BurnedSupplyKey stores the total of the base denom burned from the tax.
+	BurnedSupplyKey = []byte{0x03}
 )
 
 func KeyPrefix(p string) []byte {
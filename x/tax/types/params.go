@@ -6,18 +6,22 @@ import (
 	"strings"
 
 	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
 	"github.com/Nolus-Protocol/nolus-core/app/params"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"gopkg.in/yaml.v2"
 )
 
 var (
-	DefaultFeeRate         int32          = 40
-	DefaultContractAddress string         = "nolus14hj2tavq8fpesdwxxcu44rty3hh90vhujrvcmstl4zr3txmfvw9s0k0puz"
-	DefaultBaseDenom       string         = params.BaseCoinUnit
-	DefaultOracleAddress   string         = "nolus1436kxs0w2es6xlqpp9rd35e3d0cjnw4sv8j3a7483sgks29jqwgsv3wzl4"
-	DefaultProfitAddress   string         = "nolus1mf6ptkssddfmxvhdx0ech0k03ktp6kf9yk59renau2gvht3nq2gqkxgywu"
-	DefaultAcceptedDenoms  []*DenomTicker = []*DenomTicker{
+	DefaultFeeRate             int32             = 40
+	DefaultContractAddress     string            = "nolus14hj2tavq8fpesdwxxcu44rty3hh90vhujrvcmstl4zr3txmfvw9s0k0puz"
+	DefaultBaseDenom           string            = params.BaseCoinUnit
+	DefaultOracleAddress       string            = "nolus1436kxs0w2es6xlqpp9rd35e3d0cjnw4sv8j3a7483sgks29jqwgsv3wzl4"
+	DefaultProfitAddress       string            = "nolus1mf6ptkssddfmxvhdx0ech0k03ktp6kf9yk59renau2gvht3nq2gqkxgywu"
+	DefaultMaxRelayGasPerTx    uint64            = 1_000_000
+	DefaultMaxRelayGasPerBlock uint64            = 20_000_000
+	DefaultBurnRatio           sdkmath.LegacyDec = sdkmath.LegacyZeroDec()
+	DefaultAcceptedDenoms      []*DenomTicker    = []*DenomTicker{
 		{
 			Denom:  "ibc/C4CFF46FD6DE35CA4CF4CE031E643C8FDC9BA4B99AE598E9B0ED98FE3A2319F9y",
 			Ticker: "OSMO",
@@ -39,16 +43,20 @@ func NewParams(
 		FeeRate:         feeRate,
 		ContractAddress: contractAddress,
 		BaseDenom:       baseDenom,
+		BurnRatio:       DefaultBurnRatio,
 	}
 }
 
 // DefaultParams returns default x/tax module parameters.
 func DefaultParams() Params {
 	return Params{
-		FeeRate:         DefaultFeeRate,
-		ContractAddress: DefaultContractAddress,
-		BaseDenom:       DefaultBaseDenom,
-		FeeParams:       DefaultFeeParams(),
+		FeeRate:             DefaultFeeRate,
+		ContractAddress:     DefaultContractAddress,
+		BaseDenom:           DefaultBaseDenom,
+		FeeParams:           DefaultFeeParams(),
+		MaxRelayGasPerTx:    DefaultMaxRelayGasPerTx,
+		MaxRelayGasPerBlock: DefaultMaxRelayGasPerBlock,
+		BurnRatio:           DefaultBurnRatio,
 	}
 }
 
@@ -82,6 +90,14 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateRelayGas(p.MaxRelayGasPerTx, p.MaxRelayGasPerBlock); err != nil {
+		return err
+	}
+
+	if err := validateBurnRatio(p.BurnRatio); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -151,3 +167,29 @@ func validateFeeParams(v interface{}) error {
 
 	return nil
 }
+
+func validateRelayGas(maxPerTx, maxPerBlock uint64) error {
+	if maxPerTx > maxPerBlock {
+		return errorsmod.Wrapf(ErrInvalidRelayGas, "max relay gas per tx %d exceeds the max relay gas per block %d", maxPerTx, maxPerBlock)
+	}
+
+	return nil
+}
+
+func validateBurnRatio(v interface{}) error {
+	burnRatio, ok := v.(sdkmath.LegacyDec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	// a missing ratio, as in the params set before the tax was burned, burns nothing
+	if burnRatio.IsNil() {
+		return nil
+	}
+
+	if burnRatio.IsNegative() || burnRatio.GT(sdkmath.LegacyOneDec()) {
+		return errorsmod.Wrapf(ErrInvalidBurnRatio, "%s", burnRatio)
+	}
+
+	return nil
+}
@@ -4,7 +4,9 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
@@ -29,6 +31,16 @@ type Params struct {
 	ContractAddress string      `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
 	BaseDenom       string      `protobuf:"bytes,3,opt,name=base_denom,json=baseDenom,proto3" json:"base_denom,omitempty"`
 	FeeParams       []*FeeParam `protobuf:"bytes,4,rep,name=fee_params,json=feeParams,proto3" json:"fee_params,omitempty"`
+	// max_relay_gas_per_tx is the gas limit up to which a transaction consisting
+	// only of IBC relay messages pays neither the minimum fee nor tax.
+	// Zero disables the fee-free relaying.
+	MaxRelayGasPerTx uint64 `protobuf:"varint,5,opt,name=max_relay_gas_per_tx,json=maxRelayGasPerTx,proto3" json:"max_relay_gas_per_tx,omitempty"`
+	// max_relay_gas_per_block is the total gas of the fee-free relay
+	// transactions accepted in a block.
+	MaxRelayGasPerBlock uint64 `protobuf:"varint,6,opt,name=max_relay_gas_per_block,json=maxRelayGasPerBlock,proto3" json:"max_relay_gas_per_block,omitempty"`
+	// burn_ratio is the share of the tax of the fees paid in the base denom
+	// which is burned instead of sent to the treasury.
+	BurnRatio cosmossdk_io_math.LegacyDec `protobuf:"bytes,7,opt,name=burn_ratio,json=burnRatio,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"burn_ratio"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -91,6 +103,20 @@ func (m *Params) GetFeeParams() []*FeeParam {
 	return nil
 }
 
+func (m *Params) GetMaxRelayGasPerTx() uint64 {
+	if m != nil {
+		return m.MaxRelayGasPerTx
+	}
+	return 0
+}
+
+func (m *Params) GetMaxRelayGasPerBlock() uint64 {
+	if m != nil {
+		return m.MaxRelayGasPerBlock
+	}
+	return 0
+}
+
 // Defines the accepted fees with corresponding oracle and profit addresses
 type FeeParam struct {
 	OracleAddress  string         `protobuf:"bytes,1,opt,name=oracle_address,json=oracleAddress,proto3" json:"oracle_address,omitempty"`
@@ -214,31 +240,39 @@ func init() {
 func init() { proto.RegisterFile("nolus/tax/v1beta1/params.proto", fileDescriptor_149cb69039ffce9f) }
 
 var fileDescriptor_149cb69039ffce9f = []byte{
-	// 380 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0xcf, 0x4e, 0xe3, 0x30,
-	0x10, 0x87, 0xe3, 0xed, 0x9f, 0x6d, 0x5d, 0xb5, 0xdd, 0xb5, 0xaa, 0x55, 0x76, 0x57, 0xeb, 0xad,
-	0x2a, 0x55, 0xea, 0x1e, 0x9a, 0x6c, 0xe1, 0x56, 0x4e, 0x20, 0x04, 0x12, 0x07, 0x54, 0x45, 0x9c,
-	0xb8, 0x54, 0x8e, 0x33, 0x29, 0x15, 0x6d, 0x1d, 0xd9, 0x2e, 0x2a, 0x6f, 0xc1, 0x91, 0x23, 0x3c,
-	0x01, 0xaf, 0xc1, 0xb1, 0x47, 0x8e, 0xa8, 0x7d, 0x11, 0x14, 0x3b, 0x41, 0x08, 0xb8, 0xd9, 0xdf,
-	0x6f, 0x46, 0xf3, 0x25, 0x63, 0x4c, 0x17, 0x62, 0xb6, 0x54, 0xbe, 0x66, 0x2b, 0xff, 0x6a, 0x10,
-	0x82, 0x66, 0x03, 0x3f, 0x61, 0x92, 0xcd, 0x95, 0x97, 0x48, 0xa1, 0x05, 0xf9, 0x6e, 0x72, 0x4f,
-	0xb3, 0x95, 0x97, 0xe5, 0xbf, 0x5a, 0x13, 0x31, 0x11, 0x26, 0xf5, 0xd3, 0x93, 0x2d, 0xec, 0x3c,
-	0x20, 0x5c, 0x1e, 0x99, 0x4e, 0xf2, 0x13, 0x57, 0x62, 0x80, 0xb1, 0x64, 0x1a, 0x5c, 0xd4, 0x46,
-	0xbd, 0x52, 0xf0, 0x35, 0x06, 0x08, 0x98, 0x06, 0xf2, 0x0f, 0x7f, 0xe3, 0x62, 0xa1, 0x25, 0xe3,
-	0x7a, 0xcc, 0xa2, 0x48, 0x82, 0x52, 0xee, 0x97, 0x36, 0xea, 0x55, 0x83, 0x66, 0xce, 0xf7, 0x2d,
-	0x26, 0x7f, 0x30, 0x0e, 0x99, 0x82, 0x71, 0x04, 0x0b, 0x31, 0x77, 0x0b, 0xa6, 0xa8, 0x9a, 0x92,
-	0xc3, 0x14, 0x90, 0x21, 0xc6, 0xe9, 0x10, 0x2b, 0xeb, 0x16, 0xdb, 0x85, 0x5e, 0x6d, 0xe7, 0xb7,
-	0xf7, 0xc1, 0xd6, 0x3b, 0x02, 0x30, 0x5a, 0x41, 0x35, 0xce, 0x4e, 0x6a, 0x58, 0xbc, 0xbd, 0xfb,
-	0xeb, 0x74, 0xee, 0x11, 0xae, 0xe4, 0x29, 0xe9, 0xe2, 0x86, 0x90, 0x8c, 0xcf, 0xe0, 0x55, 0x0b,
-	0x99, 0x89, 0x75, 0x4b, 0x73, 0xa9, 0x2e, 0x6e, 0x24, 0x52, 0xc4, 0xd3, 0xf7, 0xf6, 0x75, 0x4b,
-	0xf3, 0xb2, 0x63, 0xdc, 0x64, 0x9c, 0x43, 0xa2, 0x21, 0xb2, 0xfe, 0xca, 0x2d, 0x18, 0x43, 0xfa,
-	0x89, 0xa1, 0xf9, 0x9e, 0xb3, 0x29, 0xbf, 0x04, 0x19, 0x34, 0xf2, 0x36, 0x03, 0x55, 0x67, 0x0f,
-	0xd7, 0xde, 0xc4, 0xa4, 0x85, 0x4b, 0xf6, 0x77, 0x58, 0x39, 0x7b, 0x21, 0x3f, 0x70, 0x59, 0x9b,
-	0x3c, 0x93, 0xc9, 0x6e, 0x07, 0x27, 0x8f, 0x1b, 0x8a, 0xd6, 0x1b, 0x8a, 0x9e, 0x37, 0x14, 0xdd,
-	0x6c, 0xa9, 0xb3, 0xde, 0x52, 0xe7, 0x69, 0x4b, 0x9d, 0xf3, 0xff, 0x93, 0xa9, 0xbe, 0x58, 0x86,
-	0x1e, 0x17, 0x73, 0xff, 0x34, 0x15, 0xea, 0x8f, 0xd2, 0x25, 0x72, 0x31, 0xf3, 0x8d, 0x5f, 0x9f,
-	0x0b, 0x09, 0xfe, 0xca, 0x3c, 0x0b, 0x7d, 0x9d, 0x80, 0x0a, 0xcb, 0x66, 0xcb, 0xbb, 0x2f, 0x01,
-	0x00, 0x00, 0xff, 0xff, 0xf9, 0x1c, 0x7d, 0x3e, 0x30, 0x02, 0x00, 0x00,
+	// 503 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0xcf, 0x6e, 0xd3, 0x40,
+	0x10, 0xc6, 0xe3, 0xe6, 0x4f, 0x9b, 0xa9, 0x9a, 0x96, 0x25, 0x02, 0xb7, 0x15, 0x4e, 0x14, 0xa9,
+	0x52, 0x38, 0xc4, 0x26, 0xc0, 0xa9, 0x9c, 0x88, 0x22, 0x2a, 0x21, 0x84, 0x22, 0xab, 0x27, 0x2e,
+	0xd6, 0x7a, 0x33, 0x49, 0xa3, 0xc4, 0x59, 0x6b, 0x77, 0x83, 0x9c, 0xb7, 0xe0, 0xc8, 0x11, 0xde,
+	0x81, 0x87, 0xe8, 0xb1, 0xe2, 0x84, 0x38, 0x54, 0x28, 0x39, 0xf0, 0x1a, 0x68, 0x77, 0x6d, 0x04,
+	0x94, 0x9b, 0xe7, 0xfb, 0x66, 0xc6, 0x33, 0xbf, 0x1d, 0xf0, 0x96, 0x7c, 0xb1, 0x92, 0x81, 0xa2,
+	0x59, 0xf0, 0xbe, 0x1f, 0xa3, 0xa2, 0xfd, 0x20, 0xa5, 0x82, 0x26, 0xd2, 0x4f, 0x05, 0x57, 0x9c,
+	0xdc, 0x33, 0xbe, 0xaf, 0x68, 0xe6, 0xe7, 0xfe, 0x49, 0x73, 0xca, 0xa7, 0xdc, 0xb8, 0x81, 0xfe,
+	0xb2, 0x89, 0x27, 0xc7, 0x8c, 0xcb, 0x84, 0xcb, 0xc8, 0x1a, 0x36, 0xb0, 0x56, 0xe7, 0xe7, 0x0e,
+	0xd4, 0x46, 0xa6, 0x29, 0x39, 0x86, 0xbd, 0x09, 0x62, 0x24, 0xa8, 0x42, 0xd7, 0x69, 0x3b, 0xdd,
+	0x6a, 0xb8, 0x3b, 0x41, 0x0c, 0xa9, 0x42, 0xf2, 0x18, 0x8e, 0x18, 0x5f, 0x2a, 0x41, 0x99, 0x8a,
+	0xe8, 0x78, 0x2c, 0x50, 0x4a, 0x77, 0xa7, 0xed, 0x74, 0xeb, 0xe1, 0x61, 0xa1, 0xbf, 0xb4, 0x32,
+	0x79, 0x04, 0x10, 0x53, 0x89, 0xd1, 0x18, 0x97, 0x3c, 0x71, 0xcb, 0x26, 0xa9, 0xae, 0x95, 0xa1,
+	0x16, 0xc8, 0x39, 0x80, 0xfe, 0x89, 0xdd, 0xc3, 0xad, 0xb4, 0xcb, 0xdd, 0xfd, 0xa7, 0xa7, 0xfe,
+	0x9d, 0x45, 0xfc, 0x57, 0x88, 0x66, 0xac, 0xb0, 0x3e, 0xc9, 0xbf, 0x24, 0xf1, 0xa1, 0x99, 0xd0,
+	0x2c, 0x12, 0xb8, 0xa0, 0xeb, 0x68, 0x4a, 0x65, 0x94, 0xa2, 0x88, 0x54, 0xe6, 0x56, 0xdb, 0x4e,
+	0xb7, 0x12, 0x1e, 0x25, 0x34, 0x0b, 0xb5, 0x75, 0x41, 0xe5, 0x08, 0xc5, 0x65, 0x46, 0x9e, 0xc3,
+	0xc3, 0xbb, 0xf9, 0xf1, 0x82, 0xb3, 0xb9, 0x5b, 0x33, 0x25, 0xf7, 0xff, 0x2e, 0x19, 0x68, 0x8b,
+	0x8c, 0x00, 0xe2, 0x95, 0x58, 0x6a, 0x0e, 0x33, 0xee, 0xee, 0xea, 0x05, 0x06, 0xfd, 0xeb, 0xdb,
+	0x56, 0xe9, 0xfb, 0x6d, 0xeb, 0xd4, 0xb2, 0x93, 0xe3, 0xb9, 0x3f, 0xe3, 0x41, 0x42, 0xd5, 0x95,
+	0xff, 0x06, 0xa7, 0x94, 0xad, 0x87, 0xc8, 0xbe, 0x7e, 0xe9, 0x41, 0x8e, 0x76, 0x88, 0x2c, 0xac,
+	0xeb, 0x26, 0xa1, 0xee, 0x71, 0x5e, 0xf9, 0xf8, 0xa9, 0x55, 0xea, 0x7c, 0x76, 0x60, 0xaf, 0xd8,
+	0x8a, 0x9c, 0x41, 0x83, 0x0b, 0xca, 0x16, 0xf8, 0x1b, 0xa7, 0x63, 0x48, 0x1d, 0x58, 0xb5, 0x80,
+	0x79, 0x06, 0x8d, 0x54, 0xf0, 0xc9, 0xec, 0x5f, 0xea, 0x07, 0x56, 0x2d, 0xd2, 0x2e, 0xe0, 0x90,
+	0x32, 0x86, 0xa9, 0xc2, 0xb1, 0xe5, 0x2e, 0xdd, 0xb2, 0x21, 0xeb, 0xfd, 0x87, 0xac, 0x79, 0x87,
+	0xcb, 0x19, 0x9b, 0xa3, 0x08, 0x1b, 0x45, 0x99, 0x11, 0x65, 0xe7, 0x05, 0xec, 0xff, 0x61, 0x93,
+	0x26, 0x54, 0xed, 0x33, 0xda, 0xe1, 0x6c, 0x40, 0x1e, 0x40, 0x4d, 0x19, 0x3f, 0x1f, 0x26, 0x8f,
+	0x06, 0xaf, 0xaf, 0x37, 0x9e, 0x73, 0xb3, 0xf1, 0x9c, 0x1f, 0x1b, 0xcf, 0xf9, 0xb0, 0xf5, 0x4a,
+	0x37, 0x5b, 0xaf, 0xf4, 0x6d, 0xeb, 0x95, 0xde, 0x3d, 0x99, 0xce, 0xd4, 0xd5, 0x2a, 0xf6, 0x19,
+	0x4f, 0x82, 0xb7, 0x7a, 0xa0, 0xde, 0x48, 0x1f, 0x1f, 0xe3, 0x8b, 0xc0, 0xcc, 0xd7, 0x63, 0x5c,
+	0x60, 0x90, 0x99, 0x4b, 0x57, 0xeb, 0x14, 0x65, 0x5c, 0x33, 0xd7, 0xf9, 0xec, 0xd7, 0x00, 0xf3,
+	0xa2, 0x47, 0x12, 0x03, 0x03, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -261,6 +295,26 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.BurnRatio.Size()
+		i -= size
+		if _, err := m.BurnRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.MaxRelayGasPerBlock != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxRelayGasPerBlock))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.MaxRelayGasPerTx != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxRelayGasPerTx))
+		i--
+		dAtA[i] = 0x28
+	}
 	if len(m.FeeParams) > 0 {
 		for iNdEx := len(m.FeeParams) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -419,6 +473,14 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovParams(uint64(l))
 		}
 	}
+	if m.MaxRelayGasPerTx != 0 {
+		n += 1 + sovParams(uint64(m.MaxRelayGasPerTx))
+	}
+	if m.MaxRelayGasPerBlock != 0 {
+		n += 1 + sovParams(uint64(m.MaxRelayGasPerBlock))
+	}
+	l = m.BurnRatio.Size()
+	n += 1 + l + sovParams(uint64(l))
 	return n
 }
 
@@ -614,6 +676,78 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRelayGasPerTx", wireType)
+			}
+			m.MaxRelayGasPerTx = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRelayGasPerTx |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRelayGasPerBlock", wireType)
+			}
+			m.MaxRelayGasPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRelayGasPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BurnRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
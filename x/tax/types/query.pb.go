@@ -6,22 +6,27 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -112,34 +117,139 @@ func (m *QueryParamsResponse) GetParams() Params {
 	return Params{}
 }
 
+// QueryBurnedSupplyRequest is request type for the Query/BurnedSupply RPC
+// method.
+type QueryBurnedSupplyRequest struct {
+}
+
+func (m *QueryBurnedSupplyRequest) Reset()         { *m = QueryBurnedSupplyRequest{} }
+func (m *QueryBurnedSupplyRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBurnedSupplyRequest) ProtoMessage()    {}
+func (*QueryBurnedSupplyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73fe7ebb900d9dc3, []int{2}
+}
+func (m *QueryBurnedSupplyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBurnedSupplyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBurnedSupplyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBurnedSupplyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBurnedSupplyRequest.Merge(m, src)
+}
+func (m *QueryBurnedSupplyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBurnedSupplyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBurnedSupplyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBurnedSupplyRequest proto.InternalMessageInfo
+
+// QueryBurnedSupplyResponse is response type for the Query/BurnedSupply RPC
+// method.
+type QueryBurnedSupplyResponse struct {
+	// burned is the total amount burned, in the current base denom.
+	Burned types.Coin `protobuf:"bytes,1,opt,name=burned,proto3" json:"burned"`
+	// since is the block time of the first burn.
+	Since time.Time `protobuf:"bytes,2,opt,name=since,proto3,stdtime" json:"since"`
+}
+
+func (m *QueryBurnedSupplyResponse) Reset()         { *m = QueryBurnedSupplyResponse{} }
+func (m *QueryBurnedSupplyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBurnedSupplyResponse) ProtoMessage()    {}
+func (*QueryBurnedSupplyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73fe7ebb900d9dc3, []int{3}
+}
+func (m *QueryBurnedSupplyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBurnedSupplyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBurnedSupplyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBurnedSupplyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBurnedSupplyResponse.Merge(m, src)
+}
+func (m *QueryBurnedSupplyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBurnedSupplyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBurnedSupplyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBurnedSupplyResponse proto.InternalMessageInfo
+
+func (m *QueryBurnedSupplyResponse) GetBurned() types.Coin {
+	if m != nil {
+		return m.Burned
+	}
+	return types.Coin{}
+}
+
+func (m *QueryBurnedSupplyResponse) GetSince() time.Time {
+	if m != nil {
+		return m.Since
+	}
+	return time.Time{}
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "nolus.tax.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "nolus.tax.v1beta1.QueryParamsResponse")
+	proto.RegisterType((*QueryBurnedSupplyRequest)(nil), "nolus.tax.v1beta1.QueryBurnedSupplyRequest")
+	proto.RegisterType((*QueryBurnedSupplyResponse)(nil), "nolus.tax.v1beta1.QueryBurnedSupplyResponse")
 }
 
 func init() { proto.RegisterFile("nolus/tax/v1beta1/query.proto", fileDescriptor_73fe7ebb900d9dc3) }
 
 var fileDescriptor_73fe7ebb900d9dc3 = []byte{
-	// 293 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xcd, 0xcb, 0xcf, 0x29,
-	0x2d, 0xd6, 0x2f, 0x49, 0xac, 0xd0, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4, 0x2f, 0x2c,
-	0x4d, 0x2d, 0xaa, 0xd4, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x04, 0x4b, 0xeb, 0x95, 0x24,
-	0x56, 0xe8, 0x41, 0xa5, 0xa5, 0x44, 0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0xb2, 0xfa, 0x20, 0x16, 0x44,
-	0xa1, 0x94, 0x4c, 0x7a, 0x7e, 0x7e, 0x7a, 0x4e, 0xaa, 0x7e, 0x62, 0x41, 0xa6, 0x7e, 0x62, 0x5e,
-	0x5e, 0x7e, 0x49, 0x62, 0x49, 0x66, 0x7e, 0x5e, 0x31, 0x54, 0x56, 0x0e, 0xd3, 0x96, 0x82, 0xc4,
-	0xa2, 0xc4, 0x5c, 0xa8, 0xbc, 0x92, 0x08, 0x97, 0x50, 0x20, 0xc8, 0xd6, 0x00, 0xb0, 0x60, 0x50,
-	0x6a, 0x61, 0x69, 0x6a, 0x71, 0x89, 0x92, 0x1f, 0x97, 0x30, 0x8a, 0x68, 0x71, 0x41, 0x7e, 0x5e,
-	0x71, 0xaa, 0x90, 0x39, 0x17, 0x1b, 0x44, 0xb3, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0xb7, 0x91, 0xa4,
-	0x1e, 0x86, 0x23, 0xf5, 0x20, 0x5a, 0x9c, 0x58, 0x4e, 0xdc, 0x93, 0x67, 0x08, 0x82, 0x2a, 0x37,
-	0x6a, 0x65, 0xe4, 0x62, 0x05, 0x1b, 0x28, 0x54, 0xc3, 0xc5, 0x06, 0x51, 0x21, 0xa4, 0x8a, 0x45,
-	0x33, 0xa6, 0x53, 0xa4, 0xd4, 0x08, 0x29, 0x83, 0xb8, 0x4d, 0x49, 0xb9, 0xe9, 0xf2, 0x93, 0xc9,
-	0x4c, 0xb2, 0x42, 0xd2, 0xfa, 0x79, 0xf9, 0xb9, 0xf9, 0xfa, 0x60, 0x4d, 0xba, 0xc9, 0xf9, 0x45,
-	0xa9, 0x60, 0xbf, 0x43, 0xdc, 0xe1, 0xe4, 0x75, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c,
-	0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72,
-	0x0c, 0x51, 0x06, 0xe9, 0x99, 0x25, 0x19, 0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0x7e, 0x60,
-	0xbd, 0x01, 0xa0, 0xf0, 0x49, 0xce, 0xcf, 0x41, 0x36, 0xaa, 0x02, 0x6c, 0x58, 0x49, 0x65, 0x41,
-	0x6a, 0x71, 0x12, 0x1b, 0x38, 0x00, 0x8d, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0xae, 0xb4, 0x14,
-	0xec, 0xc8, 0x01, 0x00, 0x00,
+	// 433 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x51, 0xbd, 0xae, 0xd3, 0x30,
+	0x14, 0x8e, 0x2b, 0x6e, 0x85, 0x0c, 0x0b, 0xe6, 0x0e, 0xbd, 0x81, 0x9b, 0xa2, 0xf0, 0x23, 0xc4,
+	0x8f, 0xcd, 0x2d, 0x03, 0x12, 0x63, 0xd8, 0x18, 0xaa, 0x52, 0x98, 0x58, 0x90, 0x13, 0x4c, 0x88,
+	0x94, 0xf8, 0xb8, 0xb1, 0x83, 0x5a, 0x89, 0x89, 0x27, 0xa8, 0x84, 0x18, 0x79, 0x9f, 0x8e, 0x95,
+	0x58, 0x98, 0x00, 0xb5, 0x3c, 0x08, 0x8a, 0x9d, 0x94, 0xa2, 0x06, 0xc1, 0x96, 0x9c, 0xef, 0x7c,
+	0xe7, 0xfb, 0x31, 0x3e, 0x95, 0x90, 0x57, 0x9a, 0x19, 0x3e, 0x67, 0xef, 0xce, 0x62, 0x61, 0xf8,
+	0x19, 0x9b, 0x55, 0xa2, 0x5c, 0x50, 0x55, 0x82, 0x01, 0x72, 0xc9, 0xc2, 0xd4, 0xf0, 0x39, 0x6d,
+	0x60, 0xff, 0x38, 0x85, 0x14, 0x2c, 0xca, 0xea, 0x2f, 0xb7, 0xe8, 0x5f, 0x4d, 0x01, 0xd2, 0x5c,
+	0x30, 0xae, 0x32, 0xc6, 0xa5, 0x04, 0xc3, 0x4d, 0x06, 0x52, 0x37, 0xe8, 0xb0, 0x41, 0xed, 0x5f,
+	0x5c, 0xbd, 0x61, 0x26, 0x2b, 0x84, 0x36, 0xbc, 0x50, 0xcd, 0x42, 0x90, 0x80, 0x2e, 0x40, 0xb3,
+	0x98, 0x6b, 0xb1, 0x33, 0x92, 0x40, 0x26, 0x5b, 0xfc, 0xd0, 0xa6, 0xe2, 0x25, 0x2f, 0x1a, 0x81,
+	0xf0, 0x18, 0x93, 0x67, 0xb5, 0xed, 0x89, 0x1d, 0x4e, 0xc5, 0xac, 0x12, 0xda, 0x84, 0x63, 0x7c,
+	0xf9, 0x8f, 0xa9, 0x56, 0x20, 0xb5, 0x20, 0x8f, 0x70, 0xdf, 0x91, 0x07, 0xe8, 0x1a, 0xba, 0x7d,
+	0x61, 0x74, 0x42, 0x0f, 0x52, 0x52, 0x47, 0x89, 0xce, 0xad, 0xbe, 0x0d, 0xbd, 0x69, 0xb3, 0x1e,
+	0xfa, 0x78, 0x60, 0xef, 0x45, 0x55, 0x29, 0xc5, 0xeb, 0xe7, 0x95, 0x52, 0xf9, 0xa2, 0xd5, 0x5a,
+	0x22, 0x7c, 0xd2, 0x01, 0xfe, 0x96, 0x8c, 0xed, 0x7c, 0x27, 0xe9, 0x02, 0xd3, 0x3a, 0xf0, 0x4e,
+	0xf4, 0x09, 0x64, 0xb2, 0x95, 0x74, 0xeb, 0xe4, 0x31, 0x3e, 0xd2, 0x99, 0x4c, 0xc4, 0xa0, 0x67,
+	0x79, 0x3e, 0x75, 0x4d, 0xd2, 0xb6, 0x49, 0xfa, 0xa2, 0x6d, 0x32, 0x3a, 0x5f, 0x13, 0x97, 0xdf,
+	0x87, 0x68, 0xea, 0x28, 0xa3, 0xcf, 0x3d, 0x7c, 0x64, 0x2d, 0x91, 0xf7, 0xb8, 0xef, 0x02, 0x91,
+	0x9b, 0x1d, 0x59, 0x0f, 0x9b, 0xf3, 0x6f, 0xfd, 0x6b, 0xcd, 0xe5, 0x0a, 0xaf, 0x7f, 0xf8, 0xf2,
+	0xf3, 0x63, 0xef, 0x94, 0x5c, 0x61, 0x12, 0x0a, 0x60, 0x96, 0x74, 0x3f, 0x81, 0x52, 0xd8, 0xa7,
+	0x72, 0xb5, 0x91, 0x4f, 0x08, 0x5f, 0xdc, 0x6f, 0x85, 0xdc, 0xfd, 0xdb, 0xf5, 0x8e, 0x62, 0xfd,
+	0x7b, 0xff, 0xb7, 0xdc, 0x18, 0xba, 0x63, 0x0d, 0xdd, 0x20, 0x61, 0xa7, 0x21, 0x57, 0xea, 0x2b,
+	0x6d, 0x39, 0xd1, 0xd3, 0xd5, 0x26, 0x40, 0xeb, 0x4d, 0x80, 0x7e, 0x6c, 0x02, 0xb4, 0xdc, 0x06,
+	0xde, 0x7a, 0x1b, 0x78, 0x5f, 0xb7, 0x81, 0xf7, 0xf2, 0x41, 0x9a, 0x99, 0xb7, 0x55, 0x4c, 0x13,
+	0x28, 0xd8, 0xd8, 0x9e, 0x98, 0xd4, 0x7d, 0x27, 0x90, 0xef, 0x5f, 0x9c, 0xdb, 0x9b, 0x66, 0xa1,
+	0x84, 0x8e, 0xfb, 0xf6, 0x41, 0x1e, 0xfe, 0x1a, 0x00, 0x38, 0xa6, 0xed, 0x1a, 0x50, 0x03, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -156,6 +266,8 @@ const _ = grpc.SupportPackageIsVersion4
 type QueryClient interface {
 	// Parameters queries the parameters of the module.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// BurnedSupply queries the total of the base denom burned from the tax.
+	BurnedSupply(ctx context.Context, in *QueryBurnedSupplyRequest, opts ...grpc.CallOption) (*QueryBurnedSupplyResponse, error)
 }
 
 type queryClient struct {
@@ -175,10 +287,21 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) BurnedSupply(ctx context.Context, in *QueryBurnedSupplyRequest, opts ...grpc.CallOption) (*QueryBurnedSupplyResponse, error) {
+	out := new(QueryBurnedSupplyResponse)
+	err := c.cc.Invoke(ctx, "/nolus.tax.v1beta1.Query/BurnedSupply", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Parameters queries the parameters of the module.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// BurnedSupply queries the total of the base denom burned from the tax.
+	BurnedSupply(context.Context, *QueryBurnedSupplyRequest) (*QueryBurnedSupplyResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -188,6 +311,9 @@ type UnimplementedQueryServer struct {
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (*UnimplementedQueryServer) BurnedSupply(ctx context.Context, req *QueryBurnedSupplyRequest) (*QueryBurnedSupplyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BurnedSupply not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -211,6 +337,24 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_BurnedSupply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBurnedSupplyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BurnedSupply(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nolus.tax.v1beta1.Query/BurnedSupply",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BurnedSupply(ctx, req.(*QueryBurnedSupplyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "nolus.tax.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -219,6 +363,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "BurnedSupply",
+			Handler:    _Query_BurnedSupply_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "nolus/tax/v1beta1/query.proto",
@@ -280,6 +428,70 @@ func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryBurnedSupplyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBurnedSupplyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBurnedSupplyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBurnedSupplyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBurnedSupplyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBurnedSupplyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Since, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Since):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintQuery(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Burned.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -311,6 +523,28 @@ func (m *QueryParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryBurnedSupplyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryBurnedSupplyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Burned.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Since)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -450,6 +684,172 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryBurnedSupplyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBurnedSupplyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBurnedSupplyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBurnedSupplyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBurnedSupplyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBurnedSupplyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Burned", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Burned.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Since", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Since, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,52 @@
+package tokenfactory
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/bank/exported"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/Nolus-Protocol/nolus-core/x/tokenfactory/keeper"
+)
+
+// BankAppModule is the bank module of the app. Its messages are handled by the bank keeper
+// calling the before send hooks, which the bank module does not accept as its keeper.
+type BankAppModule struct {
+	bank.AppModule
+
+	keeper         keeper.BankKeeper
+	legacySubspace exported.Subspace
+}
+
+// NewBankAppModule creates the bank module handling its messages with the hooked bank keeper.
+func NewBankAppModule(cdc codec.Codec, keeper keeper.BankKeeper, accountKeeper authkeeper.AccountKeeper, ss exported.Subspace) BankAppModule {
+	return BankAppModule{
+		AppModule:      bank.NewAppModule(cdc, keeper.BaseKeeper, accountKeeper, ss),
+		keeper:         keeper,
+		legacySubspace: ss,
+	}
+}
+
+// RegisterServices registers the bank services with the hooked bank keeper.
+func (am BankAppModule) RegisterServices(cfg module.Configurator) {
+	banktypes.RegisterMsgServer(cfg.MsgServer(), bankkeeper.NewMsgServerImpl(am.keeper))
+	banktypes.RegisterQueryServer(cfg.QueryServer(), am.keeper)
+
+	m := bankkeeper.NewMigrator(am.keeper.BaseKeeper, am.legacySubspace)
+	if err := cfg.RegisterMigration(banktypes.ModuleName, 1, m.Migrate1to2); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/bank from version 1 to 2: %v", err))
+	}
+
+	if err := cfg.RegisterMigration(banktypes.ModuleName, 2, m.Migrate2to3); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/bank from version 2 to 3: %v", err))
+	}
+
+	if err := cfg.RegisterMigration(banktypes.ModuleName, 3, m.Migrate3to4); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/bank from version 3 to 4: %v", err))
+	}
+}
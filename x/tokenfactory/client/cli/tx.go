@@ -0,0 +1,195 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/spf13/cobra"
+
+	"github.com/Nolus-Protocol/nolus-core/x/tokenfactory/types"
+)
+
+// FlagMintTo is the flag of the address receiving the minted tokens.
+const FlagMintTo = "mint-to"
+
+// GetTxCmd returns the transaction commands for this module.
+func GetTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("%s transactions subcommands", types.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(CmdCreateDenom())
+	cmd.AddCommand(CmdMint())
+	cmd.AddCommand(CmdBurn())
+	cmd.AddCommand(CmdChangeAdmin())
+	cmd.AddCommand(CmdSetDenomMetadata())
+	cmd.AddCommand(CmdSetBeforeSendHook())
+
+	return cmd
+}
+
+func CmdCreateDenom() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-denom [subdenom]",
+		Short: "Create the denom factory/{sender}/{subdenom}.",
+		Long: `Create the denom factory/{sender}/{subdenom} with the sender as its admin. The
+sender pays the denom creation fee to the treasury.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreateDenom(clientCtx.GetFromAddress().String(), args[0])
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdMint() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "mint [amount]",
+		Short: "Mint tokens of a denom.",
+		Long: `Mint tokens of a denom to the address of the '--mint-to' flag, or to the
+sender without it. Must be signed by the admin of the denom.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			mintTo, err := cmd.Flags().GetString(FlagMintTo)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgMint(clientCtx.GetFromAddress().String(), amount, mintTo)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().String(FlagMintTo, "", "Address receiving the minted tokens, the sender if empty")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdBurn() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "burn [amount]",
+		Short: "Burn tokens of a denom.",
+		Long:  `Burn tokens of the sender of a denom. Must be signed by the admin of the denom.`,
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgBurn(clientCtx.GetFromAddress().String(), amount)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdChangeAdmin() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "change-admin [denom] [new-admin]",
+		Short: "Change the admin of a denom.",
+		Long: `Change the admin of a denom. An empty new admin renounces the admin for good.
+Must be signed by the admin of the denom.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgChangeAdmin(clientCtx.GetFromAddress().String(), args[0], args[1])
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdSetDenomMetadata() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-denom-metadata [metadata-file]",
+		Short: "Set the bank metadata of a denom.",
+		Long: `Set the bank metadata of a denom from a JSON file. The base of the metadata is
+the denom. Must be signed by the admin of the denom.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			bz, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			var metadata banktypes.Metadata
+			if err := clientCtx.Codec.UnmarshalJSON(bz, &metadata); err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetDenomMetadata(clientCtx.GetFromAddress().String(), metadata)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdSetBeforeSendHook() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-before-send-hook [denom] [contract]",
+		Short: "Set the contract called before a denom is sent.",
+		Long: `Set the contract called before a denom is sent. An empty contract removes the
+hook. Must be signed by the admin of the denom.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetBeforeSendHook(clientCtx.GetFromAddress().String(), args[0], args[1])
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,27 @@
+package tokenfactory
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Nolus-Protocol/nolus-core/x/tokenfactory/keeper"
+	"github.com/Nolus-Protocol/nolus-core/x/tokenfactory/types"
+)
+
+// InitGenesis initializes the tokenfactory module's state from a provided genesis
+// state.
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState) {
+	if err := k.SetParams(ctx, genState.Params); err != nil {
+		ctx.Logger().Error("failed to set tokenfactory module params", "error", err)
+	}
+
+	for _, denom := range genState.FactoryDenoms {
+		if err := k.InitDenom(ctx, denom); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// ExportGenesis returns the tokenfactory module's exported genesis.
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	return types.NewGenesisState(k.GetParams(ctx), k.GetAllDenoms(ctx))
+}
@@ -0,0 +1,53 @@
+package tokenfactory_test
+
+import (
+	"testing"
+	"time"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Nolus-Protocol/nolus-core/app/params"
+	simulationapp "github.com/Nolus-Protocol/nolus-core/testutil/simapp"
+	"github.com/Nolus-Protocol/nolus-core/x/tokenfactory"
+	"github.com/Nolus-Protocol/nolus-core/x/tokenfactory/types"
+)
+
+func TestGenesis(t *testing.T) {
+	_ = params.SetAddressPrefixes()
+	app, err := simulationapp.TestSetup(t)
+	require.NoError(t, err)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{}).WithBlockTime(time.Now())
+
+	creator := sdk.AccAddress("creator_____________").String()
+	genesisState := types.GenesisState{
+		Params: types.NewParams(sdk.NewCoins(sdk.NewInt64Coin(params.BaseCoinUnit, 1000))),
+		FactoryDenoms: []types.GenesisDenom{
+			{
+				Denom:             "factory/" + creator + "/lp",
+				AuthorityMetadata: types.DenomAuthorityMetadata{Admin: creator},
+			},
+			{
+				Denom:             "factory/" + creator + "/receipt",
+				AuthorityMetadata: types.DenomAuthorityMetadata{},
+				BeforeSendHook:    sdk.AccAddress("contract____________").String(),
+			},
+		},
+	}
+	require.NoError(t, genesisState.Validate())
+
+	tokenfactory.InitGenesis(ctx, *app.TokenFactoryKeeper, genesisState)
+
+	// the denoms are described to the bank module
+	for _, denom := range genesisState.FactoryDenoms {
+		metadata, found := app.BankKeeper.GetDenomMetaData(ctx, denom.Denom)
+		require.True(t, found)
+		require.NoError(t, metadata.Validate())
+	}
+
+	got := tokenfactory.ExportGenesis(ctx, *app.TokenFactoryKeeper)
+	require.NotNil(t, got)
+	require.Equal(t, genesisState.Params, got.Params)
+	require.Equal(t, genesisState.FactoryDenoms, got.FactoryDenoms)
+}
@@ -0,0 +1,92 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/Nolus-Protocol/nolus-core/x/tokenfactory/types"
+)
+
+// BankKeeper is the bank keeper of the app. It calls the bank hooks before sending tokens from
+// or to an account, so that the before send hooks of the token factory denoms may reject the
+// send. Sends between module accounts, delegations and undelegations are not hooked.
+type BankKeeper struct {
+	bankkeeper.BaseKeeper
+
+	hooks types.BankHooks
+}
+
+// NewBankKeeper wraps the bank keeper with the bank hooks set later by SetHooks.
+func NewBankKeeper(keeper bankkeeper.BaseKeeper) BankKeeper {
+	return BankKeeper{BaseKeeper: keeper}
+}
+
+// SetHooks sets the bank hooks. It panics if they are already set.
+func (k *BankKeeper) SetHooks(hooks types.BankHooks) {
+	if k.hooks != nil {
+		panic("cannot set bank hooks twice")
+	}
+
+	k.hooks = hooks
+}
+
+// SendCoins calls the bank hooks and sends the coins if they allow it.
+func (k BankKeeper) SendCoins(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	if err := k.blockBeforeSend(ctx, fromAddr, toAddr, amt); err != nil {
+		return err
+	}
+
+	return k.BaseKeeper.SendCoins(ctx, fromAddr, toAddr, amt)
+}
+
+// InputOutputCoins calls the bank hooks for each of the outputs and sends the coins if they
+// allow it.
+func (k BankKeeper) InputOutputCoins(ctx sdk.Context, inputs []banktypes.Input, outputs []banktypes.Output) error {
+	for _, in := range inputs {
+		fromAddr, err := sdk.AccAddressFromBech32(in.Address)
+		if err != nil {
+			return err
+		}
+
+		for _, out := range outputs {
+			toAddr, err := sdk.AccAddressFromBech32(out.Address)
+			if err != nil {
+				return err
+			}
+
+			if err := k.blockBeforeSend(ctx, fromAddr, toAddr, out.Coins); err != nil {
+				return err
+			}
+		}
+	}
+
+	return k.BaseKeeper.InputOutputCoins(ctx, inputs, outputs)
+}
+
+// SendCoinsFromModuleToAccount calls the bank hooks and sends the coins if they allow it.
+func (k BankKeeper) SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error {
+	if err := k.blockBeforeSend(ctx, authtypes.NewModuleAddress(senderModule), recipientAddr, amt); err != nil {
+		return err
+	}
+
+	return k.BaseKeeper.SendCoinsFromModuleToAccount(ctx, senderModule, recipientAddr, amt)
+}
+
+// SendCoinsFromAccountToModule calls the bank hooks and sends the coins if they allow it.
+func (k BankKeeper) SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error {
+	if err := k.blockBeforeSend(ctx, senderAddr, authtypes.NewModuleAddress(recipientModule), amt); err != nil {
+		return err
+	}
+
+	return k.BaseKeeper.SendCoinsFromAccountToModule(ctx, senderAddr, recipientModule, amt)
+}
+
+func (k BankKeeper) blockBeforeSend(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	if k.hooks == nil {
+		return nil
+	}
+
+	return k.hooks.BlockBeforeSend(ctx, fromAddr, toAddr, amt)
+}
@@ -0,0 +1,109 @@
+package keeper
+
+import (
+	"encoding/json"
+
+	errorsmod "cosmossdk.io/errors"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/Nolus-Protocol/nolus-core/x/tokenfactory/types"
+)
+
+// GetBeforeSendHook returns the contract called before the denom is sent, or an empty string
+// if the denom has no before send hook.
+func (k Keeper) GetBeforeSendHook(ctx sdk.Context, denom string) string {
+	return string(ctx.KVStore(k.storeKey).Get(types.GetBeforeSendHookKey(denom)))
+}
+
+// SetBeforeSendHook sets the contract called before the denom is sent. An empty contract
+// address removes the hook.
+func (k Keeper) SetBeforeSendHook(ctx sdk.Context, denom, contractAddr string) {
+	store := ctx.KVStore(k.storeKey)
+	if contractAddr == "" {
+		store.Delete(types.GetBeforeSendHookKey(denom))
+		return
+	}
+
+	store.Set(types.GetBeforeSendHookKey(denom), []byte(contractAddr))
+}
+
+var _ types.BankHooks = Hooks{}
+
+// Hooks calls the before send hooks of the token factory denoms.
+type Hooks struct {
+	k Keeper
+}
+
+// Hooks returns the bank hooks of the token factory.
+func (k Keeper) Hooks() Hooks {
+	return Hooks{k}
+}
+
+// BlockBeforeSend calls the before send hook of each of the sent coins which has one. The send
+// is rejected if any of the hooks returns an error.
+func (h Hooks) BlockBeforeSend(ctx sdk.Context, from, to sdk.AccAddress, amount sdk.Coins) error {
+	for _, coin := range amount {
+		if !types.IsFactoryDenom(coin.Denom) {
+			continue
+		}
+
+		hook := h.k.GetBeforeSendHook(ctx, coin.Denom)
+		if hook == "" {
+			continue
+		}
+
+		if err := h.k.callBeforeSendHook(ctx, hook, from, to, coin); err != nil {
+			return errorsmod.Wrapf(types.ErrBeforeSendHookRejected, "denom %s: %s", coin.Denom, err)
+		}
+	}
+
+	return nil
+}
+
+// callBeforeSendHook sudo calls the hook with at most BeforeSendHookGasLimit gas. The state
+// changes of the hook are discarded if it fails.
+func (k Keeper) callBeforeSendHook(ctx sdk.Context, hook string, from, to sdk.AccAddress, coin sdk.Coin) (err error) {
+	contract, err := sdk.AccAddressFromBech32(hook)
+	if err != nil {
+		return err
+	}
+
+	msg, err := json.Marshal(types.BlockBeforeSendSudoMsg{
+		BlockBeforeSend: types.BlockBeforeSendMsg{
+			From:   from.String(),
+			To:     to.String(),
+			Amount: wasmvmtypes.Coin{Denom: coin.Denom, Amount: coin.Amount.String()},
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	gasLimit := types.BeforeSendHookGasLimit
+	if remaining := ctx.GasMeter().GasRemaining(); remaining < gasLimit {
+		gasLimit = remaining
+	}
+
+	hookCtx, writeCache := ctx.WithGasMeter(sdk.NewGasMeter(gasLimit)).WithEventManager(sdk.NewEventManager()).CacheContext()
+	defer func() {
+		r := recover()
+		ctx.GasMeter().ConsumeGas(hookCtx.GasMeter().GasConsumedToLimit(), "before send hook")
+		if r == nil {
+			return
+		}
+
+		if _, ok := r.(sdk.ErrorOutOfGas); !ok {
+			panic(r)
+		}
+		err = errorsmod.Wrapf(sdkerrors.ErrOutOfGas, "hook %s exceeded the gas limit of %d", hook, gasLimit)
+	}()
+
+	if _, err := k.contractKeeper.Sudo(hookCtx, contract, msg); err != nil {
+		return err
+	}
+
+	writeCache()
+	return nil
+}
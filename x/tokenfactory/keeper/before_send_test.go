@@ -0,0 +1,108 @@
+package keeper_test
+
+import (
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/Nolus-Protocol/nolus-core/x/tokenfactory/types"
+)
+
+func (s *KeeperTestSuite) TestMsgSetBeforeSendHook() {
+	admin := sdk.AccAddress("admin_______________")
+	contract := sdk.AccAddress("contract____________").String()
+	denom := s.createDenom(admin, "lp")
+	ctx := sdk.WrapSDKContext(s.ctx)
+
+	_, err := s.msgServer.SetBeforeSendHook(ctx, types.NewMsgSetBeforeSendHook(admin.String(), denom, contract))
+	s.Require().ErrorIs(err, types.ErrContractNotFound)
+
+	s.contractKeeper.contracts[contract] = true
+	_, err = s.msgServer.SetBeforeSendHook(ctx, types.NewMsgSetBeforeSendHook(sdk.AccAddress("other_______________").String(), denom, contract))
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+	_, err = s.msgServer.SetBeforeSendHook(ctx, types.NewMsgSetBeforeSendHook(admin.String(), denom, contract))
+	s.Require().NoError(err)
+	s.Require().Equal(contract, s.keeper.GetBeforeSendHook(s.ctx, denom))
+
+	// an empty contract removes the hook
+	_, err = s.msgServer.SetBeforeSendHook(ctx, types.NewMsgSetBeforeSendHook(admin.String(), denom, ""))
+	s.Require().NoError(err)
+	s.Require().Empty(s.keeper.GetBeforeSendHook(s.ctx, denom))
+}
+
+func (s *KeeperTestSuite) TestBeforeSendHook() {
+	admin := sdk.AccAddress("admin_______________")
+	receiver := sdk.AccAddress("receiver____________")
+	contract := sdk.AccAddress("contract____________").String()
+	denom := s.createDenom(admin, "lp")
+	ctx := sdk.WrapSDKContext(s.ctx)
+
+	s.contractKeeper.contracts[contract] = true
+	_, err := s.msgServer.SetBeforeSendHook(ctx, types.NewMsgSetBeforeSendHook(admin.String(), denom, contract))
+	s.Require().NoError(err)
+
+	// the hook is called for the mint, which is a send from the module account
+	_, err = s.msgServer.Mint(ctx, types.NewMsgMint(admin.String(), sdk.NewInt64Coin(denom, 100), ""))
+	s.Require().NoError(err)
+	s.Require().Len(s.contractKeeper.calls, 1)
+
+	s.contractKeeper.calls = nil
+	coins := sdk.NewCoins(sdk.NewInt64Coin(denom, 10), sdk.NewInt64Coin("unls", 5))
+	s.fund(admin, sdk.NewCoins(sdk.NewInt64Coin("unls", 5)))
+	s.Require().NoError(s.bankKeeper.SendCoins(s.ctx, admin, receiver, coins))
+	s.Require().Equal([]string{
+		fmt.Sprintf(`{"block_before_send":{"from":"%s","to":"%s","amount":{"denom":"%s","amount":"10"}}}`, admin, receiver, denom),
+	}, s.contractKeeper.calls)
+
+	// the hook rejects the sends by returning an error, and its state changes are discarded
+	key := []byte("hook")
+	s.contractKeeper.sudo = func(ctx sdk.Context, _ sdk.AccAddress, _ []byte) error {
+		ctx.KVStore(s.app.GetKey(types.StoreKey)).Set(key, []byte{1})
+		return errors.New("frozen")
+	}
+	err = s.bankKeeper.SendCoins(s.ctx, admin, receiver, coins)
+	s.Require().ErrorIs(err, types.ErrBeforeSendHookRejected)
+	err = s.bankKeeper.InputOutputCoins(s.ctx,
+		[]banktypes.Input{banktypes.NewInput(admin, coins)},
+		[]banktypes.Output{banktypes.NewOutput(receiver, coins)},
+	)
+	s.Require().ErrorIs(err, types.ErrBeforeSendHookRejected)
+	_, err = s.msgServer.Burn(ctx, types.NewMsgBurn(admin.String(), sdk.NewInt64Coin(denom, 10)))
+	s.Require().ErrorIs(err, types.ErrBeforeSendHookRejected)
+	s.Require().False(s.ctx.KVStore(s.app.GetKey(types.StoreKey)).Has(key))
+	s.Require().Equal(int64(90), s.app.BankKeeper.GetBalance(s.ctx, admin, denom).Amount.Int64())
+
+	// the sends of the other denoms are not hooked
+	s.Require().NoError(s.bankKeeper.SendCoins(s.ctx, receiver, admin, sdk.NewCoins(sdk.NewInt64Coin("unls", 5))))
+}
+
+func (s *KeeperTestSuite) TestBeforeSendHookGasLimit() {
+	admin := sdk.AccAddress("admin_______________")
+	contract := sdk.AccAddress("contract____________").String()
+	denom := s.createDenom(admin, "lp")
+	ctx := sdk.WrapSDKContext(s.ctx)
+
+	s.contractKeeper.contracts[contract] = true
+	_, err := s.msgServer.SetBeforeSendHook(ctx, types.NewMsgSetBeforeSendHook(admin.String(), denom, contract))
+	s.Require().NoError(err)
+
+	s.contractKeeper.sudo = func(ctx sdk.Context, _ sdk.AccAddress, _ []byte) error {
+		ctx.GasMeter().ConsumeGas(types.BeforeSendHookGasLimit+1, "loop")
+		return nil
+	}
+
+	gasMeter := sdk.NewGasMeter(10 * types.BeforeSendHookGasLimit)
+	_, err = s.msgServer.Mint(sdk.WrapSDKContext(s.ctx.WithGasMeter(gasMeter)), types.NewMsgMint(admin.String(), sdk.NewInt64Coin(denom, 100), ""))
+	s.Require().ErrorIs(err, types.ErrBeforeSendHookRejected)
+	s.Require().ErrorContains(err, sdkerrors.ErrOutOfGas.Error())
+	s.Require().GreaterOrEqual(gasMeter.GasConsumed(), types.BeforeSendHookGasLimit)
+
+	// the hook may not use more gas than the sender has left
+	gasMeter = sdk.NewGasMeter(types.BeforeSendHookGasLimit / 2)
+	err = s.bankKeeper.SendCoins(s.ctx.WithGasMeter(gasMeter), admin, admin, sdk.NewCoins(sdk.NewInt64Coin(denom, 1)))
+	s.Require().ErrorIs(err, types.ErrBeforeSendHookRejected)
+	s.Require().True(gasMeter.IsOutOfGas())
+}
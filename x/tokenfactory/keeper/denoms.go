@@ -0,0 +1,141 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/Nolus-Protocol/nolus-core/x/tokenfactory/types"
+)
+
+// CreateDenom creates the denom factory/{creator}/{subdenom} with the creator as its admin. The
+// creator pays the denom creation fee to the treasury contract of the tax module.
+func (k Keeper) CreateDenom(ctx sdk.Context, creator sdk.AccAddress, subdenom string) (string, error) {
+	denom, err := types.GetTokenDenom(creator.String(), subdenom)
+	if err != nil {
+		return "", err
+	}
+
+	if _, found := k.GetAuthorityMetadata(ctx, denom); found {
+		return "", errorsmod.Wrapf(types.ErrDenomExists, "denom %s", denom)
+	}
+
+	if err := k.chargeDenomCreationFee(ctx, creator); err != nil {
+		return "", err
+	}
+
+	k.createDenom(ctx, denom, subdenom, types.DenomAuthorityMetadata{Admin: creator.String()})
+
+	return denom, nil
+}
+
+// createDenom stores the authorities of the denom and describes it to the bank module, unless
+// its metadata is already set.
+func (k Keeper) createDenom(ctx sdk.Context, denom, subdenom string, authorityMetadata types.DenomAuthorityMetadata) {
+	if _, found := k.bankKeeper.GetDenomMetaData(ctx, denom); !found {
+		k.bankKeeper.SetDenomMetaData(ctx, banktypes.Metadata{
+			DenomUnits: []*banktypes.DenomUnit{{Denom: denom, Exponent: 0}},
+			Base:       denom,
+			Display:    denom,
+			Name:       denom,
+			Symbol:     subdenom,
+		})
+	}
+
+	k.SetAuthorityMetadata(ctx, denom, authorityMetadata)
+}
+
+// InitDenom restores a denom of the genesis along with its authorities and before send hook.
+func (k Keeper) InitDenom(ctx sdk.Context, denom types.GenesisDenom) error {
+	_, subdenom, err := types.DeconstructDenom(denom.Denom)
+	if err != nil {
+		return err
+	}
+
+	k.createDenom(ctx, denom.Denom, subdenom, denom.AuthorityMetadata)
+	k.SetBeforeSendHook(ctx, denom.Denom, denom.BeforeSendHook)
+
+	return nil
+}
+
+func (k Keeper) chargeDenomCreationFee(ctx sdk.Context, creator sdk.AccAddress) error {
+	fee := k.GetParams(ctx).DenomCreationFee
+	if fee.IsZero() {
+		return nil
+	}
+
+	treasury, err := sdk.AccAddressFromBech32(k.taxKeeper.ContractAddress(ctx))
+	if err != nil {
+		return errorsmod.Wrap(types.ErrNoTreasury, err.Error())
+	}
+
+	return k.bankKeeper.SendCoins(ctx, creator, treasury, fee)
+}
+
+// GetAuthorityMetadata returns the authorities of a denom created by the token factory.
+func (k Keeper) GetAuthorityMetadata(ctx sdk.Context, denom string) (types.DenomAuthorityMetadata, bool) {
+	var metadata types.DenomAuthorityMetadata
+
+	bz := ctx.KVStore(k.storeKey).Get(types.GetDenomAuthorityMetadataKey(denom))
+	if bz == nil {
+		return metadata, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &metadata)
+	return metadata, true
+}
+
+// SetAuthorityMetadata stores the authorities of a denom.
+func (k Keeper) SetAuthorityMetadata(ctx sdk.Context, denom string, metadata types.DenomAuthorityMetadata) {
+	ctx.KVStore(k.storeKey).Set(types.GetDenomAuthorityMetadataKey(denom), k.cdc.MustMarshal(&metadata))
+}
+
+// GetDenomsFromCreator returns the denoms created by the creator, ordered by subdenom.
+func (k Keeper) GetDenomsFromCreator(ctx sdk.Context, creator string) []string {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.GetCreatorKey(creator))
+	defer iterator.Close()
+
+	denoms := []string{}
+	for ; iterator.Valid(); iterator.Next() {
+		denoms = append(denoms, string(iterator.Key()[len(types.DenomAuthorityMetadataKeyPrefix):]))
+	}
+
+	return denoms
+}
+
+// GetAllDenoms returns the denoms created by the token factory along with their authorities
+// and before send hooks, ordered by denom.
+func (k Keeper) GetAllDenoms(ctx sdk.Context) []types.GenesisDenom {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.DenomAuthorityMetadataKeyPrefix)
+	defer iterator.Close()
+
+	denoms := []types.GenesisDenom{}
+	for ; iterator.Valid(); iterator.Next() {
+		denom := string(iterator.Key()[len(types.DenomAuthorityMetadataKeyPrefix):])
+
+		var metadata types.DenomAuthorityMetadata
+		k.cdc.MustUnmarshal(iterator.Value(), &metadata)
+
+		denoms = append(denoms, types.GenesisDenom{
+			Denom:             denom,
+			AuthorityMetadata: metadata,
+			BeforeSendHook:    k.GetBeforeSendHook(ctx, denom),
+		})
+	}
+
+	return denoms
+}
+
+// getAdminAuthorityMetadata returns the authorities of a denom whose admin is the sender.
+func (k Keeper) getAdminAuthorityMetadata(ctx sdk.Context, sender, denom string) (types.DenomAuthorityMetadata, error) {
+	metadata, found := k.GetAuthorityMetadata(ctx, denom)
+	if !found {
+		return metadata, errorsmod.Wrapf(types.ErrDenomNotFound, "denom %s", denom)
+	}
+
+	if metadata.Admin == "" || metadata.Admin != sender {
+		return metadata, errorsmod.Wrapf(types.ErrUnauthorized, "%s is not the admin of denom %s", sender, denom)
+	}
+
+	return metadata, nil
+}
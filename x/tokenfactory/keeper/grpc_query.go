@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Nolus-Protocol/nolus-core/x/tokenfactory/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// Params returns the parameters of the module.
+func (k Keeper) Params(c context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryParamsResponse{Params: k.GetParams(ctx)}, nil
+}
+
+// DenomAuthorityMetadata returns the authorities of a denom.
+func (k Keeper) DenomAuthorityMetadata(c context.Context, req *types.QueryDenomAuthorityMetadataRequest) (*types.QueryDenomAuthorityMetadataResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	metadata, found := k.GetAuthorityMetadata(ctx, req.Denom)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "denom %s not found", req.Denom)
+	}
+
+	return &types.QueryDenomAuthorityMetadataResponse{AuthorityMetadata: metadata}, nil
+}
+
+// DenomsFromCreator returns the denoms created by the creator.
+func (k Keeper) DenomsFromCreator(c context.Context, req *types.QueryDenomsFromCreatorRequest) (*types.QueryDenomsFromCreatorResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryDenomsFromCreatorResponse{Denoms: k.GetDenomsFromCreator(ctx, req.Creator)}, nil
+}
+
+// BeforeSendHook returns the contract called before a denom is sent.
+func (k Keeper) BeforeSendHook(c context.Context, req *types.QueryBeforeSendHookRequest) (*types.QueryBeforeSendHookResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryBeforeSendHookResponse{ContractAddr: k.GetBeforeSendHook(ctx, req.Denom)}, nil
+}
@@ -0,0 +1,44 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Nolus-Protocol/nolus-core/x/tokenfactory/types"
+)
+
+func (s *KeeperTestSuite) TestQueries() {
+	admin := sdk.AccAddress("admin_______________")
+	contract := sdk.AccAddress("contract____________").String()
+	lp := s.createDenom(admin, "lp")
+	receipt := s.createDenom(admin, "receipt")
+	s.keeper.SetBeforeSendHook(s.ctx, receipt, contract)
+	ctx := sdk.WrapSDKContext(s.ctx)
+
+	params, err := s.keeper.Params(ctx, &types.QueryParamsRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(types.DefaultParams(), params.Params)
+
+	metadata, err := s.keeper.DenomAuthorityMetadata(ctx, &types.QueryDenomAuthorityMetadataRequest{Denom: lp})
+	s.Require().NoError(err)
+	s.Require().Equal(admin.String(), metadata.AuthorityMetadata.Admin)
+
+	_, err = s.keeper.DenomAuthorityMetadata(ctx, &types.QueryDenomAuthorityMetadataRequest{Denom: "unls"})
+	s.Require().Equal(codes.NotFound, status.Code(err))
+
+	denoms, err := s.keeper.DenomsFromCreator(ctx, &types.QueryDenomsFromCreatorRequest{Creator: admin.String()})
+	s.Require().NoError(err)
+	s.Require().Equal([]string{lp, receipt}, denoms.Denoms)
+
+	hook, err := s.keeper.BeforeSendHook(ctx, &types.QueryBeforeSendHookRequest{Denom: receipt})
+	s.Require().NoError(err)
+	s.Require().Equal(contract, hook.ContractAddr)
+
+	hook, err = s.keeper.BeforeSendHook(ctx, &types.QueryBeforeSendHookRequest{Denom: lp})
+	s.Require().NoError(err)
+	s.Require().Empty(hook.ContractAddr)
+
+	_, err = s.keeper.BeforeSendHook(ctx, nil)
+	s.Require().Equal(codes.InvalidArgument, status.Code(err))
+}
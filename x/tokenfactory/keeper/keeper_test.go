@@ -0,0 +1,98 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/suite"
+
+	nolusapp "github.com/Nolus-Protocol/nolus-core/app"
+	"github.com/Nolus-Protocol/nolus-core/app/params"
+	simulationapp "github.com/Nolus-Protocol/nolus-core/testutil/simapp"
+	"github.com/Nolus-Protocol/nolus-core/x/tokenfactory/keeper"
+	"github.com/Nolus-Protocol/nolus-core/x/tokenfactory/types"
+)
+
+// mockContractKeeper records the calls of the before send hooks and lets the
+// tests decide their outcome.
+type mockContractKeeper struct {
+	contracts map[string]bool
+	calls     []string
+	sudo      func(ctx sdk.Context, contract sdk.AccAddress, msg []byte) error
+}
+
+func (m *mockContractKeeper) HasContractInfo(_ sdk.Context, contract sdk.AccAddress) bool {
+	return m.contracts[contract.String()]
+}
+
+func (m *mockContractKeeper) Sudo(ctx sdk.Context, contract sdk.AccAddress, msg []byte) ([]byte, error) {
+	m.calls = append(m.calls, string(msg))
+	if m.sudo == nil {
+		return nil, nil
+	}
+	return nil, m.sudo(ctx, contract, msg)
+}
+
+type KeeperTestSuite struct {
+	suite.Suite
+	ctx            sdk.Context
+	app            *nolusapp.App
+	contractKeeper *mockContractKeeper
+	bankKeeper     keeper.BankKeeper
+	keeper         keeper.Keeper
+	msgServer      types.MsgServer
+	authority      string
+	treasury       sdk.AccAddress
+}
+
+// SetupTest setups a new test, with a token factory keeper calling a mock
+// contract keeper and a bank keeper calling its before send hooks.
+func (s *KeeperTestSuite) SetupTest() {
+	var err error
+	_ = params.SetAddressPrefixes()
+	s.app, err = simulationapp.TestSetup(s.T())
+	s.Require().NoError(err)
+
+	header := tmproto.Header{Height: s.app.LastBlockHeight() + 1}
+	s.ctx = s.app.BaseApp.NewContext(false, header).WithBlockTime(time.Now())
+
+	s.authority = authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	s.contractKeeper = &mockContractKeeper{contracts: map[string]bool{}}
+	s.bankKeeper = keeper.NewBankKeeper(s.app.BankKeeper.BaseKeeper)
+	s.keeper = *keeper.NewKeeper(
+		s.app.AppCodec(),
+		s.app.GetKey(types.StoreKey),
+		&s.bankKeeper,
+		s.app.TaxKeeper,
+		s.contractKeeper,
+		s.authority,
+	)
+	s.bankKeeper.SetHooks(s.keeper.Hooks())
+	s.msgServer = keeper.NewMsgServerImpl(s.keeper)
+	s.treasury = sdk.MustAccAddressFromBech32(s.app.TaxKeeper.ContractAddress(s.ctx))
+
+	s.Require().NoError(s.keeper.SetParams(s.ctx, types.DefaultParams()))
+}
+
+// fund sends coins to an account.
+func (s *KeeperTestSuite) fund(addr sdk.AccAddress, coins sdk.Coins) {
+	s.Require().NoError(banktestutil.FundAccount(&s.bankKeeper, s.ctx, addr, coins))
+}
+
+// createDenom creates a denom of the creator, who is funded with the creation fee.
+func (s *KeeperTestSuite) createDenom(creator sdk.AccAddress, subdenom string) string {
+	s.fund(creator, s.keeper.GetParams(s.ctx).DenomCreationFee)
+
+	res, err := s.msgServer.CreateDenom(sdk.WrapSDKContext(s.ctx), types.NewMsgCreateDenom(creator.String(), subdenom))
+	s.Require().NoError(err)
+	return res.NewTokenDenom
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
@@ -0,0 +1,210 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/Nolus-Protocol/nolus-core/x/tokenfactory/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+var _ types.MsgServer = msgServer{}
+
+// CreateDenom creates a denom with the sender as its admin.
+func (k msgServer) CreateDenom(goCtx context.Context, req *types.MsgCreateDenom) (*types.MsgCreateDenomResponse, error) {
+	if err := req.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	denom, err := k.Keeper.CreateDenom(ctx, sdk.MustAccAddressFromBech32(req.Sender), req.Subdenom)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCreateDenom,
+			sdk.NewAttribute(types.AttributeKeyCreator, req.Sender),
+			sdk.NewAttribute(types.AttributeKeyNewTokenDenom, denom),
+		),
+	)
+
+	return &types.MsgCreateDenomResponse{NewTokenDenom: denom}, nil
+}
+
+// Mint mints tokens of a denom the sender is the admin of.
+func (k msgServer) Mint(goCtx context.Context, req *types.MsgMint) (*types.MsgMintResponse, error) {
+	if err := req.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if _, err := k.getAdminAuthorityMetadata(ctx, req.Sender, req.Amount.Denom); err != nil {
+		return nil, err
+	}
+
+	mintTo := req.MintToAddress
+	if mintTo == "" {
+		mintTo = req.Sender
+	}
+
+	coins := sdk.NewCoins(req.Amount)
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, coins); err != nil {
+		return nil, err
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sdk.MustAccAddressFromBech32(mintTo), coins); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeMint,
+			sdk.NewAttribute(types.AttributeKeyMintToAddress, mintTo),
+			sdk.NewAttribute(types.AttributeKeyAmount, req.Amount.String()),
+		),
+	)
+
+	return &types.MsgMintResponse{}, nil
+}
+
+// Burn burns tokens of the sender of a denom the sender is the admin of.
+func (k msgServer) Burn(goCtx context.Context, req *types.MsgBurn) (*types.MsgBurnResponse, error) {
+	if err := req.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if _, err := k.getAdminAuthorityMetadata(ctx, req.Sender, req.Amount.Denom); err != nil {
+		return nil, err
+	}
+
+	coins := sdk.NewCoins(req.Amount)
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sdk.MustAccAddressFromBech32(req.Sender), types.ModuleName, coins); err != nil {
+		return nil, err
+	}
+
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, coins); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeBurn,
+			sdk.NewAttribute(types.AttributeKeyBurnFromAddress, req.Sender),
+			sdk.NewAttribute(types.AttributeKeyAmount, req.Amount.String()),
+		),
+	)
+
+	return &types.MsgBurnResponse{}, nil
+}
+
+// ChangeAdmin hands the admin of a denom over to the new admin. An empty new admin renounces
+// the admin for good.
+func (k msgServer) ChangeAdmin(goCtx context.Context, req *types.MsgChangeAdmin) (*types.MsgChangeAdminResponse, error) {
+	if err := req.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	metadata, err := k.getAdminAuthorityMetadata(ctx, req.Sender, req.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	metadata.Admin = req.NewAdmin
+	k.SetAuthorityMetadata(ctx, req.Denom, metadata)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeChangeAdmin,
+			sdk.NewAttribute(types.AttributeKeyDenom, req.Denom),
+			sdk.NewAttribute(types.AttributeKeyNewAdmin, req.NewAdmin),
+		),
+	)
+
+	return &types.MsgChangeAdminResponse{}, nil
+}
+
+// SetDenomMetadata sets the bank metadata of a denom the sender is the admin of.
+func (k msgServer) SetDenomMetadata(goCtx context.Context, req *types.MsgSetDenomMetadata) (*types.MsgSetDenomMetadataResponse, error) {
+	if err := req.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if _, err := k.getAdminAuthorityMetadata(ctx, req.Sender, req.Metadata.Base); err != nil {
+		return nil, err
+	}
+
+	k.bankKeeper.SetDenomMetaData(ctx, req.Metadata)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetDenomMetadata,
+			sdk.NewAttribute(types.AttributeKeyDenom, req.Metadata.Base),
+			sdk.NewAttribute(types.AttributeKeyDenomMetadata, req.Metadata.String()),
+		),
+	)
+
+	return &types.MsgSetDenomMetadataResponse{}, nil
+}
+
+// SetBeforeSendHook sets the contract called before a denom the sender is the admin of is
+// sent. An empty contract address removes the hook.
+func (k msgServer) SetBeforeSendHook(goCtx context.Context, req *types.MsgSetBeforeSendHook) (*types.MsgSetBeforeSendHookResponse, error) {
+	if err := req.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if _, err := k.getAdminAuthorityMetadata(ctx, req.Sender, req.Denom); err != nil {
+		return nil, err
+	}
+
+	if req.ContractAddr != "" && !k.contractKeeper.HasContractInfo(ctx, sdk.MustAccAddressFromBech32(req.ContractAddr)) {
+		return nil, errors.Wrapf(types.ErrContractNotFound, "contract %s", req.ContractAddr)
+	}
+
+	k.Keeper.SetBeforeSendHook(ctx, req.Denom, req.ContractAddr)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetBeforeSendHook,
+			sdk.NewAttribute(types.AttributeKeyDenom, req.Denom),
+			sdk.NewAttribute(types.AttributeKeyBeforeSendHook, req.ContractAddr),
+		),
+	)
+
+	return &types.MsgSetBeforeSendHookResponse{}, nil
+}
+
+func (k msgServer) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if err := req.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	if k.authority != req.Authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.SetParams(ctx, req.Params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
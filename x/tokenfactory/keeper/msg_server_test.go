@@ -0,0 +1,156 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/Nolus-Protocol/nolus-core/x/tokenfactory/types"
+)
+
+func (s *KeeperTestSuite) TestMsgCreateDenom() {
+	creator := sdk.AccAddress("creator_____________")
+	ctx := sdk.WrapSDKContext(s.ctx)
+	fee := types.DefaultDenomCreationFee
+	treasuryBalance := s.app.BankKeeper.GetAllBalances(s.ctx, s.treasury)
+
+	// the creator pays the fee to the treasury
+	_, err := s.msgServer.CreateDenom(ctx, types.NewMsgCreateDenom(creator.String(), "lp"))
+	s.Require().ErrorIs(err, sdkerrors.ErrInsufficientFunds)
+
+	s.fund(creator, fee.Add(fee...))
+	res, err := s.msgServer.CreateDenom(ctx, types.NewMsgCreateDenom(creator.String(), "lp"))
+	s.Require().NoError(err)
+	denom := "factory/" + creator.String() + "/lp"
+	s.Require().Equal(denom, res.NewTokenDenom)
+	s.Require().Equal(fee, s.app.BankKeeper.GetAllBalances(s.ctx, creator))
+	s.Require().Equal(treasuryBalance.Add(fee...), s.app.BankKeeper.GetAllBalances(s.ctx, s.treasury))
+
+	_, err = s.msgServer.CreateDenom(ctx, types.NewMsgCreateDenom(creator.String(), "lp"))
+	s.Require().ErrorIs(err, types.ErrDenomExists)
+	s.Require().Equal(fee, s.app.BankKeeper.GetAllBalances(s.ctx, creator))
+
+	metadata, found := s.keeper.GetAuthorityMetadata(s.ctx, denom)
+	s.Require().True(found)
+	s.Require().Equal(creator.String(), metadata.Admin)
+
+	bankMetadata, found := s.app.BankKeeper.GetDenomMetaData(s.ctx, denom)
+	s.Require().True(found)
+	s.Require().NoError(bankMetadata.Validate())
+	s.Require().Equal(denom, bankMetadata.Base)
+	s.Require().Equal("lp", bankMetadata.Symbol)
+
+	// no fee is charged if the fee is zero
+	s.Require().NoError(s.keeper.SetParams(s.ctx, types.NewParams(nil)))
+	_, err = s.msgServer.CreateDenom(ctx, types.NewMsgCreateDenom(creator.String(), "pool/1"))
+	s.Require().NoError(err)
+	s.Require().Equal(fee, s.app.BankKeeper.GetAllBalances(s.ctx, creator))
+
+	s.Require().Equal([]string{denom, "factory/" + creator.String() + "/pool/1"}, s.keeper.GetDenomsFromCreator(s.ctx, creator.String()))
+	s.Require().Empty(s.keeper.GetDenomsFromCreator(s.ctx, sdk.AccAddress("other_______________").String()))
+}
+
+func (s *KeeperTestSuite) TestMsgMintBurn() {
+	admin := sdk.AccAddress("admin_______________")
+	other := sdk.AccAddress("other_______________")
+	denom := s.createDenom(admin, "lp")
+	ctx := sdk.WrapSDKContext(s.ctx)
+
+	_, err := s.msgServer.Mint(ctx, types.NewMsgMint(admin.String(), sdk.NewInt64Coin(denom, 100), ""))
+	s.Require().NoError(err)
+	_, err = s.msgServer.Mint(ctx, types.NewMsgMint(admin.String(), sdk.NewInt64Coin(denom, 50), other.String()))
+	s.Require().NoError(err)
+	_, err = s.msgServer.Mint(ctx, types.NewMsgMint(other.String(), sdk.NewInt64Coin(denom, 50), ""))
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+	_, err = s.msgServer.Mint(ctx, types.NewMsgMint(admin.String(), sdk.NewInt64Coin("factory/"+other.String()+"/lp", 50), ""))
+	s.Require().ErrorIs(err, types.ErrDenomNotFound)
+	_, err = s.msgServer.Mint(ctx, types.NewMsgMint(admin.String(), sdk.NewInt64Coin("unls", 50), ""))
+	s.Require().ErrorIs(err, types.ErrInvalidDenom)
+
+	s.Require().Equal(int64(100), s.app.BankKeeper.GetBalance(s.ctx, admin, denom).Amount.Int64())
+	s.Require().Equal(int64(50), s.app.BankKeeper.GetBalance(s.ctx, other, denom).Amount.Int64())
+	s.Require().Equal(int64(150), s.app.BankKeeper.GetSupply(s.ctx, denom).Amount.Int64())
+
+	// the admin burns its own tokens only
+	_, err = s.msgServer.Burn(ctx, types.NewMsgBurn(other.String(), sdk.NewInt64Coin(denom, 50)))
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+	_, err = s.msgServer.Burn(ctx, types.NewMsgBurn(admin.String(), sdk.NewInt64Coin(denom, 101)))
+	s.Require().ErrorIs(err, sdkerrors.ErrInsufficientFunds)
+	_, err = s.msgServer.Burn(ctx, types.NewMsgBurn(admin.String(), sdk.NewInt64Coin(denom, 40)))
+	s.Require().NoError(err)
+
+	s.Require().Equal(int64(60), s.app.BankKeeper.GetBalance(s.ctx, admin, denom).Amount.Int64())
+	s.Require().Equal(int64(110), s.app.BankKeeper.GetSupply(s.ctx, denom).Amount.Int64())
+}
+
+func (s *KeeperTestSuite) TestMsgChangeAdmin() {
+	admin := sdk.AccAddress("admin_______________")
+	newAdmin := sdk.AccAddress("new_admin___________")
+	denom := s.createDenom(admin, "lp")
+	ctx := sdk.WrapSDKContext(s.ctx)
+
+	_, err := s.msgServer.ChangeAdmin(ctx, types.NewMsgChangeAdmin(newAdmin.String(), denom, newAdmin.String()))
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+	_, err = s.msgServer.ChangeAdmin(ctx, types.NewMsgChangeAdmin(admin.String(), denom, newAdmin.String()))
+	s.Require().NoError(err)
+
+	_, err = s.msgServer.Mint(ctx, types.NewMsgMint(admin.String(), sdk.NewInt64Coin(denom, 100), ""))
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+	_, err = s.msgServer.Mint(ctx, types.NewMsgMint(newAdmin.String(), sdk.NewInt64Coin(denom, 100), ""))
+	s.Require().NoError(err)
+
+	// nobody may manage the denom after the admin is renounced
+	_, err = s.msgServer.ChangeAdmin(ctx, types.NewMsgChangeAdmin(newAdmin.String(), denom, ""))
+	s.Require().NoError(err)
+	metadata, found := s.keeper.GetAuthorityMetadata(s.ctx, denom)
+	s.Require().True(found)
+	s.Require().Empty(metadata.Admin)
+
+	_, err = s.msgServer.Mint(ctx, types.NewMsgMint(newAdmin.String(), sdk.NewInt64Coin(denom, 100), ""))
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+	_, err = s.msgServer.ChangeAdmin(ctx, types.NewMsgChangeAdmin(newAdmin.String(), denom, newAdmin.String()))
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+}
+
+func (s *KeeperTestSuite) TestMsgSetDenomMetadata() {
+	admin := sdk.AccAddress("admin_______________")
+	denom := s.createDenom(admin, "lp")
+	ctx := sdk.WrapSDKContext(s.ctx)
+
+	metadata := banktypes.Metadata{
+		Description: "Liquidity pool shares",
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: denom, Exponent: 0},
+			{Denom: "lpt", Exponent: 6},
+		},
+		Base:    denom,
+		Display: "lpt",
+		Name:    "LP",
+		Symbol:  "LP",
+	}
+
+	_, err := s.msgServer.SetDenomMetadata(ctx, types.NewMsgSetDenomMetadata(sdk.AccAddress("other_______________").String(), metadata))
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+	_, err = s.msgServer.SetDenomMetadata(ctx, types.NewMsgSetDenomMetadata(admin.String(), metadata))
+	s.Require().NoError(err)
+
+	got, found := s.app.BankKeeper.GetDenomMetaData(s.ctx, denom)
+	s.Require().True(found)
+	s.Require().Equal(metadata, got)
+}
+
+func (s *KeeperTestSuite) TestMsgUpdateParams() {
+	params := types.NewParams(sdk.NewCoins(sdk.NewInt64Coin("unls", 1)))
+	ctx := sdk.WrapSDKContext(s.ctx)
+
+	_, err := s.msgServer.UpdateParams(ctx, &types.MsgUpdateParams{Authority: sdk.AccAddress("other_______________").String(), Params: params})
+	s.Require().ErrorIs(err, govtypes.ErrInvalidSigner)
+
+	_, err = s.msgServer.UpdateParams(ctx, &types.MsgUpdateParams{Authority: s.authority, Params: types.Params{DenomCreationFee: sdk.Coins{{Denom: "unls", Amount: sdk.ZeroInt()}}}})
+	s.Require().Error(err)
+
+	_, err = s.msgServer.UpdateParams(ctx, &types.MsgUpdateParams{Authority: s.authority, Params: params})
+	s.Require().NoError(err)
+	s.Require().Equal(params, s.keeper.GetParams(s.ctx))
+}
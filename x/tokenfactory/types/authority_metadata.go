@@ -0,0 +1,15 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Validate checks the admin address. A denom without an admin may no longer be minted or managed.
+func (m DenomAuthorityMetadata) Validate() error {
+	if m.Admin == "" {
+		return nil
+	}
+
+	_, err := sdk.AccAddressFromBech32(m.Admin)
+	return err
+}
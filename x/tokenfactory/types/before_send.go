@@ -0,0 +1,22 @@
+package types
+
+import (
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+)
+
+// BeforeSendHookGasLimit is the gas available to a before send hook for each of the coins it is
+// called with.
+const BeforeSendHookGasLimit = uint64(500_000)
+
+// BlockBeforeSendSudoMsg is the sudo message a before send hook is called with. The hook rejects
+// the send by returning an error.
+type BlockBeforeSendSudoMsg struct {
+	BlockBeforeSend BlockBeforeSendMsg `json:"block_before_send"`
+}
+
+// BlockBeforeSendMsg describes a send of a denom with a before send hook.
+type BlockBeforeSendMsg struct {
+	From   string           `json:"from"`
+	To     string           `json:"to"`
+	Amount wasmvmtypes.Coin `json:"amount"`
+}
@@ -0,0 +1,33 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+)
+
+func RegisterCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&MsgCreateDenom{}, "nolus-core/x/tokenfactory/MsgCreateDenom", nil)
+	cdc.RegisterConcrete(&MsgMint{}, "nolus-core/x/tokenfactory/MsgMint", nil)
+	cdc.RegisterConcrete(&MsgBurn{}, "nolus-core/x/tokenfactory/MsgBurn", nil)
+	cdc.RegisterConcrete(&MsgChangeAdmin{}, "nolus-core/x/tokenfactory/MsgChangeAdmin", nil)
+	cdc.RegisterConcrete(&MsgSetDenomMetadata{}, "nolus-core/x/tokenfactory/MsgSetDenomMetadata", nil)
+	cdc.RegisterConcrete(&MsgSetBeforeSendHook{}, "nolus-core/x/tokenfactory/MsgSetBeforeSendHook", nil)
+	cdc.RegisterConcrete(&MsgUpdateParams{}, "nolus-core/x/tokenfactory/MsgUpdateParams", nil)
+}
+
+func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgCreateDenom{},
+		&MsgMint{},
+		&MsgBurn{},
+		&MsgChangeAdmin{},
+		&MsgSetDenomMetadata{},
+		&MsgSetBeforeSendHook{},
+		&MsgUpdateParams{},
+	)
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
+
+var ModuleCdc = codec.NewProtoCodec(cdctypes.NewInterfaceRegistry())
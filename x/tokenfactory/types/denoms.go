@@ -0,0 +1,69 @@
+package types
+
+import (
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ModuleDenomPrefix is the first part of the denoms created by the token factory.
+	ModuleDenomPrefix = "factory"
+
+	// MaxSubdenomLength is the longest subdenom, which keeps the denoms of the longest
+	// creator addresses within the 128 characters of an sdk denom.
+	MaxSubdenomLength = 44
+
+	// MaxCreatorLength is the longest creator address, long enough for the contract addresses.
+	MaxCreatorLength = 75
+)
+
+// denomSeparator separates the parts of a denom. Bech32 addresses never contain it.
+const denomSeparator = "/"
+
+// GetTokenDenom returns the denom factory/{creator}/{subdenom} of a subdenom created by the creator.
+func GetTokenDenom(creator, subdenom string) (string, error) {
+	if subdenom == "" {
+		return "", errorsmod.Wrap(ErrInvalidDenom, "subdenom cannot be empty")
+	}
+
+	if len(subdenom) > MaxSubdenomLength {
+		return "", ErrSubdenomTooLong
+	}
+
+	if len(creator) > MaxCreatorLength || strings.Contains(creator, denomSeparator) {
+		return "", ErrInvalidCreator
+	}
+
+	denom := strings.Join([]string{ModuleDenomPrefix, creator, subdenom}, denomSeparator)
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return "", errorsmod.Wrap(ErrInvalidDenom, err.Error())
+	}
+
+	return denom, nil
+}
+
+// DeconstructDenom returns the creator and the subdenom of a denom created by the token factory.
+// The subdenom may contain the separator, e.g. factory/{creator}/pool/1 has the subdenom pool/1.
+func DeconstructDenom(denom string) (creator, subdenom string, err error) {
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return "", "", errorsmod.Wrap(ErrInvalidDenom, err.Error())
+	}
+
+	parts := strings.SplitN(denom, denomSeparator, 3)
+	if len(parts) < 3 || parts[0] != ModuleDenomPrefix || parts[2] == "" {
+		return "", "", errorsmod.Wrapf(ErrInvalidDenom, "denom %s is not of the form %s/{creator}/{subdenom}", denom, ModuleDenomPrefix)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(parts[1]); err != nil {
+		return "", "", errorsmod.Wrapf(ErrInvalidDenom, "invalid creator address %s: %s", parts[1], err)
+	}
+
+	return parts[1], parts[2], nil
+}
+
+// IsFactoryDenom returns true if the denom has the prefix of the denoms created by the token factory.
+func IsFactoryDenom(denom string) bool {
+	return strings.HasPrefix(denom, ModuleDenomPrefix+denomSeparator)
+}
@@ -0,0 +1,71 @@
+package types_test
+
+import (
+	"strings"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Nolus-Protocol/nolus-core/app/params"
+	"github.com/Nolus-Protocol/nolus-core/x/tokenfactory/types"
+)
+
+func TestGetTokenDenom(t *testing.T) {
+	_ = params.SetAddressPrefixes()
+	creator := sdk.AccAddress("creator_____________").String()
+	contract := sdk.AccAddress(strings.Repeat("c", 32)).String()
+
+	for _, tc := range []struct {
+		desc     string
+		creator  string
+		subdenom string
+		err      error
+	}{
+		{desc: "account creator", creator: creator, subdenom: "lp"},
+		{desc: "contract creator with the longest subdenom", creator: contract, subdenom: strings.Repeat("a", types.MaxSubdenomLength)},
+		{desc: "nested subdenom", creator: creator, subdenom: "pool/1"},
+		{desc: "empty subdenom", creator: creator, subdenom: "", err: types.ErrInvalidDenom},
+		{desc: "too long subdenom", creator: creator, subdenom: strings.Repeat("a", types.MaxSubdenomLength+1), err: types.ErrSubdenomTooLong},
+		{desc: "invalid subdenom", creator: creator, subdenom: "l p", err: types.ErrInvalidDenom},
+		{desc: "creator with the separator", creator: "nolus/1", subdenom: "lp", err: types.ErrInvalidCreator},
+		{desc: "too long creator", creator: strings.Repeat("a", types.MaxCreatorLength+1), subdenom: "lp", err: types.ErrInvalidCreator},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			denom, err := types.GetTokenDenom(tc.creator, tc.subdenom)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, "factory/"+tc.creator+"/"+tc.subdenom, denom)
+			require.True(t, types.IsFactoryDenom(denom))
+
+			gotCreator, gotSubdenom, err := types.DeconstructDenom(denom)
+			require.NoError(t, err)
+			require.Equal(t, tc.creator, gotCreator)
+			require.Equal(t, tc.subdenom, gotSubdenom)
+		})
+	}
+}
+
+func TestDeconstructDenom(t *testing.T) {
+	_ = params.SetAddressPrefixes()
+	creator := sdk.AccAddress("creator_____________").String()
+
+	for _, denom := range []string{
+		"unls",
+		"ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2",
+		"factory/" + creator,
+		"factory/" + creator + "/",
+		"factory/nolus1invalid/lp",
+		"token/" + creator + "/lp",
+	} {
+		_, _, err := types.DeconstructDenom(denom)
+		require.ErrorIs(t, err, types.ErrInvalidDenom, denom)
+	}
+
+	require.False(t, types.IsFactoryDenom("unls"))
+	require.False(t, types.IsFactoryDenom("factoryunls"))
+}
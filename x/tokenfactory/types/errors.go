@@ -0,0 +1,24 @@
+package types
+
+// DONTCOVER
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// x/tokenfactory module sentinel errors.
+//
+// The codes start at 1101 since the neutron token factory, linked in through
+// the neutron dependency, registers its errors under the same codespace.
+var (
+	ErrDenomExists            = errorsmod.Register(ModuleName, 1101, "denom already exists")
+	ErrDenomNotFound          = errorsmod.Register(ModuleName, 1102, "denom not found")
+	ErrUnauthorized           = errorsmod.Register(ModuleName, 1103, "unauthorized account")
+	ErrInvalidDenom           = errorsmod.Register(ModuleName, 1104, "invalid denom")
+	ErrInvalidCreator         = errorsmod.Register(ModuleName, 1105, "invalid creator")
+	ErrSubdenomTooLong        = errorsmod.Register(ModuleName, 1106, "subdenom too long")
+	ErrInvalidMetadata        = errorsmod.Register(ModuleName, 1107, "invalid denom metadata")
+	ErrContractNotFound       = errorsmod.Register(ModuleName, 1108, "contract not found")
+	ErrBeforeSendHookRejected = errorsmod.Register(ModuleName, 1109, "send rejected by the before send hook")
+	ErrNoTreasury             = errorsmod.Register(ModuleName, 1110, "no treasury to pay the denom creation fee to")
+)
@@ -0,0 +1,22 @@
+package types
+
+const (
+	EventTypeCreateDenom       = "create_denom"
+	EventTypeMint              = "tf_mint"
+	EventTypeBurn              = "tf_burn"
+	EventTypeChangeAdmin       = "change_admin"
+	EventTypeSetDenomMetadata  = "set_denom_metadata"
+	EventTypeSetBeforeSendHook = "set_before_send_hook"
+
+	AttributeKeyCreator         = "creator"
+	AttributeKeyNewTokenDenom   = "new_token_denom"
+	AttributeKeyDenom           = "denom"
+	AttributeKeyAmount          = "amount"
+	AttributeKeyMintToAddress   = "mint_to_address"
+	AttributeKeyBurnFromAddress = "burn_from_address"
+	AttributeKeyNewAdmin        = "new_admin"
+	AttributeKeyDenomMetadata   = "denom_metadata"
+	AttributeKeyBeforeSendHook  = "before_send_hook"
+
+	AttributeValueCategory = ModuleName
+)
@@ -0,0 +1,54 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewGenesisState creates a new GenesisState object.
+func NewGenesisState(params Params, denoms []GenesisDenom) *GenesisState {
+	return &GenesisState{
+		Params:        params,
+		FactoryDenoms: denoms,
+	}
+}
+
+// DefaultGenesis returns the default tokenfactory genesis state.
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{
+		Params: DefaultParams(),
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	seen := make(map[string]bool, len(gs.FactoryDenoms))
+	for _, denom := range gs.FactoryDenoms {
+		if _, _, err := DeconstructDenom(denom.Denom); err != nil {
+			return err
+		}
+
+		if seen[denom.Denom] {
+			return fmt.Errorf("duplicate denom %s", denom.Denom)
+		}
+		seen[denom.Denom] = true
+
+		if err := denom.AuthorityMetadata.Validate(); err != nil {
+			return fmt.Errorf("invalid authority metadata of denom %s: %w", denom.Denom, err)
+		}
+
+		if denom.BeforeSendHook != "" {
+			if _, err := sdk.AccAddressFromBech32(denom.BeforeSendHook); err != nil {
+				return fmt.Errorf("invalid before send hook of denom %s: %w", denom.Denom, err)
+			}
+		}
+	}
+
+	return nil
+}
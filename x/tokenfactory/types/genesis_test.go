@@ -0,0 +1,82 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Nolus-Protocol/nolus-core/app/params"
+	"github.com/Nolus-Protocol/nolus-core/x/tokenfactory/types"
+)
+
+func TestGenesisState_Validate(t *testing.T) {
+	_ = params.SetAddressPrefixes()
+	creator := sdk.AccAddress("creator_____________").String()
+	contract := sdk.AccAddress("contract____________").String()
+	denom := func(subdenom string, modify func(d *types.GenesisDenom)) types.GenesisDenom {
+		d := types.GenesisDenom{
+			Denom:             "factory/" + creator + "/" + subdenom,
+			AuthorityMetadata: types.DenomAuthorityMetadata{Admin: creator},
+		}
+		if modify != nil {
+			modify(&d)
+		}
+		return d
+	}
+
+	for _, tc := range []struct {
+		desc     string
+		genState *types.GenesisState
+		valid    bool
+	}{
+		{
+			desc:     "default is valid",
+			genState: types.DefaultGenesis(),
+			valid:    true,
+		},
+		{
+			desc: "valid genesis state",
+			genState: types.NewGenesisState(types.NewParams(nil), []types.GenesisDenom{
+				denom("lp", nil),
+				denom("receipt", func(d *types.GenesisDenom) { d.BeforeSendHook = contract }),
+				denom("renounced", func(d *types.GenesisDenom) { d.AuthorityMetadata.Admin = "" }),
+			}),
+			valid: true,
+		},
+		{
+			desc:     "invalid denom creation fee",
+			genState: types.NewGenesisState(types.Params{DenomCreationFee: sdk.Coins{{Denom: "unls", Amount: sdk.ZeroInt()}}}, nil),
+			valid:    false,
+		},
+		{
+			desc:     "duplicate denom",
+			genState: types.NewGenesisState(types.DefaultParams(), []types.GenesisDenom{denom("lp", nil), denom("lp", nil)}),
+			valid:    false,
+		},
+		{
+			desc:     "not a factory denom",
+			genState: types.NewGenesisState(types.DefaultParams(), []types.GenesisDenom{denom("lp", func(d *types.GenesisDenom) { d.Denom = "unls" })}),
+			valid:    false,
+		},
+		{
+			desc:     "invalid admin",
+			genState: types.NewGenesisState(types.DefaultParams(), []types.GenesisDenom{denom("lp", func(d *types.GenesisDenom) { d.AuthorityMetadata.Admin = "invalid_address" })}),
+			valid:    false,
+		},
+		{
+			desc:     "invalid before send hook",
+			genState: types.NewGenesisState(types.DefaultParams(), []types.GenesisDenom{denom("lp", func(d *types.GenesisDenom) { d.BeforeSendHook = "invalid_address" })}),
+			valid:    false,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := tc.genState.Validate()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
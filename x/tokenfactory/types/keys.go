@@ -0,0 +1,42 @@
+package types
+
+var (
+	// ParamsKey is the key of the module params.
+	ParamsKey = []byte{0x00}
+
+	// DenomAuthorityMetadataKeyPrefix is the prefix of the authorities of the denoms, stored by
+	// denom. As the denoms start with their creator, the denoms of a creator share a prefix.
+	DenomAuthorityMetadataKeyPrefix = []byte{0x01}
+
+	// BeforeSendHookKeyPrefix is the prefix of the before send hooks, stored by denom.
+	BeforeSendHookKeyPrefix = []byte{0x02}
+)
+
+const (
+	// ModuleName defines the module name.
+	ModuleName = "tokenfactory"
+
+	// StoreKey defines the primary module store key.
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for tokenfactory.
+	RouterKey = ModuleName
+
+	// QuerierRoute defines the module's query routing key.
+	QuerierRoute = ModuleName
+)
+
+// GetDenomAuthorityMetadataKey returns the store key of the authorities of a denom.
+func GetDenomAuthorityMetadataKey(denom string) []byte {
+	return append(DenomAuthorityMetadataKeyPrefix, []byte(denom)...)
+}
+
+// GetCreatorKey returns the key prefix of the authorities of the denoms of a creator.
+func GetCreatorKey(creator string) []byte {
+	return GetDenomAuthorityMetadataKey(ModuleDenomPrefix + denomSeparator + creator + denomSeparator)
+}
+
+// GetBeforeSendHookKey returns the store key of the before send hook of a denom.
+func GetBeforeSendHookKey(denom string) []byte {
+	return append(BeforeSendHookKeyPrefix, []byte(denom)...)
+}
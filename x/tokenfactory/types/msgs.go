@@ -0,0 +1,244 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+var (
+	_ sdk.Msg = &MsgCreateDenom{}
+	_ sdk.Msg = &MsgMint{}
+	_ sdk.Msg = &MsgBurn{}
+	_ sdk.Msg = &MsgChangeAdmin{}
+	_ sdk.Msg = &MsgSetDenomMetadata{}
+	_ sdk.Msg = &MsgSetBeforeSendHook{}
+	_ sdk.Msg = &MsgUpdateParams{}
+)
+
+// NewMsgCreateDenom returns a reference to a new MsgCreateDenom.
+func NewMsgCreateDenom(sender, subdenom string) *MsgCreateDenom {
+	return &MsgCreateDenom{
+		Sender:   sender,
+		Subdenom: subdenom,
+	}
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgCreateDenom) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgCreateDenom message.
+func (m *MsgCreateDenom) GetSigners() []sdk.AccAddress {
+	return signers(m.Sender)
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgCreateDenom) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return errorsmod.Wrap(err, "invalid sender address")
+	}
+
+	_, err := GetTokenDenom(m.Sender, m.Subdenom)
+	return err
+}
+
+// NewMsgMint returns a reference to a new MsgMint.
+func NewMsgMint(sender string, amount sdk.Coin, mintToAddress string) *MsgMint {
+	return &MsgMint{
+		Sender:        sender,
+		Amount:        amount,
+		MintToAddress: mintToAddress,
+	}
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgMint) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgMint message.
+func (m *MsgMint) GetSigners() []sdk.AccAddress {
+	return signers(m.Sender)
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgMint) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return errorsmod.Wrap(err, "invalid sender address")
+	}
+
+	if m.MintToAddress != "" {
+		if _, err := sdk.AccAddressFromBech32(m.MintToAddress); err != nil {
+			return errorsmod.Wrap(err, "invalid mint to address")
+		}
+	}
+
+	return validateAmount(m.Amount)
+}
+
+// NewMsgBurn returns a reference to a new MsgBurn.
+func NewMsgBurn(sender string, amount sdk.Coin) *MsgBurn {
+	return &MsgBurn{
+		Sender: sender,
+		Amount: amount,
+	}
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgBurn) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgBurn message.
+func (m *MsgBurn) GetSigners() []sdk.AccAddress {
+	return signers(m.Sender)
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgBurn) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return errorsmod.Wrap(err, "invalid sender address")
+	}
+
+	return validateAmount(m.Amount)
+}
+
+// NewMsgChangeAdmin returns a reference to a new MsgChangeAdmin.
+func NewMsgChangeAdmin(sender, denom, newAdmin string) *MsgChangeAdmin {
+	return &MsgChangeAdmin{
+		Sender:   sender,
+		Denom:    denom,
+		NewAdmin: newAdmin,
+	}
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgChangeAdmin) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgChangeAdmin message.
+func (m *MsgChangeAdmin) GetSigners() []sdk.AccAddress {
+	return signers(m.Sender)
+}
+
+// ValidateBasic does a sanity check on the provided data. An empty new admin renounces the
+// admin of the denom for good.
+func (m *MsgChangeAdmin) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return errorsmod.Wrap(err, "invalid sender address")
+	}
+
+	if m.NewAdmin != "" {
+		if _, err := sdk.AccAddressFromBech32(m.NewAdmin); err != nil {
+			return errorsmod.Wrap(err, "invalid new admin address")
+		}
+	}
+
+	_, _, err := DeconstructDenom(m.Denom)
+	return err
+}
+
+// NewMsgSetDenomMetadata returns a reference to a new MsgSetDenomMetadata.
+func NewMsgSetDenomMetadata(sender string, metadata banktypes.Metadata) *MsgSetDenomMetadata {
+	return &MsgSetDenomMetadata{
+		Sender:   sender,
+		Metadata: metadata,
+	}
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgSetDenomMetadata) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgSetDenomMetadata message.
+func (m *MsgSetDenomMetadata) GetSigners() []sdk.AccAddress {
+	return signers(m.Sender)
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgSetDenomMetadata) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return errorsmod.Wrap(err, "invalid sender address")
+	}
+
+	if err := m.Metadata.Validate(); err != nil {
+		return errorsmod.Wrap(ErrInvalidMetadata, err.Error())
+	}
+
+	_, _, err := DeconstructDenom(m.Metadata.Base)
+	return err
+}
+
+// NewMsgSetBeforeSendHook returns a reference to a new MsgSetBeforeSendHook.
+func NewMsgSetBeforeSendHook(sender, denom, contractAddr string) *MsgSetBeforeSendHook {
+	return &MsgSetBeforeSendHook{
+		Sender:       sender,
+		Denom:        denom,
+		ContractAddr: contractAddr,
+	}
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgSetBeforeSendHook) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgSetBeforeSendHook message.
+func (m *MsgSetBeforeSendHook) GetSigners() []sdk.AccAddress {
+	return signers(m.Sender)
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgSetBeforeSendHook) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return errorsmod.Wrap(err, "invalid sender address")
+	}
+
+	if m.ContractAddr != "" {
+		if _, err := sdk.AccAddressFromBech32(m.ContractAddr); err != nil {
+			return errorsmod.Wrap(err, "invalid contract address")
+		}
+	}
+
+	_, _, err := DeconstructDenom(m.Denom)
+	return err
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgUpdateParams) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgUpdateParams message.
+func (m *MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	return signers(m.Authority)
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	return m.Params.Validate()
+}
+
+func signers(address string) []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(address)
+	return []sdk.AccAddress{addr}
+}
+
+// validateAmount checks that the amount is a positive amount of a token factory denom.
+func validateAmount(amount sdk.Coin) error {
+	if !amount.IsValid() || !amount.IsPositive() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "invalid amount %s", amount)
+	}
+
+	_, _, err := DeconstructDenom(amount.Denom)
+	return err
+}
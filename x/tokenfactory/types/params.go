@@ -0,0 +1,49 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"gopkg.in/yaml.v2"
+
+	"github.com/Nolus-Protocol/nolus-core/app/params"
+)
+
+// DefaultDenomCreationFee is the default fee paid to create a denom, 10 NLS.
+var DefaultDenomCreationFee = sdk.NewCoins(sdk.NewInt64Coin(params.BaseCoinUnit, 10_000_000))
+
+// NewParams creates a new Params instance.
+func NewParams(denomCreationFee sdk.Coins) Params {
+	return Params{
+		DenomCreationFee: denomCreationFee,
+	}
+}
+
+// DefaultParams returns default x/tokenfactory module parameters.
+func DefaultParams() Params {
+	return NewParams(DefaultDenomCreationFee)
+}
+
+// Validate validates the set of params.
+func (p Params) Validate() error {
+	return validateDenomCreationFee(p.DenomCreationFee)
+}
+
+// String implements the Stringer interface.
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+func validateDenomCreationFee(v interface{}) error {
+	fee, ok := v.(sdk.Coins)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if err := fee.Validate(); err != nil {
+		return fmt.Errorf("invalid denom creation fee %s: %w", fee, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,172 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+
+	"github.com/Nolus-Protocol/nolus-core/x/vestings/types"
+)
+
+// Query command flags.
+const (
+	FlagTime   = "time"
+	FlagPeriod = "period"
+)
+
+// GetQueryCmd returns the cli query commands for the vestings module.
+func GetQueryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("Querying commands for the %s module", types.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		GetCmdQueryParams(),
+		GetCmdQueryBalances(),
+		GetCmdQueryUnlockSchedule(),
+		GetCmdQueryVestingAccounts(),
+	)
+
+	return cmd
+}
+
+// GetCmdQueryParams implements a command to return the parameters of the module.
+func GetCmdQueryParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Short: "Query the parameters of the module",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Params(cmd.Context(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Params)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryBalances implements a command to return the vested, unvested,
+// locked and spendable coins of a vesting account.
+func GetCmdQueryBalances() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "balances [address]",
+		Short: "Query the vested, unvested, locked and spendable coins of a vesting account",
+		Long: `Query the vested, unvested, locked and spendable coins of a vesting account
+at the time given by the '--time' flag as a UNIX epoch timestamp, or at the
+latest block time if not provided.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			t, err := cmd.Flags().GetInt64(FlagTime)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.Balances(cmd.Context(), &types.QueryBalancesRequest{Address: args[0], Time: t})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Int64(FlagTime, 0, "UNIX epoch timestamp to query the balances at")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryUnlockSchedule implements a command to return the projected
+// unlocks of a vesting account.
+func GetCmdQueryUnlockSchedule() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unlock-schedule [address]",
+		Short: "Query the projected unlocks of a vesting account",
+		Long: `Query the projected unlocks of a vesting account after the latest block
+time. Continuously vesting coins are reported every '--period' seconds.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			period, err := cmd.Flags().GetInt64(FlagPeriod)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.UnlockSchedule(cmd.Context(), &types.QueryUnlockScheduleRequest{Address: args[0], Period: period})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Int64(FlagPeriod, 0, "Period in seconds between the unlocks of continuously vesting coins, one day if not provided")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryVestingAccounts implements a command to return the accounts
+// created through the vestings module.
+func GetCmdQueryVestingAccounts() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "accounts",
+		Short: "Query the accounts created through the vestings module",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.VestingAccounts(cmd.Context(), &types.QueryVestingAccountsRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "accounts")
+
+	return cmd
+}
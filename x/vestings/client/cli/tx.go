@@ -20,6 +20,8 @@ func GetTxCmd() *cobra.Command {
 	}
 
 	cmd.AddCommand(CmdCreateVestingAccount())
+	cmd.AddCommand(CmdCreateClawbackVestingAccount())
+	cmd.AddCommand(CmdClawback())
 
 	return cmd
 }
@@ -0,0 +1,59 @@
+package cli
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Nolus-Protocol/nolus-core/x/vestings/types"
+)
+
+// Transaction command flags.
+const (
+	FlagDest = "dest"
+)
+
+func CmdClawback() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "clawback [address]",
+		Short: "Return the unvested tokens of a clawback vesting account.",
+		Long: `Return the unvested tokens of a clawback vesting account, including the
+delegated and unbonding ones, and end its vesting schedule. The tokens are sent
+to the '--dest' address, or to the funder of the account if not provided. Must
+be signed by the clawback admin of the account.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			addr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			destAddress, err := cmd.Flags().GetString(FlagDest)
+			if err != nil {
+				return err
+			}
+
+			var dest sdk.AccAddress
+			if destAddress != "" {
+				if dest, err = sdk.AccAddressFromBech32(destAddress); err != nil {
+					return err
+				}
+			}
+
+			msg := types.NewMsgClawback(clientCtx.GetFromAddress(), addr, dest)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().String(FlagDest, "", "Address receiving the unvested tokens, the funder if empty")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
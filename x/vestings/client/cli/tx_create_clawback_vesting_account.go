@@ -0,0 +1,98 @@
+package cli
+
+import (
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Nolus-Protocol/nolus-core/x/vestings/types"
+)
+
+// Transaction command flags.
+const (
+	FlagClawbackAdmin = "clawback-admin"
+)
+
+func CmdCreateClawbackVestingAccount() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-clawback-vesting-account [to_address] [amount] [start_time] [end_time]",
+		Short: "Create a new vesting account whose unvested tokens can be clawed back.",
+		Long: `Create a new vesting account funded with an allocation of tokens. The
+unvested tokens can be returned by the clawback admin, set with the
+'--clawback-admin' flag, or by the funder if no admin is set. The account can
+either be a delayed or continuous vesting account, which is determined by the
+'--delayed' flag. The start_time, end_time must be provided as a UNIX epoch
+timestamp.
+
+With the '--merge' flag an existing base account is converted into a clawback
+vesting account, and the schedule is added to a clawback vesting account with
+the same funder and clawback admin. A merge must be signed by the owner of
+to_address as well, so generate it with '--generate-only' and have both
+accounts sign it.`,
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			toAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			startTime, err := strconv.ParseInt(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			endTime, err := strconv.ParseInt(args[3], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			delayed, err := cmd.Flags().GetBool(FlagDelayed)
+			if err != nil {
+				return err
+			}
+
+			clawbackAdmin, err := cmd.Flags().GetString(FlagClawbackAdmin)
+			if err != nil {
+				return err
+			}
+
+			merge, err := cmd.Flags().GetBool(FlagMerge)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreateClawbackVestingAccount(
+				clientCtx.GetFromAddress(),
+				toAddr,
+				amount,
+				startTime,
+				endTime,
+				delayed,
+				clawbackAdmin,
+				merge,
+			)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().Bool(FlagDelayed, false, "Create a delayed vesting account if true")
+	cmd.Flags().String(FlagClawbackAdmin, "", "Address allowed to claw back the unvested tokens, the funder if empty")
+	cmd.Flags().Bool(FlagMerge, false, "Add the schedule to an existing account if true")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -18,6 +18,7 @@ var _ = strconv.Itoa(0)
 // Transaction command flags.
 const (
 	FlagDelayed = "delayed"
+	FlagMerge   = "merge"
 )
 
 func CmdCreateVestingAccount() *cobra.Command {
@@ -27,7 +28,12 @@ func CmdCreateVestingAccount() *cobra.Command {
 		Long: `Create a new vesting account funded with an allocation of tokens. The
 account can either be a delayed or continuous vesting account, which is determined
 by the '--delayed' flag. The start_time, end_time must be provided as a UNIX epoch
-timestamp.`,
+timestamp.
+
+With the '--merge' flag an existing base account is converted into a vesting
+account, and the schedule is added to a vesting account created by the module.
+A merge must be signed by the owner of to_address as well, so generate it with
+'--generate-only' and have both accounts sign it.`,
 		Args: cobra.ExactArgs(4),
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
 			clientCtx, err := client.GetClientTxContext(cmd)
@@ -59,6 +65,12 @@ timestamp.`,
 			if err != nil {
 				return err
 			}
+
+			merge, err := cmd.Flags().GetBool(FlagMerge)
+			if err != nil {
+				return err
+			}
+
 			msg := types.NewMsgCreateVestingAccount(
 				clientCtx.GetFromAddress(),
 				toAddr,
@@ -66,11 +78,13 @@ timestamp.`,
 				startTime,
 				endTime,
 				delayed,
+				merge,
 			)
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 	cmd.Flags().Bool(FlagDelayed, false, "Create a delayed vesting account if true")
+	cmd.Flags().Bool(FlagMerge, false, "Add the schedule to an existing account if true")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -0,0 +1,31 @@
+package vestings
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Nolus-Protocol/nolus-core/x/vestings/keeper"
+	"github.com/Nolus-Protocol/nolus-core/x/vestings/types"
+)
+
+// InitGenesis initializes the vestings module's state from a provided genesis
+// state.
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState) {
+	if err := k.SetParams(ctx, genState.Params); err != nil {
+		ctx.Logger().Error("failed to set vestings module params", "error", err)
+	}
+
+	for _, addr := range genState.VestingAccounts {
+		k.SetVestingAccount(ctx, sdk.MustAccAddressFromBech32(addr))
+	}
+}
+
+// ExportGenesis returns the vestings module's exported genesis.
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	var vestingAccounts []string
+	k.IterateVestingAccounts(ctx, func(addr sdk.AccAddress) bool {
+		vestingAccounts = append(vestingAccounts, addr.String())
+		return false
+	})
+
+	return types.NewGenesisState(k.GetParams(ctx), vestingAccounts)
+}
@@ -0,0 +1,40 @@
+package vestings_test
+
+import (
+	"testing"
+	"time"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdktestutil "github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Nolus-Protocol/nolus-core/app/params"
+	simulationapp "github.com/Nolus-Protocol/nolus-core/testutil/simapp"
+	"github.com/Nolus-Protocol/nolus-core/x/vestings"
+	"github.com/Nolus-Protocol/nolus-core/x/vestings/types"
+)
+
+func TestGenesis(t *testing.T) {
+	_ = params.SetAddressPrefixes()
+	app, err := simulationapp.TestSetup(t)
+	require.NoError(t, err)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{}).WithBlockTime(time.Now())
+
+	_, _, addr1 := sdktestutil.KeyTestPubAddr()
+	_, _, addr2 := sdktestutil.KeyTestPubAddr()
+	genesisState := types.GenesisState{
+		Params:          types.NewParams(3600, []string{"unls"}, sdk.NewCoins(sdk.NewInt64Coin("unls", 100))),
+		VestingAccounts: []string{addr1.String(), addr2.String()},
+	}
+	require.NoError(t, genesisState.Validate())
+
+	vestings.InitGenesis(ctx, *app.VestingsKeeper, genesisState)
+	require.True(t, app.VestingsKeeper.HasVestingAccount(ctx, addr1))
+	require.True(t, app.VestingsKeeper.HasVestingAccount(ctx, addr2))
+
+	got := vestings.ExportGenesis(ctx, *app.VestingsKeeper)
+	require.NotNil(t, got)
+	require.Equal(t, genesisState.Params, got.Params)
+	require.ElementsMatch(t, genesisState.VestingAccounts, got.VestingAccounts)
+}
@@ -0,0 +1,175 @@
+package keeper
+
+import (
+	"math"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/Nolus-Protocol/nolus-core/x/vestings/types"
+)
+
+// Clawback returns the coins of a clawback vesting account which are unvested at
+// the current block time to dest and ends its vesting schedule. Unvested coins
+// which are still in the account are sent, while those that have been delegated
+// or are unbonding are transferred to dest keeping their staking state.
+func (k Keeper) Clawback(ctx sdk.Context, addr, dest sdk.AccAddress) (sdk.Coins, error) {
+	va, err := k.getClawbackVestingAccount(ctx, addr)
+	if err != nil {
+		return nil, err
+	}
+
+	blockTime := ctx.BlockTime()
+	unvested := va.GetVestingCoins(blockTime)
+	if unvested.IsZero() {
+		return nil, errorsmod.Wrapf(types.ErrNothingToClawback, "account %s", addr)
+	}
+
+	// the locked coins are the unvested ones which are not delegated and
+	// must be in the account's balance
+	liquid := va.LockedCoins(blockTime).Min(k.bankKeeper.GetAllBalances(ctx, addr))
+
+	// the destination must exist for its unbonding delegations to complete
+	if k.accountKeeper.GetAccount(ctx, dest) == nil {
+		k.accountKeeper.SetAccount(ctx, k.accountKeeper.NewAccountWithAddress(ctx, dest))
+	}
+
+	bondDenom := k.stakingKeeper.BondDenom(ctx)
+	want := unvested.AmountOf(bondDenom).Sub(liquid.AmountOf(bondDenom))
+
+	bonded, err := k.transferDelegations(ctx, addr, dest, want)
+	if err != nil {
+		return nil, err
+	}
+	unbonding := k.transferUnbondingDelegations(ctx, addr, dest, want.Sub(bonded))
+	delegated := sdk.NewCoins(sdk.NewCoin(bondDenom, bonded.Add(unbonding)))
+
+	// the staking hooks may have updated the account, e.g. on withdrawing rewards
+	va, err = k.getClawbackVestingAccount(ctx, addr)
+	if err != nil {
+		return nil, err
+	}
+	va.EndVesting(blockTime, unvested, delegated)
+	k.accountKeeper.SetAccount(ctx, va)
+
+	if !liquid.IsZero() {
+		if err := k.bankKeeper.SendCoins(ctx, addr, dest, liquid); err != nil {
+			return nil, err
+		}
+	}
+
+	return liquid.Add(delegated...), nil
+}
+
+func (k Keeper) getClawbackVestingAccount(ctx sdk.Context, addr sdk.AccAddress) (*types.ClawbackVestingAccount, error) {
+	acc := k.accountKeeper.GetAccount(ctx, addr)
+	if acc == nil {
+		return nil, errorsmod.Wrapf(types.ErrNotClawbackAccount, "account %s does not exist", addr)
+	}
+
+	va, ok := acc.(*types.ClawbackVestingAccount)
+	if !ok {
+		return nil, errorsmod.Wrapf(types.ErrNotClawbackAccount, "account %s is %T", addr, acc)
+	}
+
+	return va, nil
+}
+
+// transferDelegations moves up to amount bonded tokens from the delegations of
+// from to delegations of to with the same validators. It returns the amount of
+// tokens actually transferred.
+func (k Keeper) transferDelegations(ctx sdk.Context, from, to sdk.AccAddress, amount sdkmath.Int) (sdkmath.Int, error) {
+	transferred := sdkmath.ZeroInt()
+
+	for _, delegation := range k.stakingKeeper.GetDelegatorDelegations(ctx, from, math.MaxUint16) {
+		want := amount.Sub(transferred)
+		if !want.IsPositive() {
+			break
+		}
+
+		valAddr := delegation.GetValidatorAddr()
+		validator, found := k.stakingKeeper.GetValidator(ctx, valAddr)
+		if !found {
+			return transferred, errorsmod.Wrap(stakingtypes.ErrNoValidatorFound, valAddr.String())
+		}
+
+		tokens := sdkmath.MinInt(validator.TokensFromShares(delegation.GetShares()).TruncateInt(), want)
+		if !tokens.IsPositive() {
+			continue
+		}
+
+		shares, err := k.stakingKeeper.ValidateUnbondAmount(ctx, from, valAddr, tokens)
+		if err != nil {
+			return transferred, err
+		}
+
+		tokens, err = k.stakingKeeper.Unbond(ctx, from, valAddr, shares)
+		if err != nil {
+			return transferred, err
+		}
+
+		if validator, found = k.stakingKeeper.GetValidator(ctx, valAddr); found {
+			// the tokens stay in the pool the validator's status points at
+			if _, err := k.stakingKeeper.Delegate(ctx, to, tokens, validator.GetStatus(), validator, false); err != nil {
+				return transferred, err
+			}
+		} else {
+			// the last delegation to an unbonded validator has been removed
+			// together with the validator, so the tokens are paid out instead
+			coins := sdk.NewCoins(sdk.NewCoin(k.stakingKeeper.BondDenom(ctx), tokens))
+			if err := k.bankKeeper.UndelegateCoinsFromModuleToAccount(ctx, stakingtypes.NotBondedPoolName, to, coins); err != nil {
+				return transferred, err
+			}
+		}
+
+		transferred = transferred.Add(tokens)
+	}
+
+	return transferred, nil
+}
+
+// transferUnbondingDelegations moves up to amount unbonding tokens from the
+// unbonding delegations of from to unbonding delegations of to which complete
+// at the same time. It returns the amount of tokens actually transferred.
+func (k Keeper) transferUnbondingDelegations(ctx sdk.Context, from, to sdk.AccAddress, amount sdkmath.Int) sdkmath.Int {
+	transferred := sdkmath.ZeroInt()
+
+	for _, ubd := range k.stakingKeeper.GetUnbondingDelegations(ctx, from, math.MaxUint16) {
+		if !amount.Sub(transferred).IsPositive() {
+			break
+		}
+
+		valAddr, err := sdk.ValAddressFromBech32(ubd.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+
+		entries := make([]stakingtypes.UnbondingDelegationEntry, 0, len(ubd.Entries))
+		for _, entry := range ubd.Entries {
+			tokens := sdkmath.MinInt(entry.Balance, amount.Sub(transferred))
+			if tokens.IsPositive() {
+				destUbd := k.stakingKeeper.SetUnbondingDelegationEntry(ctx, to, valAddr, entry.CreationHeight, entry.CompletionTime, tokens)
+				k.stakingKeeper.InsertUBDQueue(ctx, destUbd, entry.CompletionTime)
+
+				entry.Balance = entry.Balance.Sub(tokens)
+				entry.InitialBalance = entry.InitialBalance.Sub(sdkmath.MinInt(entry.InitialBalance, tokens))
+				transferred = transferred.Add(tokens)
+			}
+
+			if entry.Balance.IsPositive() {
+				entries = append(entries, entry)
+			}
+		}
+
+		ubd.Entries = entries
+		if len(ubd.Entries) == 0 {
+			k.stakingKeeper.RemoveUnbondingDelegation(ctx, ubd)
+		} else {
+			k.stakingKeeper.SetUnbondingDelegation(ctx, ubd)
+		}
+	}
+
+	return transferred
+}
@@ -0,0 +1,235 @@
+package keeper
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	vestexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Nolus-Protocol/nolus-core/x/vestings/types"
+)
+
+const (
+	// DefaultUnlockPeriod is the period, in seconds, at which the unlock
+	// schedule of continuously vesting accounts is sampled by default.
+	DefaultUnlockPeriod = int64(24 * 60 * 60)
+
+	// MaxUnlocks is the maximum number of unlocks returned by UnlockSchedule.
+	MaxUnlocks = 1000
+)
+
+var _ types.QueryServer = Keeper{}
+
+// Params returns the parameters of the module.
+func (k Keeper) Params(c context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryParamsResponse{Params: k.GetParams(ctx)}, nil
+}
+
+// Balances returns the vested, unvested, locked and spendable coins of an account.
+func (k Keeper) Balances(c context.Context, req *types.QueryBalancesRequest) (*types.QueryBalancesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	va, err := k.getVestingAccount(ctx, req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	blockTime := ctx.BlockTime()
+	if req.Time != 0 {
+		blockTime = time.Unix(req.Time, 0)
+	}
+
+	locked := va.LockedCoins(blockTime)
+	spendable, hasNeg := k.bankKeeper.GetAllBalances(ctx, va.GetAddress()).SafeSub(locked...)
+	if hasNeg {
+		spendable = sdk.NewCoins()
+	}
+
+	return &types.QueryBalancesResponse{
+		Vested:    va.GetVestedCoins(blockTime),
+		Unvested:  va.GetVestingCoins(blockTime),
+		Locked:    locked,
+		Spendable: spendable,
+	}, nil
+}
+
+// UnlockSchedule returns the unlocks of a vesting account after the current block time.
+func (k Keeper) UnlockSchedule(c context.Context, req *types.QueryUnlockScheduleRequest) (*types.QueryUnlockScheduleResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	period := req.Period
+	if period == 0 {
+		period = DefaultUnlockPeriod
+	}
+	if period < 0 {
+		return nil, status.Error(codes.InvalidArgument, "period must be positive")
+	}
+
+	va, err := k.getVestingAccount(ctx, req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	unlockTimes, err := getUnlockTimes(va, ctx.BlockTime().Unix(), period)
+	if err != nil {
+		return nil, err
+	}
+
+	unlocks := make([]types.Unlock, 0, len(unlockTimes))
+	prevVested := va.GetVestedCoins(ctx.BlockTime())
+	for _, t := range unlockTimes {
+		vested := va.GetVestedCoins(time.Unix(t, 0))
+		amount := vested.Sub(prevVested...)
+		if amount.IsZero() {
+			continue
+		}
+
+		unlocks = append(unlocks, types.Unlock{Time: t, Amount: amount, Vested: vested})
+		prevVested = vested
+	}
+
+	return &types.QueryUnlockScheduleResponse{Unlocks: unlocks}, nil
+}
+
+// VestingAccounts returns the accounts created by the module.
+func (k Keeper) VestingAccounts(c context.Context, req *types.QueryVestingAccountsRequest) (*types.QueryVestingAccountsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.VestingAccountKeyPrefix)
+
+	var accounts []*codectypes.Any
+	pageRes, err := query.Paginate(store, req.Pagination, func(key, _ []byte) error {
+		acc := k.accountKeeper.GetAccount(ctx, types.VestingAccountAddressFromKey(key))
+		if acc == nil {
+			return nil
+		}
+
+		any, err := codectypes.NewAnyWithValue(acc)
+		if err != nil {
+			return status.Error(codes.Internal, err.Error())
+		}
+
+		accounts = append(accounts, any)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryVestingAccountsResponse{Accounts: accounts, Pagination: pageRes}, nil
+}
+
+func (k Keeper) getVestingAccount(ctx sdk.Context, address string) (vestexported.VestingAccount, error) {
+	addr, err := sdk.AccAddressFromBech32(address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", err)
+	}
+
+	acc := k.accountKeeper.GetAccount(ctx, addr)
+	if acc == nil {
+		return nil, status.Errorf(codes.NotFound, "account %s not found", address)
+	}
+
+	va, ok := acc.(vestexported.VestingAccount)
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "account %s is not a vesting account", address)
+	}
+
+	return va, nil
+}
+
+// getUnlockTimes returns the times after now at which the vested coins of the
+// account may change. Continuously vesting coins are sampled every period.
+func getUnlockTimes(va vestexported.VestingAccount, now, period int64) ([]int64, error) {
+	endTime := va.GetEndTime()
+	if endTime <= now {
+		return nil, nil
+	}
+
+	var schedules types.Schedules
+	switch acc := va.(type) {
+	case *vestingtypes.PeriodicVestingAccount:
+		var unlockTimes []int64
+		t := acc.StartTime
+		for _, p := range acc.VestingPeriods {
+			t += p.Length
+			if t > now {
+				unlockTimes = append(unlockTimes, t)
+			}
+		}
+		return unlockTimes, nil
+	case *vestingtypes.DelayedVestingAccount:
+		return []int64{endTime}, nil
+	case *vestingtypes.PermanentLockedAccount:
+		return nil, nil
+	case *types.ClawbackVestingAccount:
+		schedules = acc.GetSchedules()
+	case *types.ScheduledVestingAccount:
+		schedules = acc.Schedules
+	default:
+		schedules = types.Schedules{types.NewSchedule(va.GetStartTime(), endTime, false, va.GetOriginalVesting())}
+	}
+
+	seen := make(map[int64]bool)
+	var unlockTimes []int64
+	addUnlock := func(t int64) {
+		if !seen[t] {
+			seen[t] = true
+			unlockTimes = append(unlockTimes, t)
+		}
+	}
+
+	for _, schedule := range schedules {
+		if schedule.EndTime <= now {
+			continue
+		}
+
+		if schedule.Delayed {
+			addUnlock(schedule.EndTime)
+			continue
+		}
+
+		t := now
+		if schedule.StartTime > t {
+			t = schedule.StartTime
+		}
+		if (schedule.EndTime-t)/period >= MaxUnlocks {
+			return nil, status.Errorf(codes.InvalidArgument, "period is too short, the schedule exceeds %d unlocks", MaxUnlocks)
+		}
+
+		for t += period; t < schedule.EndTime; t += period {
+			addUnlock(t)
+		}
+		addUnlock(schedule.EndTime)
+	}
+
+	if len(unlockTimes) > MaxUnlocks {
+		return nil, status.Errorf(codes.InvalidArgument, "period is too short, the schedule exceeds %d unlocks", MaxUnlocks)
+	}
+
+	sort.Slice(unlockTimes, func(i, j int) bool { return unlockTimes[i] < unlockTimes[j] })
+
+	return unlockTimes, nil
+}
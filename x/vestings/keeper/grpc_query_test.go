@@ -0,0 +1,191 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdktestutil "github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Nolus-Protocol/nolus-core/x/vestings/keeper"
+	"github.com/Nolus-Protocol/nolus-core/x/vestings/types"
+)
+
+func (s *KeeperTestSuite) TestQueryBalances() {
+	_, addr := s.createClawbackAccount(false, nil)
+	s.delegate(addr, 700)
+
+	k := s.app.VestingsKeeper
+	res, err := k.Balances(sdk.WrapSDKContext(s.ctx), &types.QueryBalancesRequest{Address: addr.String()})
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewCoins(s.bondCoin(500)), res.Vested)
+	s.Require().Equal(sdk.NewCoins(s.bondCoin(500)), res.Unvested)
+	s.Require().True(res.Locked.IsZero())
+	s.Require().Equal(sdk.NewCoins(s.bondCoin(300)), res.Spendable)
+
+	// a quarter of the period before
+	res, err = k.Balances(sdk.WrapSDKContext(s.ctx), &types.QueryBalancesRequest{
+		Address: addr.String(),
+		Time:    s.ctx.BlockTime().Unix() - 25,
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewCoins(s.bondCoin(250)), res.Vested)
+	s.Require().Equal(sdk.NewCoins(s.bondCoin(750)), res.Unvested)
+	s.Require().Equal(sdk.NewCoins(s.bondCoin(250)), res.Locked)
+	s.Require().Equal(sdk.NewCoins(s.bondCoin(50)), res.Spendable)
+
+	// after the end of the vesting period
+	res, err = k.Balances(sdk.WrapSDKContext(s.ctx), &types.QueryBalancesRequest{
+		Address: addr.String(),
+		Time:    s.ctx.BlockTime().Unix() + 50,
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewCoins(s.bondCoin(vestingAmount)), res.Vested)
+	s.Require().True(res.Unvested.IsZero())
+	s.Require().True(res.Locked.IsZero())
+	s.Require().Equal(sdk.NewCoins(s.bondCoin(300)), res.Spendable)
+}
+
+func (s *KeeperTestSuite) TestQueryBalancesInvalid() {
+	funder, _ := s.createClawbackAccount(false, nil)
+	_, _, unknown := sdktestutil.KeyTestPubAddr()
+	k := s.app.VestingsKeeper
+
+	_, err := k.Balances(sdk.WrapSDKContext(s.ctx), nil)
+	s.Require().Equal(codes.InvalidArgument, status.Code(err))
+
+	_, err = k.Balances(sdk.WrapSDKContext(s.ctx), &types.QueryBalancesRequest{Address: "invalid_address"})
+	s.Require().Equal(codes.InvalidArgument, status.Code(err))
+
+	_, err = k.Balances(sdk.WrapSDKContext(s.ctx), &types.QueryBalancesRequest{Address: unknown.String()})
+	s.Require().Equal(codes.NotFound, status.Code(err))
+
+	// the funder is not a vesting account
+	_, err = k.Balances(sdk.WrapSDKContext(s.ctx), &types.QueryBalancesRequest{Address: funder.String()})
+	s.Require().Equal(codes.InvalidArgument, status.Code(err))
+}
+
+func (s *KeeperTestSuite) TestQueryUnlockSchedule() {
+	_, continuous := s.createClawbackAccount(false, nil)
+	_, delayed := s.createClawbackAccount(true, nil)
+	now := s.ctx.BlockTime().Unix()
+	k := s.app.VestingsKeeper
+
+	res, err := k.UnlockSchedule(sdk.WrapSDKContext(s.ctx), &types.QueryUnlockScheduleRequest{Address: continuous.String(), Period: 10})
+	s.Require().NoError(err)
+	s.Require().Len(res.Unlocks, 5)
+	for i, unlock := range res.Unlocks {
+		s.Require().Equal(now+int64(i+1)*10, unlock.Time)
+		s.Require().Equal(sdk.NewCoins(s.bondCoin(100)), unlock.Amount)
+		s.Require().Equal(sdk.NewCoins(s.bondCoin(int64(600+i*100))), unlock.Vested)
+	}
+
+	// the default period of one day covers the remaining 50 seconds at once
+	res, err = k.UnlockSchedule(sdk.WrapSDKContext(s.ctx), &types.QueryUnlockScheduleRequest{Address: continuous.String()})
+	s.Require().NoError(err)
+	s.Require().Equal([]types.Unlock{{
+		Time:   now + 50,
+		Amount: sdk.NewCoins(s.bondCoin(500)),
+		Vested: sdk.NewCoins(s.bondCoin(vestingAmount)),
+	}}, res.Unlocks)
+
+	res, err = k.UnlockSchedule(sdk.WrapSDKContext(s.ctx), &types.QueryUnlockScheduleRequest{Address: delayed.String(), Period: 10})
+	s.Require().NoError(err)
+	s.Require().Equal([]types.Unlock{{
+		Time:   now + 50,
+		Amount: sdk.NewCoins(s.bondCoin(vestingAmount)),
+		Vested: sdk.NewCoins(s.bondCoin(vestingAmount)),
+	}}, res.Unlocks)
+
+	_, err = k.UnlockSchedule(sdk.WrapSDKContext(s.ctx), &types.QueryUnlockScheduleRequest{Address: continuous.String(), Period: -1})
+	s.Require().Equal(codes.InvalidArgument, status.Code(err))
+
+	// a schedule may not have too many unlocks
+	_, err = k.UnlockSchedule(sdk.WrapSDKContext(s.ctx), &types.QueryUnlockScheduleRequest{Address: continuous.String(), Period: 1})
+	s.Require().NoError(err)
+	_, err = k.UnlockSchedule(sdk.WrapSDKContext(s.ctx.WithBlockTime(s.ctx.BlockTime().Add(-time.Hour))), &types.QueryUnlockScheduleRequest{
+		Address: continuous.String(),
+		Period:  1,
+	})
+	s.Require().NoError(err)
+
+	_, _, funder := sdktestutil.KeyTestPubAddr()
+	_, _, long := sdktestutil.KeyTestPubAddr()
+	s.fundAcc(funder, sdk.NewCoins(s.bondCoin(vestingAmount)))
+	msg := types.NewMsgCreateClawbackVestingAccount(funder, long, sdk.NewCoins(s.bondCoin(vestingAmount)), now, now+10*keeper.MaxUnlocks, false, "", false)
+	_, err = s.msgServer.CreateClawbackVestingAccount(sdk.WrapSDKContext(s.ctx), msg)
+	s.Require().NoError(err)
+	_, err = k.UnlockSchedule(sdk.WrapSDKContext(s.ctx), &types.QueryUnlockScheduleRequest{Address: long.String(), Period: 10})
+	s.Require().Equal(codes.InvalidArgument, status.Code(err))
+}
+
+func (s *KeeperTestSuite) TestQueryUnlockSchedulePeriodic() {
+	_, _, addr := sdktestutil.KeyTestPubAddr()
+	now := s.ctx.BlockTime().Unix()
+
+	// the periodic accounts are not created by the module, but still vesting
+	periods := vestingtypes.Periods{
+		{Length: 10, Amount: sdk.NewCoins(s.bondCoin(100))},
+		{Length: 20, Amount: sdk.NewCoins(s.bondCoin(200))},
+		{Length: 30, Amount: sdk.NewCoins(s.bondCoin(300))},
+	}
+	acc := vestingtypes.NewPeriodicVestingAccount(authtypes.NewBaseAccountWithAddress(addr), sdk.NewCoins(s.bondCoin(600)), now-15, periods)
+	s.app.AccountKeeper.SetAccount(s.ctx, s.app.AccountKeeper.NewAccount(s.ctx, acc))
+
+	res, err := s.app.VestingsKeeper.UnlockSchedule(sdk.WrapSDKContext(s.ctx), &types.QueryUnlockScheduleRequest{Address: addr.String()})
+	s.Require().NoError(err)
+	s.Require().Equal([]types.Unlock{
+		{Time: now + 15, Amount: sdk.NewCoins(s.bondCoin(200)), Vested: sdk.NewCoins(s.bondCoin(300))},
+		{Time: now + 45, Amount: sdk.NewCoins(s.bondCoin(300)), Vested: sdk.NewCoins(s.bondCoin(600))},
+	}, res.Unlocks)
+
+	s.Require().False(s.app.VestingsKeeper.HasVestingAccount(s.ctx, addr))
+}
+
+func (s *KeeperTestSuite) TestQueryVestingAccounts() {
+	var addrs []sdk.AccAddress
+	for i := 0; i < 3; i++ {
+		_, addr := s.createClawbackAccount(i%2 == 0, nil)
+		addrs = append(addrs, addr)
+		s.Require().True(s.app.VestingsKeeper.HasVestingAccount(s.ctx, addr))
+	}
+	k := s.app.VestingsKeeper
+
+	res, err := k.VestingAccounts(sdk.WrapSDKContext(s.ctx), &types.QueryVestingAccountsRequest{
+		Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(res.Accounts, 2)
+	s.Require().Equal(uint64(3), res.Pagination.Total)
+	s.Require().NotNil(res.Pagination.NextKey)
+
+	next, err := k.VestingAccounts(sdk.WrapSDKContext(s.ctx), &types.QueryVestingAccountsRequest{
+		Pagination: &query.PageRequest{Key: res.Pagination.NextKey},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(next.Accounts, 1)
+	s.Require().Nil(next.Pagination.NextKey)
+
+	var queried []string
+	for _, any := range append(res.Accounts, next.Accounts...) {
+		var acc authtypes.AccountI
+		s.Require().NoError(s.app.InterfaceRegistry().UnpackAny(any, &acc))
+		s.Require().IsType(&types.ClawbackVestingAccount{}, acc)
+		queried = append(queried, acc.GetAddress().String())
+	}
+	for _, addr := range addrs {
+		s.Require().Contains(queried, addr.String())
+	}
+
+	var iterated int
+	k.IterateVestingAccounts(s.ctx, func(addr sdk.AccAddress) bool {
+		s.Require().Contains(queried, addr.String())
+		iterated++
+		return false
+	})
+	s.Require().Equal(3, iterated)
+}
@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	vestexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
+
+	"github.com/Nolus-Protocol/nolus-core/x/vestings/types"
+)
+
+// RegisterInvariants registers all vestings invariants.
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "vesting-accounts", VestingAccountsInvariant(k))
+}
+
+// AllInvariants runs all invariants of the vestings module.
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		return VestingAccountsInvariant(k)(ctx)
+	}
+}
+
+// VestingAccountsInvariant checks that every account created by the module is a vesting account
+// whose locked and delegated vesting coins never exceed its original vesting coins.
+func VestingAccountsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken int
+		)
+
+		k.IterateVestingAccounts(ctx, func(addr sdk.AccAddress) bool {
+			va, ok := k.accountKeeper.GetAccount(ctx, addr).(vestexported.VestingAccount)
+			if !ok {
+				broken++
+				msg += fmt.Sprintf("\t%s is not a vesting account\n", addr)
+				return false
+			}
+
+			original := va.GetOriginalVesting()
+			if locked := va.LockedCoins(ctx.BlockTime()); !original.IsAllGTE(locked) {
+				broken++
+				msg += fmt.Sprintf("\t%s has locked coins %s exceeding the original vesting %s\n", addr, locked, original)
+			}
+			if delegated := va.GetDelegatedVesting(); !original.IsAllGTE(delegated) {
+				broken++
+				msg += fmt.Sprintf("\t%s has delegated vesting %s exceeding the original vesting %s\n", addr, delegated, original)
+			}
+
+			return false
+		})
+
+		return sdk.FormatInvariant(types.ModuleName, "vesting accounts",
+			fmt.Sprintf("%d vesting account invariants broken\n%s", broken, msg)), broken != 0
+	}
+}
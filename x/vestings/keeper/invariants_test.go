@@ -0,0 +1,39 @@
+package keeper_test
+
+import (
+	sdktestutil "github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+
+	"github.com/Nolus-Protocol/nolus-core/x/vestings/keeper"
+)
+
+func (s *KeeperTestSuite) TestVestingAccountsInvariant() {
+	_, _, funder := sdktestutil.KeyTestPubAddr()
+	_, _, addr := sdktestutil.KeyTestPubAddr()
+	now := s.ctx.BlockTime().Unix()
+	invariant := keeper.AllInvariants(*s.app.VestingsKeeper)
+
+	s.Require().NoError(s.createVestingAccount(funder, addr, now-50, now+50, false, false))
+	s.delegate(addr, 400)
+	_, broken := invariant(s.ctx)
+	s.Require().False(broken)
+
+	// the delegated vesting coins exceed the original vesting
+	va := s.app.AccountKeeper.GetAccount(s.ctx, addr).(*vestingtypes.ContinuousVestingAccount)
+	va.DelegatedVesting = va.OriginalVesting.Add(s.bondCoin(1))
+	s.app.AccountKeeper.SetAccount(s.ctx, va)
+	msg, broken := invariant(s.ctx)
+	s.Require().True(broken)
+	s.Require().Contains(msg, "delegated vesting")
+
+	// an indexed account which is not a vesting account
+	_, _, other := sdktestutil.KeyTestPubAddr()
+	s.app.AccountKeeper.SetAccount(s.ctx, authtypes.NewBaseAccountWithAddress(other))
+	s.app.VestingsKeeper.SetVestingAccount(s.ctx, other)
+	msg, broken = invariant(s.ctx)
+	s.Require().True(broken)
+	s.Require().Contains(msg, sdk.AccAddress(other).String()+" is not a vesting account")
+	s.Require().Contains(msg, "2 vesting account invariants broken")
+}
@@ -2,13 +2,19 @@ package keeper_test
 
 import (
 	"testing"
+	"time"
 
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	"github.com/cosmos/cosmos-sdk/client"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
 	"github.com/stretchr/testify/suite"
 
 	nolusapp "github.com/Nolus-Protocol/nolus-core/app"
+	"github.com/Nolus-Protocol/nolus-core/app/params"
+	simulationapp "github.com/Nolus-Protocol/nolus-core/testutil/simapp"
+	"github.com/Nolus-Protocol/nolus-core/x/vestings/keeper"
+	"github.com/Nolus-Protocol/nolus-core/x/vestings/types"
 )
 
 type KeeperTestSuite struct {
@@ -17,18 +23,26 @@ type KeeperTestSuite struct {
 	app       *nolusapp.App
 	clientCtx client.Context
 	txBuilder client.TxBuilder
+	msgServer types.MsgServer
 }
 
-func (s *KeeperTestSuite) SetupTest(isCheckTx bool) {
-	tempDir := s.T().TempDir()
-	s.app, s.ctx = nolusapp.CreateTestApp(isCheckTx, tempDir)
-	s.ctx = s.ctx.WithBlockHeight(1)
+// SetupTest setups a new test, with an app having a single bonded validator.
+func (s *KeeperTestSuite) SetupTest() {
+	var err error
+	_ = params.SetAddressPrefixes()
+	s.app, err = simulationapp.TestSetup(s.T())
+	s.Require().NoError(err)
+
+	header := tmproto.Header{Height: s.app.LastBlockHeight() + 1}
+	s.ctx = s.app.BaseApp.NewContext(false, header).WithBlockTime(time.Now())
 
 	// set up TxConfig
 	encodingConfig := moduletestutil.MakeTestEncodingConfig()
 	s.clientCtx = client.Context{}.WithTxConfig(encodingConfig.TxConfig)
 	s.txBuilder = s.clientCtx.TxConfig.NewTxBuilder()
 	s.Require().NoError(s.txBuilder.SetMsgs([]sdk.Msg{}...))
+
+	s.msgServer = keeper.NewMsgServerImpl(*s.app.VestingsKeeper)
 }
 
 func TestKeeperTestSuite(t *testing.T) {
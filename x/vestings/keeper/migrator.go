@@ -0,0 +1,29 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	v3 "github.com/Nolus-Protocol/nolus-core/x/vestings/migrations/v3"
+	"github.com/Nolus-Protocol/nolus-core/x/vestings/types"
+)
+
+// Migrator is a struct for handling in-place state migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+func NewMigrator(k Keeper) Migrator {
+	return Migrator{
+		keeper: k,
+	}
+}
+
+// Migrate2to3 migrates the x/vestings module state from the consensus version 2 to
+// version 3. It sets the default parameters and indexes the existing vesting accounts.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	if err := m.keeper.SetParams(ctx, types.DefaultParams()); err != nil {
+		return err
+	}
+
+	return v3.Migrate(ctx, ctx.KVStore(m.keeper.storeKey), m.keeper.accountKeeper)
+}
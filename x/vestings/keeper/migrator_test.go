@@ -0,0 +1,37 @@
+package keeper_test
+
+import (
+	sdktestutil "github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+
+	"github.com/Nolus-Protocol/nolus-core/x/vestings/keeper"
+)
+
+func (s *KeeperTestSuite) TestMigrate2to3() {
+	now := s.ctx.BlockTime().Unix()
+	amount := sdk.NewCoins(s.bondCoin(100))
+	newBaseVestingAccount := func() *vestingtypes.BaseVestingAccount {
+		_, _, addr := sdktestutil.KeyTestPubAddr()
+		return vestingtypes.NewBaseVestingAccount(authtypes.NewBaseAccountWithAddress(addr), amount, now+100)
+	}
+
+	continuous := vestingtypes.NewContinuousVestingAccountRaw(newBaseVestingAccount(), now)
+	delayed := vestingtypes.NewDelayedVestingAccountRaw(newBaseVestingAccount())
+	periodic := vestingtypes.NewPeriodicVestingAccountRaw(newBaseVestingAccount(), now, vestingtypes.Periods{{Length: 100, Amount: amount}})
+	_, _, addr := sdktestutil.KeyTestPubAddr()
+	base := authtypes.NewBaseAccountWithAddress(addr)
+	for _, acc := range []authtypes.AccountI{continuous, delayed, periodic, base} {
+		s.app.AccountKeeper.SetAccount(s.ctx, s.app.AccountKeeper.NewAccount(s.ctx, acc))
+	}
+
+	k := *s.app.VestingsKeeper
+	s.Require().NoError(keeper.NewMigrator(k).Migrate2to3(s.ctx))
+
+	// only the account types created by the module up to version 2 are indexed
+	s.Require().True(k.HasVestingAccount(s.ctx, continuous.GetAddress()))
+	s.Require().True(k.HasVestingAccount(s.ctx, delayed.GetAddress()))
+	s.Require().False(k.HasVestingAccount(s.ctx, periodic.GetAddress()))
+	s.Require().False(k.HasVestingAccount(s.ctx, base.GetAddress()))
+}
@@ -0,0 +1,64 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/Nolus-Protocol/nolus-core/x/vestings/types"
+)
+
+func (k msgServer) Clawback(goCtx context.Context, msg *types.MsgClawback) (*types.MsgClawbackResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	addr, err := sdk.AccAddressFromBech32(msg.AccountAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	va, err := k.getClawbackVestingAccount(ctx, addr)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.AdminAddress != va.GetClawbackAdmin() {
+		return nil, errorsmod.Wrapf(types.ErrNotClawbackAdmin, "expected %s, got %s", va.GetClawbackAdmin(), msg.AdminAddress)
+	}
+
+	destAddress := msg.DestAddress
+	if destAddress == "" {
+		destAddress = va.FunderAddress
+	}
+
+	dest, err := sdk.AccAddressFromBech32(destAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	if dest.Equals(addr) {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "destination cannot be the vesting account")
+	}
+
+	if k.bankKeeper.BlockedAddr(dest) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", destAddress)
+	}
+
+	amount, err := k.Keeper.Clawback(ctx, addr, dest)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeClawback,
+			sdk.NewAttribute(types.AttributeKeyAccount, msg.AccountAddress),
+			sdk.NewAttribute(types.AttributeKeyAdmin, msg.AdminAddress),
+			sdk.NewAttribute(types.AttributeKeyDestination, destAddress),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+		),
+	)
+
+	return &types.MsgClawbackResponse{Amount: amount}, nil
+}
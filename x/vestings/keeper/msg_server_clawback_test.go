@@ -0,0 +1,314 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdktestutil "github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/Nolus-Protocol/nolus-core/x/vestings/types"
+)
+
+const vestingAmount = 1000
+
+// createClawbackAccount funds a new account and creates a continuous, or delayed,
+// clawback vesting account from it, which is half way through its vesting period.
+func (s *KeeperTestSuite) createClawbackAccount(delayed bool, admin sdk.AccAddress) (funder, addr sdk.AccAddress) {
+	_, _, funder = sdktestutil.KeyTestPubAddr()
+	_, _, addr = sdktestutil.KeyTestPubAddr()
+
+	amount := sdk.NewCoins(s.bondCoin(vestingAmount))
+	s.fundAcc(funder, amount)
+
+	var adminAddress string
+	if admin != nil {
+		adminAddress = admin.String()
+	}
+
+	now := s.ctx.BlockTime().Unix()
+	msg := types.NewMsgCreateClawbackVestingAccount(funder, addr, amount, now-50, now+50, delayed, adminAddress, false)
+	_, err := s.msgServer.CreateClawbackVestingAccount(sdk.WrapSDKContext(s.ctx), msg)
+	s.Require().NoError(err)
+
+	return funder, addr
+}
+
+func (s *KeeperTestSuite) clawback(admin, addr, dest sdk.AccAddress) (sdk.Coins, error) {
+	res, err := s.msgServer.Clawback(sdk.WrapSDKContext(s.ctx), types.NewMsgClawback(admin, addr, dest))
+	if err != nil {
+		return nil, err
+	}
+	return res.Amount, nil
+}
+
+func (s *KeeperTestSuite) clawbackAccount(addr sdk.AccAddress) *types.ClawbackVestingAccount {
+	acc, ok := s.app.AccountKeeper.GetAccount(s.ctx, addr).(*types.ClawbackVestingAccount)
+	s.Require().True(ok)
+	return acc
+}
+
+func (s *KeeperTestSuite) validator() stakingtypes.Validator {
+	validators := s.app.StakingKeeper.GetAllValidators(s.ctx)
+	s.Require().Len(validators, 1)
+	return validators[0]
+}
+
+func (s *KeeperTestSuite) delegate(addr sdk.AccAddress, amount int64) {
+	_, err := s.app.StakingKeeper.Delegate(s.ctx, addr, sdkmath.NewInt(amount), stakingtypes.Unbonded, s.validator(), true)
+	s.Require().NoError(err)
+}
+
+func (s *KeeperTestSuite) delegatedTokens(addr sdk.AccAddress) sdkmath.Int {
+	delegation, found := s.app.StakingKeeper.GetDelegation(s.ctx, addr, s.validator().GetOperator())
+	if !found {
+		return sdkmath.ZeroInt()
+	}
+	return s.validator().TokensFromShares(delegation.Shares).TruncateInt()
+}
+
+func (s *KeeperTestSuite) unbondingTokens(addr sdk.AccAddress) sdkmath.Int {
+	return s.app.StakingKeeper.GetDelegatorUnbonding(s.ctx, addr)
+}
+
+func (s *KeeperTestSuite) bondCoin(amount int64) sdk.Coin {
+	return sdk.NewInt64Coin(s.app.StakingKeeper.BondDenom(s.ctx), amount)
+}
+
+func (s *KeeperTestSuite) balance(addr sdk.AccAddress) sdkmath.Int {
+	return s.app.BankKeeper.GetBalance(s.ctx, addr, s.app.StakingKeeper.BondDenom(s.ctx)).Amount
+}
+
+// fundAcc funds target address with specified amount.
+func (s *KeeperTestSuite) fundAcc(addr sdk.AccAddress, amounts sdk.Coins) {
+	s.Require().NoError(banktestutil.FundAccount(s.app.BankKeeper, s.ctx, addr, amounts))
+}
+
+func (s *KeeperTestSuite) spendable(addr sdk.AccAddress) sdkmath.Int {
+	return s.app.BankKeeper.SpendableCoins(s.ctx, addr).AmountOf(s.app.StakingKeeper.BondDenom(s.ctx))
+}
+
+func (s *KeeperTestSuite) TestCreateClawbackVestingAccount() {
+	_, _, admin := sdktestutil.KeyTestPubAddr()
+	funder, addr := s.createClawbackAccount(false, admin)
+
+	acc := s.clawbackAccount(addr)
+	s.Require().Equal(funder.String(), acc.FunderAddress)
+	s.Require().Equal(admin.String(), acc.GetClawbackAdmin())
+	s.Require().NoError(acc.Validate())
+	s.Require().Equal(sdk.NewCoins(s.bondCoin(vestingAmount)), acc.GetOriginalVesting())
+	s.Require().Equal(int64(vestingAmount/2), s.spendable(addr).Int64())
+
+	// the account cannot be created twice
+	msg := types.NewMsgCreateClawbackVestingAccount(funder, addr, sdk.NewCoins(s.bondCoin(1)), 1, 2, false, "", false)
+	_, err := s.msgServer.CreateClawbackVestingAccount(sdk.WrapSDKContext(s.ctx), msg)
+	s.Require().Error(err)
+}
+
+func (s *KeeperTestSuite) TestClawbackLiquid() {
+	funder, addr := s.createClawbackAccount(false, nil)
+
+	clawedBack, err := s.clawback(funder, addr, nil)
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewCoins(s.bondCoin(vestingAmount/2)), clawedBack)
+
+	// the unvested half is back at the funder and the vested half is spendable
+	s.Require().Equal(int64(vestingAmount/2), s.balance(funder).Int64())
+	s.Require().Equal(int64(vestingAmount/2), s.balance(addr).Int64())
+	s.Require().Equal(int64(vestingAmount/2), s.spendable(addr).Int64())
+
+	acc := s.clawbackAccount(addr)
+	s.Require().Equal(sdk.NewCoins(s.bondCoin(vestingAmount/2)), acc.GetOriginalVesting())
+	s.Require().Equal(s.ctx.BlockTime().Unix(), acc.GetEndTime())
+	s.Require().True(acc.LockedCoins(s.ctx.BlockTime()).IsZero())
+	s.Require().NoError(acc.Validate())
+
+	// there is nothing left to claw back
+	_, err = s.clawback(funder, addr, nil)
+	s.Require().ErrorIs(err, types.ErrNothingToClawback)
+}
+
+func (s *KeeperTestSuite) TestClawbackDelayed() {
+	funder, addr := s.createClawbackAccount(true, nil)
+	_, _, dest := sdktestutil.KeyTestPubAddr()
+
+	clawedBack, err := s.clawback(funder, addr, dest)
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewCoins(s.bondCoin(vestingAmount)), clawedBack)
+
+	s.Require().Equal(int64(vestingAmount), s.balance(dest).Int64())
+	s.Require().True(s.balance(funder).IsZero())
+	s.Require().True(s.balance(addr).IsZero())
+	s.Require().True(s.clawbackAccount(addr).GetOriginalVesting().IsZero())
+}
+
+func (s *KeeperTestSuite) TestClawbackUnauthorized() {
+	_, _, admin := sdktestutil.KeyTestPubAddr()
+	funder, addr := s.createClawbackAccount(false, admin)
+
+	// the funder cannot claw back once an admin is set
+	_, err := s.clawback(funder, addr, nil)
+	s.Require().ErrorIs(err, types.ErrNotClawbackAdmin)
+
+	_, err = s.clawback(addr, addr, funder)
+	s.Require().ErrorIs(err, types.ErrNotClawbackAdmin)
+
+	// the admin returns the coins to the funder by default
+	_, err = s.clawback(admin, addr, nil)
+	s.Require().NoError(err)
+	s.Require().Equal(int64(vestingAmount/2), s.balance(funder).Int64())
+	s.Require().True(s.balance(admin).IsZero())
+}
+
+func (s *KeeperTestSuite) TestClawbackNotClawbackAccount() {
+	_, _, funder := sdktestutil.KeyTestPubAddr()
+	_, _, addr := sdktestutil.KeyTestPubAddr()
+
+	amount := sdk.NewCoins(s.bondCoin(vestingAmount))
+	s.fundAcc(funder, amount)
+
+	now := s.ctx.BlockTime().Unix()
+	msg := types.NewMsgCreateVestingAccount(funder, addr, amount, now-50, now+50, false, false)
+	_, err := s.msgServer.CreateVestingAccount(sdk.WrapSDKContext(s.ctx), msg)
+	s.Require().NoError(err)
+
+	_, err = s.clawback(funder, addr, nil)
+	s.Require().ErrorIs(err, types.ErrNotClawbackAccount)
+
+	_, err = s.clawback(funder, funder, nil)
+	s.Require().ErrorIs(err, types.ErrNotClawbackAccount)
+}
+
+func (s *KeeperTestSuite) TestClawbackDelegated() {
+	funder, addr := s.createClawbackAccount(false, nil)
+	_, _, dest := sdktestutil.KeyTestPubAddr()
+	bondedPool := s.app.AccountKeeper.GetModuleAddress(stakingtypes.BondedPoolName)
+	bondedBefore := s.balance(bondedPool)
+
+	// half of the delegation is vesting and half is free
+	s.delegate(addr, vestingAmount)
+	acc := s.clawbackAccount(addr)
+	s.Require().Equal(sdk.NewCoins(s.bondCoin(vestingAmount/2)), acc.GetDelegatedVesting())
+	s.Require().Equal(sdk.NewCoins(s.bondCoin(vestingAmount/2)), acc.GetDelegatedFree())
+	s.Require().True(s.balance(addr).IsZero())
+
+	clawedBack, err := s.clawback(funder, addr, dest)
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewCoins(s.bondCoin(vestingAmount/2)), clawedBack)
+
+	// the unvested half of the delegation is now owned by the destination
+	s.Require().Equal(int64(vestingAmount/2), s.delegatedTokens(dest).Int64())
+	s.Require().Equal(int64(vestingAmount/2), s.delegatedTokens(addr).Int64())
+	s.Require().True(s.balance(dest).IsZero())
+	s.Require().Equal(bondedBefore.AddRaw(vestingAmount), s.balance(bondedPool))
+
+	acc = s.clawbackAccount(addr)
+	s.Require().True(acc.GetDelegatedVesting().IsZero())
+	s.Require().Equal(sdk.NewCoins(s.bondCoin(vestingAmount/2)), acc.GetDelegatedFree())
+	s.Require().Equal(sdk.NewCoins(s.bondCoin(vestingAmount/2)), acc.GetOriginalVesting())
+	s.Require().NoError(acc.Validate())
+
+	// the remaining delegation is free to undelegate
+	shares, err := s.app.StakingKeeper.ValidateUnbondAmount(s.ctx, addr, s.validator().GetOperator(), sdkmath.NewInt(vestingAmount/2))
+	s.Require().NoError(err)
+	_, err = s.app.StakingKeeper.Undelegate(s.ctx, addr, s.validator().GetOperator(), shares)
+	s.Require().NoError(err)
+}
+
+func (s *KeeperTestSuite) TestClawbackPartiallyDelegated() {
+	funder, addr := s.createClawbackAccount(false, nil)
+
+	// the whole unvested amount is delegated, together with 200 vested coins
+	s.delegate(addr, 700)
+	s.Require().Equal(int64(300), s.spendable(addr).Int64())
+
+	clawedBack, err := s.clawback(funder, addr, nil)
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewCoins(s.bondCoin(vestingAmount/2)), clawedBack)
+
+	s.Require().Equal(int64(vestingAmount/2), s.delegatedTokens(funder).Int64())
+	s.Require().Equal(int64(200), s.delegatedTokens(addr).Int64())
+	s.Require().Equal(int64(300), s.spendable(addr).Int64())
+
+	acc := s.clawbackAccount(addr)
+	s.Require().Equal(sdk.NewCoins(s.bondCoin(200)), acc.GetDelegatedFree())
+	s.Require().True(acc.GetDelegatedVesting().IsZero())
+}
+
+func (s *KeeperTestSuite) TestClawbackLiquidAndDelegated() {
+	funder, addr := s.createClawbackAccount(false, nil)
+
+	// 200 unvested coins are delegated and 300 are still in the account
+	s.delegate(addr, 200)
+	s.Require().Equal(int64(vestingAmount/2), s.spendable(addr).Int64())
+
+	clawedBack, err := s.clawback(funder, addr, nil)
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewCoins(s.bondCoin(vestingAmount/2)), clawedBack)
+
+	s.Require().Equal(int64(300), s.balance(funder).Int64())
+	s.Require().Equal(int64(200), s.delegatedTokens(funder).Int64())
+	s.Require().True(s.delegatedTokens(addr).IsZero())
+	s.Require().Equal(int64(vestingAmount/2), s.spendable(addr).Int64())
+
+	acc := s.clawbackAccount(addr)
+	s.Require().True(acc.GetDelegatedFree().IsZero())
+	s.Require().True(acc.GetDelegatedVesting().IsZero())
+}
+
+func (s *KeeperTestSuite) TestClawbackUnbonding() {
+	funder, addr := s.createClawbackAccount(false, nil)
+	_, _, dest := sdktestutil.KeyTestPubAddr()
+	valAddr := s.validator().GetOperator()
+
+	s.delegate(addr, vestingAmount)
+	shares, err := s.app.StakingKeeper.ValidateUnbondAmount(s.ctx, addr, valAddr, sdkmath.NewInt(vestingAmount))
+	s.Require().NoError(err)
+	completionTime, err := s.app.StakingKeeper.Undelegate(s.ctx, addr, valAddr, shares)
+	s.Require().NoError(err)
+	s.Require().True(s.delegatedTokens(addr).IsZero())
+
+	clawedBack, err := s.clawback(funder, addr, dest)
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewCoins(s.bondCoin(vestingAmount/2)), clawedBack)
+
+	// the unbonding entry is split between the account and the destination
+	s.Require().Equal(int64(vestingAmount/2), s.unbondingTokens(dest).Int64())
+	s.Require().Equal(int64(vestingAmount/2), s.unbondingTokens(addr).Int64())
+
+	// both complete at the original completion time
+	s.ctx = s.ctx.WithBlockTime(completionTime)
+	s.app.StakingKeeper.BlockValidatorUpdates(s.ctx)
+
+	s.Require().True(s.unbondingTokens(dest).IsZero())
+	s.Require().True(s.unbondingTokens(addr).IsZero())
+	s.Require().Equal(int64(vestingAmount/2), s.balance(dest).Int64())
+	s.Require().Equal(int64(vestingAmount/2), s.spendable(addr).Int64())
+	s.Require().True(s.balance(funder).IsZero())
+
+	acc := s.clawbackAccount(addr)
+	s.Require().True(acc.GetDelegatedFree().IsZero())
+	s.Require().True(acc.GetDelegatedVesting().IsZero())
+}
+
+func (s *KeeperTestSuite) TestClawbackDelegatedAndUnbonding() {
+	funder, addr := s.createClawbackAccount(false, nil)
+	valAddr := s.validator().GetOperator()
+
+	// 300 unvested coins stay delegated and the rest are unbonding
+	s.delegate(addr, vestingAmount)
+	shares, err := s.app.StakingKeeper.ValidateUnbondAmount(s.ctx, addr, valAddr, sdkmath.NewInt(700))
+	s.Require().NoError(err)
+	_, err = s.app.StakingKeeper.Undelegate(s.ctx, addr, valAddr, shares)
+	s.Require().NoError(err)
+
+	clawedBack, err := s.clawback(funder, addr, nil)
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewCoins(s.bondCoin(vestingAmount/2)), clawedBack)
+
+	// delegations are transferred before unbonding delegations
+	s.Require().Equal(int64(300), s.delegatedTokens(funder).Int64())
+	s.Require().Equal(int64(200), s.unbondingTokens(funder).Int64())
+	s.Require().True(s.delegatedTokens(addr).IsZero())
+	s.Require().Equal(int64(vestingAmount/2), s.unbondingTokens(addr).Int64())
+}
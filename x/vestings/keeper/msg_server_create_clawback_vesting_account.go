@@ -0,0 +1,55 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/Nolus-Protocol/nolus-core/x/vestings/types"
+)
+
+func (k msgServer) CreateClawbackVestingAccount(goCtx context.Context, msg *types.MsgCreateClawbackVestingAccount) (*types.MsgCreateClawbackVestingAccountResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.GetParams(ctx).ValidateSchedule(msg.Amount, msg.StartTime, msg.EndTime); err != nil {
+		return nil, err
+	}
+
+	existing, err := k.getVestingAccountTarget(ctx, msg.ToAddress, msg.Amount, msg.Merge)
+	if err != nil {
+		return nil, err
+	}
+
+	var acc *types.ClawbackVestingAccount
+
+	switch existing := existing.(type) {
+	case nil, *authtypes.BaseAccount:
+		baseVestingAccount, err := k.newBaseVestingAccount(ctx, msg.ToAddress, existing, msg.Amount, msg.EndTime)
+		if err != nil {
+			return nil, err
+		}
+
+		acc = types.NewClawbackVestingAccount(baseVestingAccount, msg.StartTime, msg.Delayed, msg.FromAddress, msg.ClawbackAdmin)
+		k.trackExistingDelegations(ctx, baseVestingAccount)
+	case *types.ClawbackVestingAccount:
+		// the schedules of an account are clawed back together, so only the
+		// funder may add to them and may not change the admin
+		if existing.FunderAddress != msg.FromAddress || existing.ClawbackAdmin != msg.ClawbackAdmin {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "schedules of %s may only be added by its funder with the same clawback admin", msg.ToAddress)
+		}
+
+		existing.AddSchedule(ctx.BlockTime(), types.NewSchedule(msg.StartTime, msg.EndTime, msg.Delayed, msg.Amount.Sort()))
+		acc = existing
+	default:
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "account %s is not a clawback vesting account", msg.ToAddress)
+	}
+
+	if err := k.fundVestingAccount(ctx, msg.FromAddress, acc, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateClawbackVestingAccountResponse{}, nil
+}
@@ -9,6 +9,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
 	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
 
 	"github.com/Nolus-Protocol/nolus-core/x/vestings/types"
@@ -16,51 +17,146 @@ import (
 
 func (k msgServer) CreateVestingAccount(goCtx context.Context, msg *types.MsgCreateVestingAccount) (*types.MsgCreateVestingAccountResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
-	ak := k.accountKeeper
-	bk := k.bankKeeper
 
-	if err := bk.IsSendEnabledCoins(ctx, msg.Amount...); err != nil {
+	if err := k.GetParams(ctx).ValidateSchedule(msg.Amount, msg.StartTime, msg.EndTime); err != nil {
 		return nil, err
 	}
 
-	from, err := sdk.AccAddressFromBech32(msg.FromAddress)
+	existing, err := k.getVestingAccountTarget(ctx, msg.ToAddress, msg.Amount, msg.Merge)
 	if err != nil {
 		return nil, err
 	}
-	to, err := sdk.AccAddressFromBech32(msg.ToAddress)
+
+	var acc authtypes.AccountI
+
+	switch existing := existing.(type) {
+	case nil, *authtypes.BaseAccount:
+		baseVestingAccount, err := k.newBaseVestingAccount(ctx, msg.ToAddress, existing, msg.Amount, msg.EndTime)
+		if err != nil {
+			return nil, err
+		}
+
+		var va vestexported.VestingAccount
+		if msg.Delayed {
+			va = vestingtypes.NewDelayedVestingAccountRaw(baseVestingAccount)
+		} else {
+			va = vestingtypes.NewContinuousVestingAccountRaw(baseVestingAccount, msg.StartTime)
+		}
+
+		k.trackExistingDelegations(ctx, baseVestingAccount)
+		acc = va
+	case *types.ClawbackVestingAccount:
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "account %s is a clawback vesting account", msg.ToAddress)
+	default:
+		sva, err := types.NewScheduledVestingAccountFrom(existing.(vestexported.VestingAccount))
+		if err != nil {
+			return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		}
+
+		sva.AddSchedule(ctx.BlockTime(), types.NewSchedule(msg.StartTime, msg.EndTime, msg.Delayed, msg.Amount.Sort()))
+		acc = sva
+	}
+
+	if err := k.fundVestingAccount(ctx, msg.FromAddress, acc, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateVestingAccountResponse{}, nil
+}
+
+// getVestingAccountTarget checks that the amount may be sent to the given
+// address and returns the account existing at it, if any. Existing accounts
+// are only returned on merge, if they are base accounts or vesting accounts
+// created by the module, and a merge requires an existing account.
+func (k Keeper) getVestingAccountTarget(ctx sdk.Context, toAddress string, amount sdk.Coins, merge bool) (authtypes.AccountI, error) {
+	bk := k.bankKeeper
+
+	if err := bk.IsSendEnabledCoins(ctx, amount...); err != nil {
+		return nil, err
+	}
+
+	to, err := sdk.AccAddressFromBech32(toAddress)
 	if err != nil {
 		return nil, err
 	}
 
 	if bk.BlockedAddr(to) {
-		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", msg.ToAddress)
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", toAddress)
 	}
 
-	if acc := ak.GetAccount(ctx, to); acc != nil {
-		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "account %s already exists", msg.ToAddress)
+	acc := k.accountKeeper.GetAccount(ctx, to)
+	if acc == nil {
+		// the owner of the destination signs a merge, which it cannot do
+		// without an account
+		if merge {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "account %s does not exist to merge into", toAddress)
+		}
+
+		return nil, nil
+	}
+
+	if merge {
+		if _, ok := acc.(*authtypes.BaseAccount); ok {
+			return acc, nil
+		}
+		if _, ok := acc.(vestexported.VestingAccount); ok && k.HasVestingAccount(ctx, to) {
+			return acc, nil
+		}
+	}
+
+	return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "account %s already exists", toAddress)
+}
+
+// newBaseVestingAccount returns the base vesting account of a new vesting
+// account at the given address, or of the existing base account converted
+// into a vesting account.
+func (k Keeper) newBaseVestingAccount(ctx sdk.Context, toAddress string, existing authtypes.AccountI, amount sdk.Coins, endTime int64) (*vestingtypes.BaseVestingAccount, error) {
+	baseAccount := existing
+	if baseAccount == nil {
+		to, err := sdk.AccAddressFromBech32(toAddress)
+		if err != nil {
+			return nil, err
+		}
+
+		baseAccount = k.accountKeeper.NewAccountWithAddress(ctx, to)
 	}
 
-	baseAccount := ak.NewAccountWithAddress(ctx, to)
 	if _, ok := baseAccount.(*authtypes.BaseAccount); !ok {
 		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid account type; expected: BaseAccount, got: %T", baseAccount)
 	}
 
-	baseVestingAccount := vestingtypes.NewBaseVestingAccount(baseAccount.(*authtypes.BaseAccount), msg.Amount.Sort(), msg.EndTime)
+	return vestingtypes.NewBaseVestingAccount(baseAccount.(*authtypes.BaseAccount), amount.Sort(), endTime), nil
+}
 
-	var acc authtypes.AccountI
+// trackExistingDelegations tracks the tokens which a base account has delegated
+// before its conversion into a vesting account, so that undelegating them
+// keeps the vesting accounting consistent. They are the account's own tokens,
+// so they are delegated free and a clawback never takes them.
+func (k Keeper) trackExistingDelegations(ctx sdk.Context, bva *vestingtypes.BaseVestingAccount) {
+	addr := bva.GetAddress()
+	delegated := k.stakingKeeper.GetDelegatorBonded(ctx, addr).Add(k.stakingKeeper.GetDelegatorUnbonding(ctx, addr))
+	if !delegated.IsPositive() {
+		return
+	}
 
-	if msg.Delayed {
-		acc = vestingtypes.NewDelayedVestingAccountRaw(baseVestingAccount)
-	} else {
-		acc = vestingtypes.NewContinuousVestingAccountRaw(baseVestingAccount, msg.StartTime)
+	bva.DelegatedFree = sdk.NewCoins(sdk.NewCoin(k.stakingKeeper.BondDenom(ctx), delegated))
+}
+
+// fundVestingAccount stores the new or updated vesting account and sends the
+// added vesting amount from the funder.
+func (k Keeper) fundVestingAccount(ctx sdk.Context, fromAddress string, acc authtypes.AccountI, amount sdk.Coins) error {
+	from, err := sdk.AccAddressFromBech32(fromAddress)
+	if err != nil {
+		return err
 	}
 
-	ak.SetAccount(ctx, acc)
+	k.accountKeeper.SetAccount(ctx, acc)
+	k.SetVestingAccount(ctx, acc.GetAddress())
 
 	defer func() {
 		telemetry.IncrCounter(1, "new", "account")
 
-		for _, a := range msg.Amount {
+		for _, a := range amount {
 			if a.Amount.IsInt64() {
 				telemetry.SetGaugeWithLabels(
 					[]string{"tx", "msg", "create_vesting_account"},
@@ -71,10 +167,5 @@ func (k msgServer) CreateVestingAccount(goCtx context.Context, msg *types.MsgCre
 		}
 	}()
 
-	err = bk.SendCoins(ctx, from, to, msg.Amount)
-	if err != nil {
-		return nil, err
-	}
-
-	return &types.MsgCreateVestingAccountResponse{}, nil
+	return k.bankKeeper.SendCoins(ctx, from, acc.GetAddress(), amount)
 }
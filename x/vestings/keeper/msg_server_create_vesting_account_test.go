@@ -0,0 +1,235 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdktestutil "github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+
+	"github.com/Nolus-Protocol/nolus-core/x/vestings/types"
+)
+
+// createVestingAccount funds the funder and creates, or merges into, a continuous
+// or delayed vesting account from it.
+func (s *KeeperTestSuite) createVestingAccount(funder, addr sdk.AccAddress, startTime, endTime int64, delayed, merge bool) error {
+	amount := sdk.NewCoins(s.bondCoin(vestingAmount))
+	s.fundAcc(funder, amount)
+
+	msg := types.NewMsgCreateVestingAccount(funder, addr, amount, startTime, endTime, delayed, merge)
+	_, err := s.msgServer.CreateVestingAccount(sdk.WrapSDKContext(s.ctx), msg)
+	return err
+}
+
+func (s *KeeperTestSuite) TestCreateVestingAccountMergeBaseAccount() {
+	_, _, funder := sdktestutil.KeyTestPubAddr()
+	_, _, addr := sdktestutil.KeyTestPubAddr()
+	now := s.ctx.BlockTime().Unix()
+
+	// a base account with delegated and liquid tokens
+	s.fundAcc(addr, sdk.NewCoins(s.bondCoin(vestingAmount)))
+	s.delegate(addr, 400)
+	accNumber := s.app.AccountKeeper.GetAccount(s.ctx, addr).GetAccountNumber()
+
+	err := s.createVestingAccount(funder, addr, now-50, now+50, false, false)
+	s.Require().ErrorIs(err, sdkerrors.ErrInvalidRequest)
+
+	err = s.createVestingAccount(funder, addr, now-50, now+50, false, true)
+	s.Require().NoError(err)
+	s.Require().True(s.app.VestingsKeeper.HasVestingAccount(s.ctx, addr))
+
+	acc, ok := s.app.AccountKeeper.GetAccount(s.ctx, addr).(*vestingtypes.ContinuousVestingAccount)
+	s.Require().True(ok)
+	s.Require().Equal(accNumber, acc.GetAccountNumber())
+	s.Require().Equal(sdk.NewCoins(s.bondCoin(vestingAmount)), acc.GetOriginalVesting())
+	s.Require().NoError(acc.Validate())
+
+	// the existing delegation is of the account's own tokens
+	s.Require().Equal(sdk.NewCoins(s.bondCoin(400)), acc.GetDelegatedFree())
+	s.Require().True(acc.GetDelegatedVesting().IsZero())
+	s.Require().Equal(int64(vestingAmount+600-vestingAmount/2), s.spendable(addr).Int64())
+
+	// undelegating the tokens keeps the accounting consistent
+	shares, err := s.app.StakingKeeper.ValidateUnbondAmount(s.ctx, addr, s.validator().GetOperator(), sdkmath.NewInt(400))
+	s.Require().NoError(err)
+	completionTime, err := s.app.StakingKeeper.Undelegate(s.ctx, addr, s.validator().GetOperator(), shares)
+	s.Require().NoError(err)
+	s.ctx = s.ctx.WithBlockTime(completionTime)
+	s.app.StakingKeeper.BlockValidatorUpdates(s.ctx)
+
+	acc = s.app.AccountKeeper.GetAccount(s.ctx, addr).(*vestingtypes.ContinuousVestingAccount)
+	s.Require().True(acc.GetDelegatedVesting().IsZero())
+	s.Require().True(acc.GetDelegatedFree().IsZero())
+}
+
+func (s *KeeperTestSuite) TestCreateVestingAccountMergeSchedule() {
+	_, _, funder := sdktestutil.KeyTestPubAddr()
+	_, _, addr := sdktestutil.KeyTestPubAddr()
+	now := s.ctx.BlockTime().Unix()
+
+	s.Require().NoError(s.createVestingAccount(funder, addr, now-50, now+50, false, false))
+	s.delegate(addr, 600)
+
+	err := s.createVestingAccount(funder, addr, now, now+100, true, false)
+	s.Require().ErrorIs(err, sdkerrors.ErrInvalidRequest)
+
+	s.Require().NoError(s.createVestingAccount(funder, addr, now, now+100, true, true))
+
+	acc, ok := s.app.AccountKeeper.GetAccount(s.ctx, addr).(*types.ScheduledVestingAccount)
+	s.Require().True(ok)
+	s.Require().NoError(acc.Validate())
+	s.Require().Len(acc.Schedules, 2)
+	s.Require().Equal(sdk.NewCoins(s.bondCoin(2*vestingAmount)), acc.GetOriginalVesting())
+	s.Require().Equal(now-50, acc.GetStartTime())
+	s.Require().Equal(now+100, acc.GetEndTime())
+
+	// the whole delegation is vesting now
+	s.Require().Equal(sdk.NewCoins(s.bondCoin(vestingAmount/2)), acc.GetVestedCoins(s.ctx.BlockTime()))
+	s.Require().Equal(sdk.NewCoins(s.bondCoin(600)), acc.GetDelegatedVesting())
+	s.Require().True(acc.GetDelegatedFree().IsZero())
+	s.Require().Equal(int64(500), s.spendable(addr).Int64())
+
+	res, err := s.app.VestingsKeeper.UnlockSchedule(sdk.WrapSDKContext(s.ctx), &types.QueryUnlockScheduleRequest{Address: addr.String(), Period: 25})
+	s.Require().NoError(err)
+	s.Require().Equal([]types.Unlock{
+		{Time: now + 25, Amount: sdk.NewCoins(s.bondCoin(250)), Vested: sdk.NewCoins(s.bondCoin(750))},
+		{Time: now + 50, Amount: sdk.NewCoins(s.bondCoin(250)), Vested: sdk.NewCoins(s.bondCoin(1000))},
+		{Time: now + 100, Amount: sdk.NewCoins(s.bondCoin(1000)), Vested: sdk.NewCoins(s.bondCoin(2000))},
+	}, res.Unlocks)
+
+	// schedules are added to a scheduled vesting account as well
+	s.Require().NoError(s.createVestingAccount(funder, addr, now, now+200, false, true))
+	acc = s.app.AccountKeeper.GetAccount(s.ctx, addr).(*types.ScheduledVestingAccount)
+	s.Require().NoError(acc.Validate())
+	s.Require().Len(acc.Schedules, 3)
+	s.Require().Equal(now+200, acc.GetEndTime())
+}
+
+func (s *KeeperTestSuite) TestCreateVestingAccountMergeRejected() {
+	funder, clawback := s.createClawbackAccount(false, nil)
+	now := s.ctx.BlockTime().Unix()
+
+	err := s.createVestingAccount(funder, clawback, now, now+100, false, true)
+	s.Require().ErrorIs(err, sdkerrors.ErrInvalidRequest)
+
+	// vesting accounts not created by the module are not merged into
+	_, _, addr := sdktestutil.KeyTestPubAddr()
+	bva := vestingtypes.NewBaseVestingAccount(authtypes.NewBaseAccountWithAddress(addr), sdk.NewCoins(s.bondCoin(1)), now+100)
+	s.app.AccountKeeper.SetAccount(s.ctx, s.app.AccountKeeper.NewAccount(s.ctx, vestingtypes.NewDelayedVestingAccountRaw(bva)))
+
+	err = s.createVestingAccount(funder, addr, now, now+100, false, true)
+	s.Require().ErrorIs(err, sdkerrors.ErrInvalidRequest)
+
+	// a merge needs an account whose owner signs it
+	_, _, addr = sdktestutil.KeyTestPubAddr()
+	err = s.createVestingAccount(funder, addr, now, now+100, false, true)
+	s.Require().ErrorIs(err, sdkerrors.ErrInvalidRequest)
+	s.Require().Nil(s.app.AccountKeeper.GetAccount(s.ctx, addr))
+}
+
+func (s *KeeperTestSuite) TestCreateClawbackVestingAccountMerge() {
+	_, _, admin := sdktestutil.KeyTestPubAddr()
+	funder, addr := s.createClawbackAccount(false, admin)
+	now := s.ctx.BlockTime().Unix()
+	amount := sdk.NewCoins(s.bondCoin(vestingAmount))
+
+	create := func(funder sdk.AccAddress, admin string) error {
+		s.fundAcc(funder, amount)
+		msg := types.NewMsgCreateClawbackVestingAccount(funder, addr, amount, now, now+100, false, admin, true)
+		_, err := s.msgServer.CreateClawbackVestingAccount(sdk.WrapSDKContext(s.ctx), msg)
+		return err
+	}
+
+	// only the funder may add schedules, keeping the clawback admin
+	_, _, other := sdktestutil.KeyTestPubAddr()
+	s.Require().ErrorIs(create(other, admin.String()), sdkerrors.ErrUnauthorized)
+	s.Require().ErrorIs(create(funder, ""), sdkerrors.ErrUnauthorized)
+	s.Require().NoError(create(funder, admin.String()))
+
+	acc := s.clawbackAccount(addr)
+	s.Require().NoError(acc.Validate())
+	s.Require().Len(acc.Schedules, 2)
+	s.Require().Equal(sdk.NewCoins(s.bondCoin(2*vestingAmount)), acc.GetOriginalVesting())
+	s.Require().Equal(now-50, acc.GetStartTime())
+	s.Require().Equal(now+100, acc.GetEndTime())
+	s.Require().Equal(sdk.NewCoins(s.bondCoin(vestingAmount/2)), acc.GetVestedCoins(s.ctx.BlockTime()))
+
+	// the unvested coins of both schedules are clawed back
+	clawedBack, err := s.clawback(admin, addr, funder)
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewCoins(s.bondCoin(vestingAmount+vestingAmount/2)), clawedBack)
+
+	acc = s.clawbackAccount(addr)
+	s.Require().NoError(acc.Validate())
+	s.Require().Empty(acc.Schedules)
+	s.Require().Equal(int64(vestingAmount/2), s.spendable(addr).Int64())
+
+	// a schedule can be added again after the clawback
+	s.Require().NoError(create(funder, admin.String()))
+	acc = s.clawbackAccount(addr)
+	s.Require().NoError(acc.Validate())
+	s.Require().Len(acc.Schedules, 2)
+	s.Require().Equal(int64(vestingAmount/2), s.spendable(addr).Int64())
+}
+
+func (s *KeeperTestSuite) TestCreateClawbackVestingAccountMergeBaseAccount() {
+	_, _, funder := sdktestutil.KeyTestPubAddr()
+	_, _, addr := sdktestutil.KeyTestPubAddr()
+	now := s.ctx.BlockTime().Unix()
+	amount := sdk.NewCoins(s.bondCoin(vestingAmount))
+
+	s.fundAcc(addr, amount)
+	s.fundAcc(funder, amount)
+
+	msg := types.NewMsgCreateClawbackVestingAccount(funder, addr, amount, now-50, now+50, false, "", true)
+	_, err := s.msgServer.CreateClawbackVestingAccount(sdk.WrapSDKContext(s.ctx), msg)
+	s.Require().NoError(err)
+
+	acc := s.clawbackAccount(addr)
+	s.Require().NoError(acc.Validate())
+	s.Require().Equal(amount, acc.GetOriginalVesting())
+	s.Require().Equal(int64(vestingAmount+vestingAmount/2), s.spendable(addr).Int64())
+
+	// a vesting account not created with clawback is not merged into
+	_, _, other := sdktestutil.KeyTestPubAddr()
+	s.Require().NoError(s.createVestingAccount(funder, other, now-50, now+50, false, false))
+	s.fundAcc(funder, amount)
+	msg = types.NewMsgCreateClawbackVestingAccount(funder, other, amount, now-50, now+50, false, "", true)
+	_, err = s.msgServer.CreateClawbackVestingAccount(sdk.WrapSDKContext(s.ctx), msg)
+	s.Require().ErrorIs(err, sdkerrors.ErrInvalidRequest)
+}
+
+func (s *KeeperTestSuite) TestCreateClawbackVestingAccountMergeDelegated() {
+	_, _, funder := sdktestutil.KeyTestPubAddr()
+	_, _, addr := sdktestutil.KeyTestPubAddr()
+	now := s.ctx.BlockTime().Unix()
+	amount := sdk.NewCoins(s.bondCoin(vestingAmount))
+
+	// a base account which has delegated most of its own tokens
+	s.fundAcc(addr, amount)
+	s.delegate(addr, 600)
+	s.fundAcc(funder, amount)
+
+	msg := types.NewMsgCreateClawbackVestingAccount(funder, addr, amount, now-50, now+50, false, "", true)
+	_, err := s.msgServer.CreateClawbackVestingAccount(sdk.WrapSDKContext(s.ctx), msg)
+	s.Require().NoError(err)
+
+	acc := s.clawbackAccount(addr)
+	s.Require().NoError(acc.Validate())
+	s.Require().Equal(sdk.NewCoins(s.bondCoin(600)), acc.GetDelegatedFree())
+	s.Require().True(acc.GetDelegatedVesting().IsZero())
+
+	// only the unvested coins sent by the funder are clawed back, the
+	// delegation made before the merge stays with the account
+	clawedBack, err := s.clawback(funder, addr, funder)
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewCoins(s.bondCoin(vestingAmount/2)), clawedBack)
+	s.Require().Equal(int64(600), s.delegatedTokens(addr).Int64())
+	s.Require().True(s.delegatedTokens(funder).IsZero())
+
+	acc = s.clawbackAccount(addr)
+	s.Require().NoError(acc.Validate())
+	s.Require().Equal(sdk.NewCoins(s.bondCoin(600)), acc.GetDelegatedFree())
+	s.Require().Equal(int64(vestingAmount-600+vestingAmount/2), s.spendable(addr).Int64())
+}
@@ -0,0 +1,61 @@
+package keeper_test
+
+import (
+	sdktestutil "github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/Nolus-Protocol/nolus-core/x/vestings/types"
+)
+
+func (s *KeeperTestSuite) TestUpdateParams() {
+	k := s.app.VestingsKeeper
+	params := types.NewParams(100, []string{s.app.StakingKeeper.BondDenom(s.ctx)}, sdk.NewCoins(s.bondCoin(10)))
+
+	_, _, other := sdktestutil.KeyTestPubAddr()
+	_, err := s.msgServer.UpdateParams(sdk.WrapSDKContext(s.ctx), &types.MsgUpdateParams{Authority: other.String(), Params: params})
+	s.Require().ErrorIs(err, govtypes.ErrInvalidSigner)
+
+	_, err = s.msgServer.UpdateParams(sdk.WrapSDKContext(s.ctx), &types.MsgUpdateParams{
+		Authority: k.GetAuthority(),
+		Params:    types.NewParams(-1, nil, nil),
+	})
+	s.Require().Error(err)
+
+	_, err = s.msgServer.UpdateParams(sdk.WrapSDKContext(s.ctx), &types.MsgUpdateParams{Authority: k.GetAuthority(), Params: params})
+	s.Require().NoError(err)
+	s.Require().Equal(params, k.GetParams(s.ctx))
+
+	res, err := k.Params(sdk.WrapSDKContext(s.ctx), &types.QueryParamsRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(params, res.Params)
+}
+
+func (s *KeeperTestSuite) TestCreateVestingAccountParams() {
+	_, _, funder := sdktestutil.KeyTestPubAddr()
+	_, _, addr := sdktestutil.KeyTestPubAddr()
+	now := s.ctx.BlockTime().Unix()
+
+	params := types.NewParams(100, []string{s.app.StakingKeeper.BondDenom(s.ctx)}, sdk.NewCoins(s.bondCoin(10)))
+	s.Require().NoError(s.app.VestingsKeeper.SetParams(s.ctx, params))
+
+	amount := sdk.NewCoins(s.bondCoin(vestingAmount), sdk.NewInt64Coin("unls", vestingAmount))
+	s.fundAcc(funder, amount)
+
+	create := func(amount sdk.Coins, startTime, endTime int64) error {
+		msg := types.NewMsgCreateVestingAccount(funder, addr, amount, startTime, endTime, false, false)
+		_, err := s.msgServer.CreateVestingAccount(sdk.WrapSDKContext(s.ctx), msg)
+		return err
+	}
+
+	s.Require().ErrorIs(create(sdk.NewCoins(s.bondCoin(10)), now, now+101), types.ErrInvalidSchedule)
+	s.Require().ErrorIs(create(sdk.NewCoins(s.bondCoin(9)), now, now+100), types.ErrInvalidSchedule)
+	s.Require().ErrorIs(create(sdk.NewCoins(s.bondCoin(10), sdk.NewInt64Coin("unls", 10)), now, now+100), types.ErrInvalidSchedule)
+
+	// the params apply to clawback vesting accounts as well
+	msg := types.NewMsgCreateClawbackVestingAccount(funder, addr, sdk.NewCoins(s.bondCoin(10)), now, now+101, false, "", false)
+	_, err := s.msgServer.CreateClawbackVestingAccount(sdk.WrapSDKContext(s.ctx), msg)
+	s.Require().ErrorIs(err, types.ErrInvalidSchedule)
+
+	s.Require().NoError(create(sdk.NewCoins(s.bondCoin(10)), now, now+100))
+}
@@ -0,0 +1,34 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Nolus-Protocol/nolus-core/x/vestings/types"
+)
+
+// SetVestingAccount indexes an account created by the module.
+func (k Keeper) SetVestingAccount(ctx sdk.Context, addr sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetVestingAccountKey(addr), []byte{})
+}
+
+// HasVestingAccount returns whether the account has been created by the module.
+func (k Keeper) HasVestingAccount(ctx sdk.Context, addr sdk.AccAddress) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.GetVestingAccountKey(addr))
+}
+
+// IterateVestingAccounts iterates over the addresses of the accounts created by
+// the module until cb returns true.
+func (k Keeper) IterateVestingAccounts(ctx sdk.Context, cb func(addr sdk.AccAddress) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.VestingAccountKeyPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		if cb(types.VestingAccountAddressFromKey(iterator.Key())) {
+			break
+		}
+	}
+}
@@ -0,0 +1,29 @@
+package v3
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+
+	"github.com/Nolus-Protocol/nolus-core/x/vestings/types"
+)
+
+// Migrate migrates the x/vestings module state from the consensus version 2 to
+// version 3. Specifically, it indexes the vesting accounts created before the
+// module kept an index of its accounts. Up to version 2 the module created only
+// continuous and delayed vesting accounts. As the accounts do not record their
+// creator, the accounts of these types created by x/auth/vesting or at genesis
+// are indexed as well. This is safe, as a merge into an indexed account is
+// signed by its owner.
+func Migrate(ctx sdk.Context, store sdk.KVStore, ak types.AccountKeeper) error {
+	ak.IterateAccounts(ctx, func(acc authtypes.AccountI) bool {
+		switch acc.(type) {
+		case *vestingtypes.ContinuousVestingAccount, *vestingtypes.DelayedVestingAccount:
+			store.Set(types.GetVestingAccountKey(acc.GetAddress()), []byte{})
+		}
+
+		return false
+	})
+
+	return nil
+}
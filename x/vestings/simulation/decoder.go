@@ -0,0 +1,31 @@
+package simulation
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+
+	"github.com/Nolus-Protocol/nolus-core/x/vestings/types"
+)
+
+// NewDecodeStore returns a decoder function closure that unmarshals the KVPair's
+// Value to the corresponding vestings type.
+func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
+	return func(kvA, kvB kv.Pair) string {
+		switch {
+		case bytes.Equal(kvA.Key, types.ParamsKey):
+			var paramsA, paramsB types.Params
+			cdc.MustUnmarshal(kvA.Value, &paramsA)
+			cdc.MustUnmarshal(kvB.Value, &paramsB)
+			return fmt.Sprintf("%v\n%v", paramsA, paramsB)
+		case bytes.HasPrefix(kvA.Key, types.VestingAccountKeyPrefix):
+			addrA := types.VestingAccountAddressFromKey(kvA.Key[len(types.VestingAccountKeyPrefix):])
+			addrB := types.VestingAccountAddressFromKey(kvB.Key[len(types.VestingAccountKeyPrefix):])
+			return fmt.Sprintf("%v\n%v", addrA, addrB)
+		default:
+			panic(fmt.Sprintf("invalid vestings key %X", kvA.Key))
+		}
+	}
+}
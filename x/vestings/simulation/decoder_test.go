@@ -0,0 +1,51 @@
+package simulation_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+
+	"github.com/Nolus-Protocol/nolus-core/x/vestings/simulation"
+	"github.com/Nolus-Protocol/nolus-core/x/vestings/types"
+)
+
+func TestDecodeStore(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig().Codec
+	dec := simulation.NewDecodeStore(cdc)
+
+	params := types.NewParams(3600, []string{"unls"}, sdk.NewCoins(sdk.NewInt64Coin("unls", 100)))
+	addr := sdk.AccAddress("addr1_______________")
+
+	kvPairs := kv.Pairs{
+		Pairs: []kv.Pair{
+			{Key: types.ParamsKey, Value: cdc.MustMarshal(&params)},
+			{Key: types.GetVestingAccountKey(addr), Value: []byte{}},
+			{Key: []byte{0x99}, Value: []byte{0x99}},
+		},
+	}
+	tests := []struct {
+		name        string
+		expectedLog string
+	}{
+		{"Params", fmt.Sprintf("%v\n%v", params, params)},
+		{"VestingAccount", fmt.Sprintf("%v\n%v", addr, addr)},
+		{"other", ""},
+	}
+
+	for i, tt := range tests {
+		i, tt := i, tt
+		t.Run(tt.name, func(t *testing.T) {
+			switch i {
+			case len(tests) - 1:
+				require.Panics(t, func() { dec(kvPairs.Pairs[i], kvPairs.Pairs[i]) }, tt.name)
+			default:
+				require.Equal(t, tt.expectedLog, dec(kvPairs.Pairs[i], kvPairs.Pairs[i]), tt.name)
+			}
+		})
+	}
+}
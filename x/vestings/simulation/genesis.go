@@ -0,0 +1,60 @@
+package simulation
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+
+	"github.com/Nolus-Protocol/nolus-core/x/vestings/types"
+)
+
+// Simulation parameter constants.
+const (
+	MaxScheduleLength = "max_schedule_length"
+	MinAmount         = "min_amount"
+)
+
+// GenMaxScheduleLength randomized MaxScheduleLength, zero or up to ten years.
+func GenMaxScheduleLength(r *rand.Rand) int64 {
+	if r.Intn(2) == 0 {
+		return 0
+	}
+	return int64(r.Intn(10*365*24*60*60) + 1)
+}
+
+// GenMinAmount randomized MinAmount of the bond denom.
+func GenMinAmount(r *rand.Rand, bondDenom string) sdk.Coins {
+	return sdk.NewCoins(sdk.NewCoin(bondDenom, sdkmath.NewInt(int64(r.Intn(1000)))))
+}
+
+// RandomizedGenState generates a random GenesisState for vestings.
+func RandomizedGenState(simState *module.SimulationState) {
+	var (
+		maxScheduleLength int64
+		minAmount         sdk.Coins
+	)
+
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, MaxScheduleLength, &maxScheduleLength, simState.Rand,
+		func(r *rand.Rand) { maxScheduleLength = GenMaxScheduleLength(r) },
+	)
+
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, MinAmount, &minAmount, simState.Rand,
+		func(r *rand.Rand) { minAmount = GenMinAmount(r, sdk.DefaultBondDenom) },
+	)
+
+	params := types.NewParams(maxScheduleLength, []string{}, minAmount)
+	vestingsGenesis := types.NewGenesisState(params, nil)
+
+	bz, err := json.MarshalIndent(&vestingsGenesis, "", " ")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Selected randomly generated vestings parameters:\n%s\n", bz)
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(vestingsGenesis)
+}
@@ -0,0 +1,182 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/Nolus-Protocol/nolus-core/x/vestings/keeper"
+	"github.com/Nolus-Protocol/nolus-core/x/vestings/types"
+)
+
+// Simulation operation weights constants.
+const (
+	DefaultWeightMsgCreateVestingAccount int = 50
+
+	OpWeightMsgCreateVestingAccount = "op_weight_msg_create_vesting_account" //nolint:gosec
+)
+
+// year is the longest schedule, and the furthest start from the block time, the operations generate.
+const year = 365 * 24 * 60 * 60
+
+// WeightedOperations returns all the operations from the module with their respective weights.
+func WeightedOperations(
+	appParams simtypes.AppParams, cdc codec.JSONCodec, ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper,
+) simulation.WeightedOperations {
+	var weightMsgCreateVestingAccount int
+	appParams.GetOrGenerate(cdc, OpWeightMsgCreateVestingAccount, &weightMsgCreateVestingAccount, nil,
+		func(_ *rand.Rand) {
+			weightMsgCreateVestingAccount = DefaultWeightMsgCreateVestingAccount
+		},
+	)
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(
+			weightMsgCreateVestingAccount,
+			SimulateMsgCreateVestingAccount(sdk.DefaultBondDenom, ak, bk, k),
+		),
+	}
+}
+
+// SimulateMsgCreateVestingAccount generates a MsgCreateVestingAccount of a random amount of denom with a delayed
+// or continuous schedule, which starts up to a year before or after the block time. The vesting account is either created
+// at a new address, or merged into a simulation account which is a base account or a vesting account created by the module
+// and signs the merge.
+func SimulateMsgCreateVestingAccount(denom string, ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgCreateVestingAccount{})
+
+		from, _ := simtypes.RandomAcc(r, accs)
+		if ak.GetAccount(ctx, from.Address) == nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "funder does not exist"), nil, nil
+		}
+
+		params := k.GetParams(ctx)
+		if !params.IsAllowedDenom(denom) {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "denom is not allowed"), nil, nil
+		}
+
+		spendable := bk.SpendableCoins(ctx, from.Address).AmountOf(denom)
+		minAmount := params.MinAmount.AmountOf(denom)
+		if !spendable.GT(minAmount) {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "insufficient funds"), nil, nil
+		}
+
+		amount, err := simtypes.RandPositiveInt(r, spendable.Sub(minAmount))
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to generate positive amount"), nil, err
+		}
+		coins := sdk.NewCoins(sdk.NewCoin(denom, amount.Add(minAmount)))
+
+		length := int64(simtypes.RandIntBetween(r, 1, year))
+		if params.MaxScheduleLength > 0 && length > params.MaxScheduleLength {
+			length = params.MaxScheduleLength
+		}
+		startTime := ctx.BlockTime().Unix() + int64(simtypes.RandIntBetween(r, -year, year))
+		if startTime <= 0 {
+			startTime = 1
+		}
+		endTime := startTime + length
+		delayed := r.Intn(2) == 0
+
+		to, _ := simtypes.RandomAcc(r, accs)
+		merge := r.Intn(2) == 0
+		if merge {
+			switch acc := ak.GetAccount(ctx, to.Address).(type) {
+			case *authtypes.BaseAccount:
+			case vestexported.VestingAccount:
+				if _, clawback := acc.(*types.ClawbackVestingAccount); clawback || !k.HasVestingAccount(ctx, to.Address) {
+					return simtypes.NoOpMsg(types.ModuleName, msgType, "account cannot be merged"), nil, nil
+				}
+			default:
+				return simtypes.NoOpMsg(types.ModuleName, msgType, "account cannot be merged"), nil, nil
+			}
+		} else {
+			to = simtypes.RandomAccounts(r, 1)[0]
+			if ak.GetAccount(ctx, to.Address) != nil {
+				return simtypes.NoOpMsg(types.ModuleName, msgType, "account already exists"), nil, nil
+			}
+		}
+
+		if to.Address.Equals(from.Address) {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "funder and account are the same"), nil, nil
+		}
+		if bk.BlockedAddr(to.Address) {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "account is blocked"), nil, nil
+		}
+		if err := bk.IsSendEnabledCoins(ctx, coins...); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, err.Error()), nil, nil
+		}
+
+		msg := types.NewMsgCreateVestingAccount(from.Address, to.Address, coins, startTime, endTime, delayed, merge)
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           moduletestutil.MakeTestEncodingConfig().TxConfig,
+			Cdc:             nil,
+			Msg:             msg,
+			MsgType:         msgType,
+			Context:         ctx,
+			SimAccount:      from,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: coins,
+		}
+
+		if merge {
+			return deliverMergeTx(txCtx, to)
+		}
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
+// deliverMergeTx delivers the merge in txCtx signed by the funder, who pays random fees, and by the owner of
+// the account merged into.
+func deliverMergeTx(txCtx simulation.OperationInput, to simtypes.Account) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+	from := txCtx.AccountKeeper.GetAccount(txCtx.Context, txCtx.SimAccount.Address)
+	acc := txCtx.AccountKeeper.GetAccount(txCtx.Context, to.Address)
+
+	coins, hasNeg := txCtx.Bankkeeper.SpendableCoins(txCtx.Context, from.GetAddress()).SafeSub(txCtx.CoinsSpentInMsg...)
+	if hasNeg {
+		return simtypes.NoOpMsg(txCtx.ModuleName, txCtx.MsgType, "message doesn't leave room for fees"), nil, nil
+	}
+
+	fees, err := simtypes.RandomFees(txCtx.R, txCtx.Context, coins)
+	if err != nil {
+		return simtypes.NoOpMsg(txCtx.ModuleName, txCtx.MsgType, "unable to generate fees"), nil, err
+	}
+
+	tx, err := simtestutil.GenSignedMockTx(
+		txCtx.R,
+		txCtx.TxGen,
+		[]sdk.Msg{txCtx.Msg},
+		fees,
+		simtestutil.DefaultGenTxGas,
+		txCtx.Context.ChainID(),
+		[]uint64{from.GetAccountNumber(), acc.GetAccountNumber()},
+		[]uint64{from.GetSequence(), acc.GetSequence()},
+		txCtx.SimAccount.PrivKey,
+		to.PrivKey,
+	)
+	if err != nil {
+		return simtypes.NoOpMsg(txCtx.ModuleName, txCtx.MsgType, "unable to generate mock tx"), nil, err
+	}
+
+	if _, _, err := txCtx.App.SimDeliver(txCtx.TxGen.TxEncoder(), tx); err != nil {
+		return simtypes.NoOpMsg(txCtx.ModuleName, txCtx.MsgType, "unable to deliver tx"), nil, err
+	}
+
+	return simtypes.NewOperationMsg(txCtx.Msg, true, "", txCtx.Cdc), nil, nil
+}
@@ -5,15 +5,37 @@ import (
 	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
 )
 
 func RegisterCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&ClawbackVestingAccount{}, "vestings/ClawbackVestingAccount", nil)
+	cdc.RegisterConcrete(&ScheduledVestingAccount{}, "vestings/ScheduledVestingAccount", nil)
 	cdc.RegisterConcrete(&MsgCreateVestingAccount{}, "vestings/CreateVestingAccount", nil)
+	cdc.RegisterConcrete(&MsgCreateClawbackVestingAccount{}, "vestings/CreateClawbackVestingAccount", nil)
+	cdc.RegisterConcrete(&MsgClawback{}, "vestings/Clawback", nil)
+	cdc.RegisterConcrete(&MsgUpdateParams{}, "nolus-core/x/vestings/MsgUpdateParams", nil)
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgCreateVestingAccount{},
+		&MsgCreateClawbackVestingAccount{},
+		&MsgClawback{},
+		&MsgUpdateParams{},
+	)
+	registry.RegisterImplementations((*vestexported.VestingAccount)(nil),
+		&ClawbackVestingAccount{},
+		&ScheduledVestingAccount{},
+	)
+	registry.RegisterImplementations((*authtypes.AccountI)(nil),
+		&ClawbackVestingAccount{},
+		&ScheduledVestingAccount{},
+	)
+	registry.RegisterImplementations((*authtypes.GenesisAccount)(nil),
+		&ClawbackVestingAccount{},
+		&ScheduledVestingAccount{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
@@ -0,0 +1,15 @@
+package types
+
+// DONTCOVER
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// x/vestings module sentinel errors.
+var (
+	ErrNotClawbackAccount = errorsmod.Register(ModuleName, 1, "account is not a clawback vesting account")
+	ErrNotClawbackAdmin   = errorsmod.Register(ModuleName, 2, "signer is not the clawback admin")
+	ErrNothingToClawback  = errorsmod.Register(ModuleName, 3, "account has no unvested coins")
+	ErrInvalidSchedule    = errorsmod.Register(ModuleName, 4, "vesting schedule is not allowed by the params")
+)
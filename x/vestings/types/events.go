@@ -1,5 +1,11 @@
 package types
 
 const (
+	EventTypeClawback = "clawback"
+
+	AttributeKeyAccount     = "account"
+	AttributeKeyAdmin       = "admin"
+	AttributeKeyDestination = "destination"
+
 	AttributeValueCategory = ModuleName
 )
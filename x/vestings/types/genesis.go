@@ -0,0 +1,44 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewGenesisState creates a new GenesisState object.
+func NewGenesisState(params Params, vestingAccounts []string) *GenesisState {
+	return &GenesisState{
+		Params:          params,
+		VestingAccounts: vestingAccounts,
+	}
+}
+
+// DefaultGenesis returns the default vestings genesis state.
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{
+		Params: DefaultParams(),
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	seen := make(map[string]bool, len(gs.VestingAccounts))
+	for _, addr := range gs.VestingAccounts {
+		if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+			return fmt.Errorf("invalid vesting account address %s: %w", addr, err)
+		}
+
+		if seen[addr] {
+			return fmt.Errorf("duplicate vesting account %s", addr)
+		}
+		seen[addr] = true
+	}
+
+	return nil
+}
@@ -0,0 +1,82 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Nolus-Protocol/nolus-core/app/params"
+	"github.com/Nolus-Protocol/nolus-core/x/vestings/types"
+)
+
+func TestGenesisState_Validate(t *testing.T) {
+	params.SetAddressPrefixes()
+	addr := sdk.AccAddress("addr1_______________").String()
+
+	for _, tc := range []struct {
+		desc     string
+		genState *types.GenesisState
+		valid    bool
+	}{
+		{
+			desc:     "default is valid",
+			genState: types.DefaultGenesis(),
+			valid:    true,
+		},
+		{
+			desc: "valid genesis state",
+			genState: types.NewGenesisState(
+				types.NewParams(3600, []string{"unls"}, sdk.NewCoins(sdk.NewInt64Coin("unls", 100))),
+				[]string{addr},
+			),
+			valid: true,
+		},
+		{
+			desc:     "negative max schedule length",
+			genState: types.NewGenesisState(types.NewParams(-1, nil, nil), nil),
+			valid:    false,
+		},
+		{
+			desc:     "duplicate allowed denom",
+			genState: types.NewGenesisState(types.NewParams(0, []string{"unls", "unls"}, nil), nil),
+			valid:    false,
+		},
+		{
+			desc:     "invalid min amount",
+			genState: types.NewGenesisState(types.NewParams(0, nil, sdk.Coins{sdk.Coin{Denom: "unls", Amount: sdk.NewInt(-1)}}), nil),
+			valid:    false,
+		},
+		{
+			desc:     "invalid vesting account",
+			genState: types.NewGenesisState(types.DefaultParams(), []string{"invalid_address"}),
+			valid:    false,
+		},
+		{
+			desc:     "duplicate vesting account",
+			genState: types.NewGenesisState(types.DefaultParams(), []string{addr, addr}),
+			valid:    false,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := tc.genState.Validate()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestParams_ValidateSchedule(t *testing.T) {
+	p := types.NewParams(100, []string{"unls"}, sdk.NewCoins(sdk.NewInt64Coin("unls", 10)))
+
+	require.NoError(t, p.ValidateSchedule(sdk.NewCoins(sdk.NewInt64Coin("unls", 10)), 0, 100))
+	require.ErrorIs(t, p.ValidateSchedule(sdk.NewCoins(sdk.NewInt64Coin("unls", 10)), 0, 101), types.ErrInvalidSchedule)
+	require.ErrorIs(t, p.ValidateSchedule(sdk.NewCoins(sdk.NewInt64Coin("unls", 9)), 0, 100), types.ErrInvalidSchedule)
+	require.ErrorIs(t, p.ValidateSchedule(sdk.NewCoins(sdk.NewInt64Coin("uosmo", 10)), 0, 100), types.ErrInvalidSchedule)
+
+	// the default params do not restrict the schedules
+	require.NoError(t, types.DefaultParams().ValidateSchedule(sdk.NewCoins(sdk.NewInt64Coin("uosmo", 1)), 0, 1<<40))
+}
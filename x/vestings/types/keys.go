@@ -1,5 +1,18 @@
 package types
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+var (
+	// ParamsKey is the key of the module params.
+	ParamsKey = []byte{0x00}
+
+	// VestingAccountKeyPrefix indexes the accounts created by the module.
+	VestingAccountKeyPrefix = []byte{0x01}
+)
+
 const (
 	// ModuleName defines the module name.
 	ModuleName = "vestings"
@@ -20,3 +33,14 @@ const (
 func KeyPrefix(p string) []byte {
 	return []byte(p)
 }
+
+// GetVestingAccountKey returns the index key of a vesting account.
+func GetVestingAccountKey(addr sdk.AccAddress) []byte {
+	return append(VestingAccountKeyPrefix, address.MustLengthPrefix(addr)...)
+}
+
+// VestingAccountAddressFromKey returns the address of a vesting account index
+// key stripped of the VestingAccountKeyPrefix.
+func VestingAccountAddressFromKey(key []byte) sdk.AccAddress {
+	return sdk.AccAddress(key[1:])
+}
@@ -0,0 +1,69 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// TypeMsgClawback defines the type value for a MsgClawback.
+const TypeMsgClawback = "msg_clawback"
+
+var _ sdk.Msg = &MsgClawback{}
+
+// NewMsgClawback returns a reference to a new MsgClawback. An empty destination
+// returns the unvested coins to the funder of the account.
+func NewMsgClawback(admin, account, dest sdk.AccAddress) *MsgClawback {
+	var destAddress string
+	if dest != nil {
+		destAddress = dest.String()
+	}
+
+	return &MsgClawback{
+		AdminAddress:   admin.String(),
+		AccountAddress: account.String(),
+		DestAddress:    destAddress,
+	}
+}
+
+// Route returns the message route for a MsgClawback.
+func (msg MsgClawback) Route() string { return RouterKey }
+
+// Type returns the message type for a MsgClawback.
+func (msg MsgClawback) Type() string { return TypeMsgClawback }
+
+// ValidateBasic Implements Msg.
+func (msg MsgClawback) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.AdminAddress); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid 'admin' address: %s", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.AccountAddress); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid 'account' address: %s", err)
+	}
+
+	if msg.DestAddress != "" {
+		if _, err := sdk.AccAddressFromBech32(msg.DestAddress); err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid 'dest' address: %s", err)
+		}
+		if msg.DestAddress == msg.AccountAddress {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "destination cannot be the vesting account")
+		}
+	}
+
+	return nil
+}
+
+// GetSignBytes returns the bytes all expected signers must sign over for a
+// MsgClawback.
+func (msg MsgClawback) GetSignBytes() []byte {
+	return sdk.MustSortJSON(amino.MustMarshalJSON(&msg))
+}
+
+// GetSigners returns the expected signers for a MsgClawback.
+func (msg MsgClawback) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.AdminAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
@@ -0,0 +1,74 @@
+package types
+
+import (
+	"errors"
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgClawback_ValidateBasic(t *testing.T) {
+	account := AccAddress().String()
+
+	tests := []struct {
+		name string
+		msg  MsgClawback
+		err  error
+	}{
+		{
+			name: "invalid admin address",
+			msg: MsgClawback{
+				AdminAddress:   "invalid_address",
+				AccountAddress: account,
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid account address",
+			msg: MsgClawback{
+				AdminAddress:   AccAddress().String(),
+				AccountAddress: "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid dest address",
+			msg: MsgClawback{
+				AdminAddress:   AccAddress().String(),
+				AccountAddress: account,
+				DestAddress:    "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "dest is the vesting account",
+			msg: MsgClawback{
+				AdminAddress:   AccAddress().String(),
+				AccountAddress: account,
+				DestAddress:    account,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "valid without dest",
+			msg: MsgClawback{
+				AdminAddress:   AccAddress().String(),
+				AccountAddress: account,
+			},
+		}, {
+			name: "valid with dest",
+			msg: MsgClawback{
+				AdminAddress:   AccAddress().String(),
+				AccountAddress: account,
+				DestAddress:    AccAddress().String(),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.EqualError(t, errors.Unwrap(err), tt.err.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -12,7 +12,7 @@ const TypeMsgCreateVestingAccount = "msg_create_vesting_account"
 var _ sdk.Msg = &MsgCreateVestingAccount{}
 
 // NewMsgCreateVestingAccount returns a reference to a NewMsgCreateVestingAccount.
-func NewMsgCreateVestingAccount(fromAddr, toAddr sdk.AccAddress, amount sdk.Coins, startTime, endTime int64, delayed bool) *MsgCreateVestingAccount {
+func NewMsgCreateVestingAccount(fromAddr, toAddr sdk.AccAddress, amount sdk.Coins, startTime, endTime int64, delayed, merge bool) *MsgCreateVestingAccount {
 	return &MsgCreateVestingAccount{
 		FromAddress: fromAddr.String(),
 		ToAddress:   toAddr.String(),
@@ -20,6 +20,7 @@ func NewMsgCreateVestingAccount(fromAddr, toAddr sdk.AccAddress, amount sdk.Coin
 		StartTime:   startTime,
 		EndTime:     endTime,
 		Delayed:     delayed,
+		Merge:       merge,
 	}
 }
 
@@ -31,30 +32,36 @@ func (msg MsgCreateVestingAccount) Type() string { return TypeMsgCreateVestingAc
 
 // ValidateBasic Implements Msg.
 func (msg MsgCreateVestingAccount) ValidateBasic() error {
-	if _, err := sdk.AccAddressFromBech32(msg.FromAddress); err != nil {
+	return validateVestingAccount(msg.FromAddress, msg.ToAddress, msg.Amount, msg.StartTime, msg.EndTime)
+}
+
+// validateVestingAccount performs the stateless checks shared by the messages
+// creating vesting accounts.
+func validateVestingAccount(fromAddress, toAddress string, amount sdk.Coins, startTime, endTime int64) error {
+	if _, err := sdk.AccAddressFromBech32(fromAddress); err != nil {
 		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid 'from' address: %s", err)
 	}
-	if _, err := sdk.AccAddressFromBech32(msg.ToAddress); err != nil {
+	if _, err := sdk.AccAddressFromBech32(toAddress); err != nil {
 		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid 'to' address: %s", err)
 	}
 
-	if !msg.Amount.IsValid() {
-		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, msg.Amount.String())
+	if !amount.IsValid() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, amount.String())
 	}
 
-	if !msg.Amount.IsAllPositive() {
-		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, msg.Amount.String())
+	if !amount.IsAllPositive() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, amount.String())
 	}
 
-	if msg.StartTime <= 0 {
+	if startTime <= 0 {
 		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "invalid start time")
 	}
 
-	if msg.EndTime <= 0 {
+	if endTime <= 0 {
 		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "invalid end time")
 	}
 
-	if msg.StartTime >= msg.EndTime {
+	if startTime >= endTime {
 		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "invalid start time")
 	}
 
@@ -68,10 +75,20 @@ func (msg MsgCreateVestingAccount) GetSignBytes() []byte {
 }
 
 // GetSigners returns the expected signers for a MsgCreateVestingAccount.
+// A merge may convert the existing account at the destination, so its owner
+// must sign as well.
 func (msg MsgCreateVestingAccount) GetSigners() []sdk.AccAddress {
-	addr, err := sdk.AccAddressFromBech32(msg.FromAddress)
+	from, err := sdk.AccAddressFromBech32(msg.FromAddress)
+	if err != nil {
+		panic(err)
+	}
+	if !msg.Merge {
+		return []sdk.AccAddress{from}
+	}
+
+	to, err := sdk.AccAddressFromBech32(msg.ToAddress)
 	if err != nil {
 		panic(err)
 	}
-	return []sdk.AccAddress{addr}
+	return []sdk.AccAddress{from, to}
 }
@@ -115,3 +115,19 @@ func TestMsgCreateVestingAccount_ValidateBasic(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgCreateVestingAccount_GetSigners(t *testing.T) {
+	from, to := AccAddress(), AccAddress()
+	amount := sdk.NewCoins(sdk.NewInt64Coin("unls", 10))
+	now := time.Now().Unix()
+
+	msg := NewMsgCreateVestingAccount(from, to, amount, now, now+1, false, false)
+	require.Equal(t, []sdk.AccAddress{from}, msg.GetSigners())
+
+	// the owner of the account merged into signs too
+	msg = NewMsgCreateVestingAccount(from, to, amount, now, now+1, false, true)
+	require.Equal(t, []sdk.AccAddress{from, to}, msg.GetSigners())
+
+	clawbackMsg := NewMsgCreateClawbackVestingAccount(from, to, amount, now, now+1, false, "", true)
+	require.Equal(t, []sdk.AccAddress{from, to}, clawbackMsg.GetSigners())
+}
@@ -0,0 +1,133 @@
+package types
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"gopkg.in/yaml.v2"
+)
+
+// NewParams creates a new Params instance.
+func NewParams(maxScheduleLength int64, allowedDenoms []string, minAmount sdk.Coins) Params {
+	return Params{
+		MaxScheduleLength: maxScheduleLength,
+		AllowedDenoms:     allowedDenoms,
+		MinAmount:         minAmount,
+	}
+}
+
+// DefaultParams returns default x/vestings module parameters, which do not
+// restrict the vesting schedules.
+func DefaultParams() Params {
+	return Params{
+		MaxScheduleLength: 0,
+		AllowedDenoms:     []string{},
+		MinAmount:         sdk.NewCoins(),
+	}
+}
+
+// Validate validates the set of params.
+func (p Params) Validate() error {
+	if err := validateMaxScheduleLength(p.MaxScheduleLength); err != nil {
+		return err
+	}
+
+	if err := validateAllowedDenoms(p.AllowedDenoms); err != nil {
+		return err
+	}
+
+	if err := validateMinAmount(p.MinAmount); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ValidateSchedule checks that a vesting schedule of amount between startTime
+// and endTime is allowed by the params.
+func (p Params) ValidateSchedule(amount sdk.Coins, startTime, endTime int64) error {
+	if p.MaxScheduleLength > 0 && endTime-startTime > p.MaxScheduleLength {
+		return errorsmod.Wrapf(ErrInvalidSchedule, "schedule length %ds exceeds the maximum of %ds", endTime-startTime, p.MaxScheduleLength)
+	}
+
+	for _, coin := range amount {
+		if !p.IsAllowedDenom(coin.Denom) {
+			return errorsmod.Wrapf(ErrInvalidSchedule, "denom %s is not allowed", coin.Denom)
+		}
+
+		if minAmount := p.MinAmount.AmountOf(coin.Denom); coin.Amount.LT(minAmount) {
+			return errorsmod.Wrapf(ErrInvalidSchedule, "amount %s is less than the minimum of %s%s", coin, minAmount, coin.Denom)
+		}
+	}
+
+	return nil
+}
+
+// IsAllowedDenom returns true if the denom may be vested.
+func (p Params) IsAllowedDenom(denom string) bool {
+	if len(p.AllowedDenoms) == 0 {
+		return true
+	}
+
+	for _, allowed := range p.AllowedDenoms {
+		if allowed == denom {
+			return true
+		}
+	}
+
+	return false
+}
+
+// String implements the Stringer interface.
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+func validateMaxScheduleLength(v interface{}) error {
+	maxScheduleLength, ok := v.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if maxScheduleLength < 0 {
+		return fmt.Errorf("max schedule length cannot be negative: %d", maxScheduleLength)
+	}
+
+	return nil
+}
+
+func validateAllowedDenoms(v interface{}) error {
+	allowedDenoms, ok := v.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	seen := make(map[string]bool, len(allowedDenoms))
+	for _, denom := range allowedDenoms {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return err
+		}
+
+		if seen[denom] {
+			return fmt.Errorf("duplicate allowed denom %s", denom)
+		}
+		seen[denom] = true
+	}
+
+	return nil
+}
+
+func validateMinAmount(v interface{}) error {
+	minAmount, ok := v.(sdk.Coins)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if !minAmount.IsValid() {
+		return fmt.Errorf("invalid min amount: %s", minAmount)
+	}
+
+	return nil
+}
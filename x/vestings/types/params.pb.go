@@ -0,0 +1,439 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: nolus/vestings/v1beta1/params.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines the parameters for the module.
+type Params struct {
+	// max_schedule_length is the maximum duration, in seconds, between the start
+	// and the end of a vesting schedule. Zero means no limit.
+	MaxScheduleLength int64 `protobuf:"varint,1,opt,name=max_schedule_length,json=maxScheduleLength,proto3" json:"max_schedule_length,omitempty" yaml:"max_schedule_length"`
+	// allowed_denoms are the denoms which may be vested. Any denom may be vested
+	// when it is empty.
+	AllowedDenoms []string `protobuf:"bytes,2,rep,name=allowed_denoms,json=allowedDenoms,proto3" json:"allowed_denoms,omitempty" yaml:"allowed_denoms"`
+	// min_amount is the minimum amount of each listed denom a vesting schedule
+	// must vest.
+	MinAmount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=min_amount,json=minAmount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"min_amount" yaml:"min_amount"`
+}
+
+func (m *Params) Reset()      { *m = Params{} }
+func (*Params) ProtoMessage() {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8547dcf00472a83c, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetMaxScheduleLength() int64 {
+	if m != nil {
+		return m.MaxScheduleLength
+	}
+	return 0
+}
+
+func (m *Params) GetAllowedDenoms() []string {
+	if m != nil {
+		return m.AllowedDenoms
+	}
+	return nil
+}
+
+func (m *Params) GetMinAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.MinAmount
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "nolus.vestings.v1beta1.Params")
+}
+
+func init() {
+	proto.RegisterFile("nolus/vestings/v1beta1/params.proto", fileDescriptor_8547dcf00472a83c)
+}
+
+var fileDescriptor_8547dcf00472a83c = []byte{
+	// 367 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0xbf, 0x6e, 0xe2, 0x40,
+	0x10, 0xc6, 0x6d, 0x7c, 0x42, 0xc2, 0xa7, 0x3b, 0x09, 0xdf, 0x1f, 0x01, 0xc5, 0x1a, 0xf9, 0x1a,
+	0x37, 0x78, 0xc5, 0x9d, 0xae, 0xa1, 0xba, 0x73, 0x92, 0x2e, 0x22, 0x88, 0x74, 0x69, 0xac, 0xb5,
+	0xbd, 0x32, 0x56, 0xbc, 0x3b, 0x88, 0xb5, 0x09, 0x54, 0x79, 0x85, 0x94, 0x29, 0x93, 0x36, 0x4f,
+	0x42, 0x49, 0x99, 0xca, 0x89, 0xe0, 0x0d, 0x78, 0x82, 0xc8, 0x6b, 0x43, 0x12, 0x29, 0x95, 0xbd,
+	0x33, 0xbf, 0xf9, 0x76, 0xe7, 0xfb, 0xf4, 0x5f, 0x1c, 0x92, 0x4c, 0xe0, 0x39, 0x15, 0x69, 0xcc,
+	0x23, 0x81, 0xe7, 0x7d, 0x9f, 0xa6, 0xa4, 0x8f, 0xa7, 0x64, 0x46, 0x98, 0x70, 0xa6, 0x33, 0x48,
+	0xc1, 0xf8, 0x29, 0x21, 0x67, 0x0f, 0x39, 0x15, 0xd4, 0xf9, 0x1e, 0x41, 0x04, 0x12, 0xc1, 0xc5,
+	0x5f, 0x49, 0x77, 0x50, 0x00, 0x82, 0x81, 0xc0, 0x3e, 0x11, 0xf4, 0xa0, 0x17, 0x40, 0xcc, 0xcb,
+	0xbe, 0x75, 0x5f, 0xd3, 0xeb, 0x23, 0x29, 0x6f, 0x0c, 0xf5, 0x6f, 0x8c, 0x2c, 0x3c, 0x11, 0x4c,
+	0x68, 0x98, 0x25, 0xd4, 0x4b, 0x28, 0x8f, 0xd2, 0x49, 0x4b, 0xed, 0xaa, 0xb6, 0xe6, 0xa2, 0x5d,
+	0x6e, 0x76, 0x96, 0x84, 0x25, 0x03, 0xeb, 0x03, 0xc8, 0x1a, 0x37, 0x19, 0x59, 0x9c, 0x57, 0xc5,
+	0x53, 0x59, 0x33, 0xfe, 0xe9, 0x5f, 0x49, 0x92, 0xc0, 0x15, 0x0d, 0xbd, 0x90, 0x72, 0x60, 0xa2,
+	0x55, 0xeb, 0x6a, 0x76, 0xc3, 0x6d, 0xef, 0x72, 0xf3, 0x47, 0x29, 0xf5, 0xbe, 0x6f, 0x8d, 0xbf,
+	0x54, 0x85, 0x63, 0x79, 0x36, 0xae, 0x75, 0x9d, 0xc5, 0xdc, 0x23, 0x0c, 0x32, 0x9e, 0xb6, 0xb4,
+	0xae, 0x66, 0x7f, 0xfe, 0xdd, 0x76, 0xca, 0x8d, 0x9c, 0x62, 0xa3, 0xfd, 0xf2, 0xce, 0x11, 0xc4,
+	0xdc, 0x3d, 0x59, 0xe5, 0xa6, 0xb2, 0xcb, 0xcd, 0x66, 0xf5, 0xce, 0xc3, 0xa8, 0xf5, 0xf0, 0x64,
+	0xda, 0x51, 0x9c, 0x4e, 0x32, 0xdf, 0x09, 0x80, 0xe1, 0xca, 0x93, 0xf2, 0xd3, 0x13, 0xe1, 0x25,
+	0x4e, 0x97, 0x53, 0x2a, 0xa4, 0x8a, 0x18, 0x37, 0x58, 0xcc, 0xff, 0xcb, 0xb9, 0xc1, 0xa7, 0xdb,
+	0x3b, 0x53, 0x71, 0xcf, 0x56, 0x1b, 0xa4, 0xae, 0x37, 0x48, 0x7d, 0xde, 0x20, 0xf5, 0x66, 0x8b,
+	0x94, 0xf5, 0x16, 0x29, 0x8f, 0x5b, 0xa4, 0x5c, 0xfc, 0x7d, 0x23, 0x3a, 0x2c, 0x62, 0xe9, 0x8d,
+	0x0a, 0x57, 0x03, 0x48, 0xb0, 0x4c, 0xa9, 0x17, 0xc0, 0x8c, 0xe2, 0xc5, 0x6b, 0xa2, 0xf2, 0x1e,
+	0xbf, 0x2e, 0xbd, 0xff, 0xf3, 0x32, 0x00, 0xb0, 0x30, 0x15, 0x55, 0xf0, 0x01, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MinAmount) > 0 {
+		for iNdEx := len(m.MinAmount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinAmount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.AllowedDenoms) > 0 {
+		for iNdEx := len(m.AllowedDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedDenoms[iNdEx])
+			copy(dAtA[i:], m.AllowedDenoms[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.AllowedDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.MaxScheduleLength != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxScheduleLength))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxScheduleLength != 0 {
+		n += 1 + sovParams(uint64(m.MaxScheduleLength))
+	}
+	if len(m.AllowedDenoms) > 0 {
+		for _, s := range m.AllowedDenoms {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if len(m.MinAmount) > 0 {
+		for _, e := range m.MinAmount {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxScheduleLength", wireType)
+			}
+			m.MaxScheduleLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxScheduleLength |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedDenoms = append(m.AllowedDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinAmount = append(m.MinAmount, types.Coin{})
+			if err := m.MinAmount[len(m.MinAmount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipParams(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthParams
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupParams
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthParams
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthParams        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowParams          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupParams = fmt.Errorf("proto: unexpected end of group")
+)
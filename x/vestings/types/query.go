@@ -0,0 +1,19 @@
+package types
+
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	vestexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
+)
+
+var _ codectypes.UnpackInterfacesMessage = &QueryVestingAccountsResponse{}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (m *QueryVestingAccountsResponse) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, any := range m.Accounts {
+		var account vestexported.VestingAccount
+		if err := unpacker.UnpackAny(any, &account); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,135 @@
+package types
+
+import (
+	"errors"
+	"time"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+// Schedules is a list of vesting schedules which vest in parallel.
+type Schedules []Schedule
+
+// NewSchedule returns a new Schedule.
+func NewSchedule(startTime, endTime int64, delayed bool, amount sdk.Coins) Schedule {
+	return Schedule{
+		StartTime: startTime,
+		EndTime:   endTime,
+		Delayed:   delayed,
+		Amount:    amount,
+	}
+}
+
+// GetVestedCoins returns the coins of the schedule which are vested at blockTime.
+// If no coins are vested, nil is returned.
+func (s Schedule) GetVestedCoins(blockTime time.Time) sdk.Coins {
+	if blockTime.Unix() >= s.EndTime {
+		return s.Amount
+	}
+
+	if s.Delayed || blockTime.Unix() <= s.StartTime {
+		return nil
+	}
+
+	var vestedCoins sdk.Coins
+
+	// calculate the vesting scalar
+	x := blockTime.Unix() - s.StartTime
+	y := s.EndTime - s.StartTime
+	scalar := math.LegacyNewDec(x).Quo(math.LegacyNewDec(y))
+
+	for _, c := range s.Amount {
+		vestedAmt := math.LegacyNewDecFromInt(c.Amount).Mul(scalar).RoundInt()
+		vestedCoins = append(vestedCoins, sdk.NewCoin(c.Denom, vestedAmt))
+	}
+
+	return vestedCoins
+}
+
+// Validate checks for errors on the schedule fields.
+func (s Schedule) Validate() error {
+	if s.StartTime > s.EndTime {
+		return errors.New("schedule start-time cannot be after end-time")
+	}
+
+	if !s.Amount.IsValid() || !s.Amount.IsAllPositive() {
+		return errors.New("invalid schedule amount: " + s.Amount.String())
+	}
+
+	return nil
+}
+
+// GetVestedCoins returns the sum of the coins vested by the schedules at blockTime.
+func (ss Schedules) GetVestedCoins(blockTime time.Time) sdk.Coins {
+	vested := sdk.NewCoins()
+	for _, s := range ss {
+		vested = vested.Add(s.GetVestedCoins(blockTime)...)
+	}
+
+	return vested
+}
+
+// GetAmount returns the sum of the coins vested by the schedules.
+func (ss Schedules) GetAmount() sdk.Coins {
+	amount := sdk.NewCoins()
+	for _, s := range ss {
+		amount = amount.Add(s.Amount...)
+	}
+
+	return amount
+}
+
+// GetStartTime returns the earliest start time of the schedules.
+func (ss Schedules) GetStartTime() int64 {
+	var startTime int64
+	for i, s := range ss {
+		if i == 0 || s.StartTime < startTime {
+			startTime = s.StartTime
+		}
+	}
+
+	return startTime
+}
+
+// GetEndTime returns the latest end time of the schedules.
+func (ss Schedules) GetEndTime() int64 {
+	var endTime int64
+	for i, s := range ss {
+		if i == 0 || s.EndTime > endTime {
+			endTime = s.EndTime
+		}
+	}
+
+	return endTime
+}
+
+// Validate checks for errors on the schedules and that they vest the
+// original vesting coins of the account by its end time.
+func (ss Schedules) Validate(originalVesting sdk.Coins, endTime int64) error {
+	for _, s := range ss {
+		if err := s.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if amount := ss.GetAmount(); !amount.IsAllGTE(originalVesting) || !originalVesting.IsAllGTE(amount) {
+		return errors.New("schedules do not sum up to the original vesting coins")
+	}
+
+	if ss.GetEndTime() != endTime {
+		return errors.New("schedules do not end at the vesting end-time")
+	}
+
+	return nil
+}
+
+// TrackExistingDelegations splits the coins delegated by an account, which are
+// no longer in its balance, into delegated vesting, up to the vesting coins,
+// and delegated free.
+func TrackExistingDelegations(bva *vestingtypes.BaseVestingAccount, delegated, vesting sdk.Coins) {
+	bva.DelegatedVesting = delegated.Min(vesting)
+	bva.DelegatedFree = delegated.Sub(bva.DelegatedVesting...)
+}
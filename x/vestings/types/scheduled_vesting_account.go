@@ -0,0 +1,144 @@
+package types
+
+import (
+	"errors"
+	"time"
+
+	"gopkg.in/yaml.v2"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+var (
+	_ vestexported.VestingAccount = (*ScheduledVestingAccount)(nil)
+	_ authtypes.GenesisAccount    = (*ScheduledVestingAccount)(nil)
+)
+
+// NewScheduledVestingAccount returns a new ScheduledVestingAccount vesting the
+// original vesting coins of bva by the given schedules.
+func NewScheduledVestingAccount(bva *vestingtypes.BaseVestingAccount, schedules Schedules) *ScheduledVestingAccount {
+	bva.EndTime = schedules.GetEndTime()
+
+	return &ScheduledVestingAccount{
+		BaseVestingAccount: bva,
+		Schedules:          schedules,
+	}
+}
+
+// NewScheduledVestingAccountFrom converts a continuous, delayed or scheduled
+// vesting account into a ScheduledVestingAccount with the same schedules.
+func NewScheduledVestingAccountFrom(va vestexported.VestingAccount) (*ScheduledVestingAccount, error) {
+	switch acc := va.(type) {
+	case *ScheduledVestingAccount:
+		return acc, nil
+	case *vestingtypes.ContinuousVestingAccount:
+		return NewScheduledVestingAccount(acc.BaseVestingAccount, Schedules{
+			NewSchedule(acc.StartTime, acc.EndTime, false, acc.OriginalVesting),
+		}), nil
+	case *vestingtypes.DelayedVestingAccount:
+		return NewScheduledVestingAccount(acc.BaseVestingAccount, Schedules{
+			NewSchedule(acc.EndTime, acc.EndTime, true, acc.OriginalVesting),
+		}), nil
+	default:
+		return nil, errors.New("schedules cannot be added to " + va.GetAddress().String())
+	}
+}
+
+// GetVestedCoins returns the total number of vested coins. If no coins are vested,
+// nil is returned.
+func (sva ScheduledVestingAccount) GetVestedCoins(blockTime time.Time) sdk.Coins {
+	vested := Schedules(sva.Schedules).GetVestedCoins(blockTime)
+	if vested.IsZero() {
+		return nil
+	}
+
+	return vested
+}
+
+// GetVestingCoins returns the total number of vesting coins. If no coins are
+// vesting, nil is returned.
+func (sva ScheduledVestingAccount) GetVestingCoins(blockTime time.Time) sdk.Coins {
+	return sva.OriginalVesting.Sub(sva.GetVestedCoins(blockTime)...)
+}
+
+// LockedCoins returns the set of coins that are not spendable (i.e. locked),
+// defined as the vesting coins that are not delegated.
+func (sva ScheduledVestingAccount) LockedCoins(blockTime time.Time) sdk.Coins {
+	return sva.BaseVestingAccount.LockedCoinsFromVesting(sva.GetVestingCoins(blockTime))
+}
+
+// TrackDelegation tracks a desired delegation amount by setting the appropriate
+// values for the amount of delegated vesting, delegated free, and reducing the
+// overall amount of base coins.
+func (sva *ScheduledVestingAccount) TrackDelegation(blockTime time.Time, balance, amount sdk.Coins) {
+	sva.BaseVestingAccount.TrackDelegation(balance, sva.GetVestingCoins(blockTime), amount)
+}
+
+// GetStartTime returns the time when vesting starts for a scheduled vesting
+// account.
+func (sva ScheduledVestingAccount) GetStartTime() int64 {
+	return Schedules(sva.Schedules).GetStartTime()
+}
+
+// AddSchedule adds the coins of schedule, which must have been sent to the
+// account, to its original vesting coins. The coins already delegated are
+// tracked again as delegated vesting up to the coins vesting at blockTime.
+func (sva *ScheduledVestingAccount) AddSchedule(blockTime time.Time, schedule Schedule) {
+	sva.Schedules = append(sva.Schedules, schedule)
+	sva.OriginalVesting = sva.OriginalVesting.Add(schedule.Amount...)
+	sva.EndTime = Schedules(sva.Schedules).GetEndTime()
+
+	delegated := sva.DelegatedFree.Add(sva.DelegatedVesting...)
+	TrackExistingDelegations(sva.BaseVestingAccount, delegated, sva.GetVestingCoins(blockTime))
+}
+
+// Validate checks for errors on the account fields
+func (sva ScheduledVestingAccount) Validate() error {
+	if len(sva.Schedules) == 0 {
+		return errors.New("scheduled vesting account has no schedules")
+	}
+
+	if err := Schedules(sva.Schedules).Validate(sva.OriginalVesting, sva.EndTime); err != nil {
+		return err
+	}
+
+	return sva.BaseVestingAccount.Validate()
+}
+
+type scheduledVestingAccountYAML struct {
+	Address          string    `yaml:"address"`
+	AccountNumber    uint64    `yaml:"account_number"`
+	Sequence         uint64    `yaml:"sequence"`
+	OriginalVesting  sdk.Coins `yaml:"original_vesting"`
+	DelegatedFree    sdk.Coins `yaml:"delegated_free"`
+	DelegatedVesting sdk.Coins `yaml:"delegated_vesting"`
+	EndTime          int64     `yaml:"end_time"`
+	Schedules        Schedules `yaml:"schedules"`
+}
+
+func (sva ScheduledVestingAccount) String() string {
+	out, _ := sva.MarshalYAML()
+	return out.(string)
+}
+
+// MarshalYAML returns the YAML representation of a ScheduledVestingAccount.
+func (sva ScheduledVestingAccount) MarshalYAML() (interface{}, error) {
+	out, err := yaml.Marshal(scheduledVestingAccountYAML{
+		Address:          sva.Address,
+		AccountNumber:    sva.AccountNumber,
+		Sequence:         sva.Sequence,
+		OriginalVesting:  sva.OriginalVesting,
+		DelegatedFree:    sva.DelegatedFree,
+		DelegatedVesting: sva.DelegatedVesting,
+		EndTime:          sva.EndTime,
+		Schedules:        sva.Schedules,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return string(out), nil
+}
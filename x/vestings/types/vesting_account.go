@@ -0,0 +1,205 @@
+package types
+
+import (
+	"errors"
+	"time"
+
+	"cosmossdk.io/math"
+	"gopkg.in/yaml.v2"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+var (
+	_ vestexported.VestingAccount = (*ClawbackVestingAccount)(nil)
+	_ authtypes.GenesisAccount    = (*ClawbackVestingAccount)(nil)
+)
+
+// NewClawbackVestingAccount returns a new ClawbackVestingAccount.
+func NewClawbackVestingAccount(bva *vestingtypes.BaseVestingAccount, startTime int64, delayed bool, funder, admin string) *ClawbackVestingAccount {
+	return &ClawbackVestingAccount{
+		BaseVestingAccount: bva,
+		StartTime:          startTime,
+		Delayed:            delayed,
+		FunderAddress:      funder,
+		ClawbackAdmin:      admin,
+	}
+}
+
+// GetVestedCoins returns the total number of vested coins. If no coins are vested,
+// nil is returned.
+func (cva ClawbackVestingAccount) GetVestedCoins(blockTime time.Time) sdk.Coins {
+	// all coins are vested once the vesting period has passed
+	if blockTime.Unix() >= cva.EndTime {
+		return cva.OriginalVesting
+	}
+
+	if len(cva.Schedules) > 0 {
+		return Schedules(cva.Schedules).GetVestedCoins(blockTime)
+	}
+
+	// delayed accounts vest nothing until the end time, continuous ones vest
+	// nothing before the start time
+	if cva.Delayed || blockTime.Unix() <= cva.StartTime {
+		return nil
+	}
+
+	var vestedCoins sdk.Coins
+
+	// calculate the vesting scalar
+	x := blockTime.Unix() - cva.StartTime
+	y := cva.EndTime - cva.StartTime
+	s := math.LegacyNewDec(x).Quo(math.LegacyNewDec(y))
+
+	for _, ovc := range cva.OriginalVesting {
+		vestedAmt := math.LegacyNewDecFromInt(ovc.Amount).Mul(s).RoundInt()
+		vestedCoins = append(vestedCoins, sdk.NewCoin(ovc.Denom, vestedAmt))
+	}
+
+	return vestedCoins
+}
+
+// GetVestingCoins returns the total number of vesting coins. If no coins are
+// vesting, nil is returned.
+func (cva ClawbackVestingAccount) GetVestingCoins(blockTime time.Time) sdk.Coins {
+	return cva.OriginalVesting.Sub(cva.GetVestedCoins(blockTime)...)
+}
+
+// LockedCoins returns the set of coins that are not spendable (i.e. locked),
+// defined as the vesting coins that are not delegated.
+func (cva ClawbackVestingAccount) LockedCoins(blockTime time.Time) sdk.Coins {
+	return cva.BaseVestingAccount.LockedCoinsFromVesting(cva.GetVestingCoins(blockTime))
+}
+
+// TrackDelegation tracks a desired delegation amount by setting the appropriate
+// values for the amount of delegated vesting, delegated free, and reducing the
+// overall amount of base coins.
+func (cva *ClawbackVestingAccount) TrackDelegation(blockTime time.Time, balance, amount sdk.Coins) {
+	cva.BaseVestingAccount.TrackDelegation(balance, cva.GetVestingCoins(blockTime), amount)
+}
+
+// GetStartTime returns the time when vesting starts for a clawback vesting
+// account.
+func (cva ClawbackVestingAccount) GetStartTime() int64 {
+	return cva.StartTime
+}
+
+// GetClawbackAdmin returns the address allowed to claw back the unvested coins.
+func (cva ClawbackVestingAccount) GetClawbackAdmin() string {
+	if cva.ClawbackAdmin != "" {
+		return cva.ClawbackAdmin
+	}
+	return cva.FunderAddress
+}
+
+// GetSchedules returns the vesting schedules of the account.
+func (cva ClawbackVestingAccount) GetSchedules() Schedules {
+	if len(cva.Schedules) > 0 {
+		return Schedules(cva.Schedules)
+	}
+	return Schedules{NewSchedule(cva.StartTime, cva.EndTime, cva.Delayed, cva.OriginalVesting)}
+}
+
+// AddSchedule adds the coins of schedule, which must have been sent to the
+// account, to its original vesting coins. The coins already delegated are
+// tracked again as delegated vesting up to the coins vesting at blockTime.
+func (cva *ClawbackVestingAccount) AddSchedule(blockTime time.Time, schedule Schedule) {
+	cva.Schedules = append(cva.GetSchedules(), schedule)
+	cva.OriginalVesting = cva.OriginalVesting.Add(schedule.Amount...)
+	cva.StartTime = Schedules(cva.Schedules).GetStartTime()
+	cva.EndTime = Schedules(cva.Schedules).GetEndTime()
+
+	delegated := cva.DelegatedFree.Add(cva.DelegatedVesting...)
+	TrackExistingDelegations(cva.BaseVestingAccount, delegated, cva.GetVestingCoins(blockTime))
+}
+
+// EndVesting removes the given unvested coins from the vesting schedule and
+// marks all the remaining coins as vested at blockTime. The delegated amount
+// is reduced by the given delegated coins, which are no longer owned by the
+// account, and the rest is tracked as delegated free from now on.
+func (cva *ClawbackVestingAccount) EndVesting(blockTime time.Time, unvested, delegated sdk.Coins) {
+	cva.OriginalVesting = cva.OriginalVesting.Sub(unvested...)
+
+	totalDelegated := cva.DelegatedFree.Add(cva.DelegatedVesting...)
+	cva.DelegatedFree = totalDelegated.Sub(totalDelegated.Min(delegated)...)
+	cva.DelegatedVesting = sdk.NewCoins()
+
+	if cva.EndTime > blockTime.Unix() {
+		cva.EndTime = blockTime.Unix()
+	}
+	if cva.StartTime > cva.EndTime {
+		cva.StartTime = cva.EndTime
+	}
+	cva.Schedules = nil
+}
+
+// Validate checks for errors on the account fields
+func (cva ClawbackVestingAccount) Validate() error {
+	if cva.GetStartTime() > cva.GetEndTime() {
+		return errors.New("vesting start-time cannot be after end-time")
+	}
+
+	if len(cva.Schedules) > 0 {
+		if err := Schedules(cva.Schedules).Validate(cva.OriginalVesting, cva.EndTime); err != nil {
+			return err
+		}
+	}
+
+	if _, err := sdk.AccAddressFromBech32(cva.FunderAddress); err != nil {
+		return errors.New("invalid funder address: " + err.Error())
+	}
+
+	if cva.ClawbackAdmin != "" {
+		if _, err := sdk.AccAddressFromBech32(cva.ClawbackAdmin); err != nil {
+			return errors.New("invalid clawback admin address: " + err.Error())
+		}
+	}
+
+	return cva.BaseVestingAccount.Validate()
+}
+
+type clawbackVestingAccountYAML struct {
+	Address          string    `yaml:"address"`
+	AccountNumber    uint64    `yaml:"account_number"`
+	Sequence         uint64    `yaml:"sequence"`
+	OriginalVesting  sdk.Coins `yaml:"original_vesting"`
+	DelegatedFree    sdk.Coins `yaml:"delegated_free"`
+	DelegatedVesting sdk.Coins `yaml:"delegated_vesting"`
+	StartTime        int64     `yaml:"start_time"`
+	EndTime          int64     `yaml:"end_time"`
+	Delayed          bool      `yaml:"delayed"`
+	FunderAddress    string    `yaml:"funder_address"`
+	ClawbackAdmin    string    `yaml:"clawback_admin"`
+	Schedules        Schedules `yaml:"schedules,omitempty"`
+}
+
+func (cva ClawbackVestingAccount) String() string {
+	out, _ := cva.MarshalYAML()
+	return out.(string)
+}
+
+// MarshalYAML returns the YAML representation of a ClawbackVestingAccount.
+func (cva ClawbackVestingAccount) MarshalYAML() (interface{}, error) {
+	out, err := yaml.Marshal(clawbackVestingAccountYAML{
+		Address:          cva.Address,
+		AccountNumber:    cva.AccountNumber,
+		Sequence:         cva.Sequence,
+		OriginalVesting:  cva.OriginalVesting,
+		DelegatedFree:    cva.DelegatedFree,
+		DelegatedVesting: cva.DelegatedVesting,
+		StartTime:        cva.StartTime,
+		EndTime:          cva.EndTime,
+		Delayed:          cva.Delayed,
+		FunderAddress:    cva.FunderAddress,
+		ClawbackAdmin:    cva.ClawbackAdmin,
+		Schedules:        cva.Schedules,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return string(out), nil
+}
@@ -0,0 +1,144 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/stretchr/testify/require"
+)
+
+func newClawbackVestingAccount(startTime, endTime time.Time, delayed bool) *ClawbackVestingAccount {
+	bacc := authtypes.NewBaseAccountWithAddress(AccAddress())
+	origCoins := sdk.NewCoins(sdk.NewInt64Coin("unls", 1000), sdk.NewInt64Coin("uosmo", 100))
+	bva := vestingtypes.NewBaseVestingAccount(bacc, origCoins, endTime.Unix())
+
+	return NewClawbackVestingAccount(bva, startTime.Unix(), delayed, AccAddress().String(), "")
+}
+
+func TestClawbackVestingAccount_GetVestedCoins(t *testing.T) {
+	now := time.Now()
+	endTime := now.Add(24 * time.Hour)
+
+	cva := newClawbackVestingAccount(now, endTime, false)
+	require.Nil(t, cva.GetVestedCoins(now))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("unls", 500), sdk.NewInt64Coin("uosmo", 50)), cva.GetVestedCoins(now.Add(12*time.Hour)))
+	require.Equal(t, cva.OriginalVesting, cva.GetVestedCoins(endTime))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("unls", 500), sdk.NewInt64Coin("uosmo", 50)), cva.GetVestingCoins(now.Add(12*time.Hour)))
+
+	dva := newClawbackVestingAccount(now, endTime, true)
+	require.Nil(t, dva.GetVestedCoins(now.Add(12*time.Hour)))
+	require.Equal(t, dva.OriginalVesting, dva.GetVestingCoins(now.Add(12*time.Hour)))
+	require.Equal(t, dva.OriginalVesting, dva.GetVestedCoins(endTime))
+}
+
+func TestClawbackVestingAccount_TrackDelegation(t *testing.T) {
+	now := time.Now()
+	endTime := now.Add(24 * time.Hour)
+
+	cva := newClawbackVestingAccount(now, endTime, false)
+	cva.TrackDelegation(now.Add(12*time.Hour), cva.OriginalVesting, sdk.NewCoins(sdk.NewInt64Coin("unls", 700)))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("unls", 500)), cva.DelegatedVesting)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("unls", 200)), cva.DelegatedFree)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uosmo", 50)), cva.LockedCoins(now.Add(12*time.Hour)))
+}
+
+func TestClawbackVestingAccount_EndVesting(t *testing.T) {
+	now := time.Now()
+	endTime := now.Add(24 * time.Hour)
+	blockTime := now.Add(12 * time.Hour)
+
+	cva := newClawbackVestingAccount(now, endTime, false)
+	cva.TrackDelegation(blockTime, cva.OriginalVesting, sdk.NewCoins(sdk.NewInt64Coin("unls", 700)))
+	cva.EndVesting(blockTime, cva.GetVestingCoins(blockTime), sdk.NewCoins(sdk.NewInt64Coin("unls", 500)))
+
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("unls", 500), sdk.NewInt64Coin("uosmo", 50)), cva.OriginalVesting)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("unls", 200)), cva.DelegatedFree)
+	require.True(t, cva.DelegatedVesting.IsZero())
+	require.Equal(t, blockTime.Unix(), cva.EndTime)
+	require.True(t, cva.GetVestingCoins(blockTime).IsZero())
+	require.True(t, cva.LockedCoins(blockTime).IsZero())
+	require.NoError(t, cva.Validate())
+
+	// a schedule which has not started yet ends with nothing vested
+	fva := newClawbackVestingAccount(endTime, endTime.Add(time.Hour), false)
+	fva.EndVesting(blockTime, fva.GetVestingCoins(blockTime), sdk.NewCoins())
+	require.True(t, fva.OriginalVesting.IsZero())
+	require.Equal(t, blockTime.Unix(), fva.StartTime)
+	require.NoError(t, fva.Validate())
+}
+
+func TestClawbackVestingAccount_Validate(t *testing.T) {
+	now := time.Now()
+
+	cva := newClawbackVestingAccount(now, now.Add(time.Hour), false)
+	require.NoError(t, cva.Validate())
+	require.Equal(t, cva.FunderAddress, cva.GetClawbackAdmin())
+
+	cva.ClawbackAdmin = AccAddress().String()
+	require.NoError(t, cva.Validate())
+	require.Equal(t, cva.ClawbackAdmin, cva.GetClawbackAdmin())
+
+	cva.ClawbackAdmin = "invalid_address"
+	require.Error(t, cva.Validate())
+
+	cva = newClawbackVestingAccount(now, now.Add(time.Hour), false)
+	cva.FunderAddress = ""
+	require.Error(t, cva.Validate())
+
+	cva = newClawbackVestingAccount(now.Add(time.Hour), now, false)
+	require.Error(t, cva.Validate())
+}
+
+func TestClawbackVestingAccount_AddSchedule(t *testing.T) {
+	now := time.Now()
+	endTime := now.Add(24 * time.Hour)
+	blockTime := now.Add(12 * time.Hour)
+
+	cva := newClawbackVestingAccount(now, endTime, false)
+	cva.TrackDelegation(blockTime, cva.OriginalVesting, sdk.NewCoins(sdk.NewInt64Coin("unls", 700)))
+
+	amount := sdk.NewCoins(sdk.NewInt64Coin("unls", 1000))
+	cva.AddSchedule(blockTime, NewSchedule(blockTime.Unix(), endTime.Add(24*time.Hour).Unix(), true, amount))
+	require.NoError(t, cva.Validate())
+	require.Len(t, cva.Schedules, 2)
+	require.Equal(t, now.Unix(), cva.GetStartTime())
+	require.Equal(t, endTime.Add(24*time.Hour).Unix(), cva.GetEndTime())
+
+	// the delegation is tracked as vesting up to the new vesting coins
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("unls", 700)), cva.DelegatedVesting)
+	require.True(t, cva.DelegatedFree.IsZero())
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("unls", 500), sdk.NewInt64Coin("uosmo", 50)), cva.GetVestedCoins(blockTime))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("unls", 1000), sdk.NewInt64Coin("uosmo", 100)), cva.GetVestedCoins(endTime))
+	require.Equal(t, cva.OriginalVesting, cva.GetVestedCoins(endTime.Add(24*time.Hour)))
+
+	cva.Schedules[1].Amount = sdk.NewCoins(sdk.NewInt64Coin("unls", 1))
+	require.Error(t, cva.Validate())
+}
+
+func TestScheduledVestingAccount(t *testing.T) {
+	now := time.Now()
+	endTime := now.Add(24 * time.Hour)
+	origCoins := sdk.NewCoins(sdk.NewInt64Coin("unls", 1000))
+
+	bva := vestingtypes.NewBaseVestingAccount(authtypes.NewBaseAccountWithAddress(AccAddress()), origCoins, endTime.Unix())
+	sva, err := NewScheduledVestingAccountFrom(vestingtypes.NewDelayedVestingAccountRaw(bva))
+	require.NoError(t, err)
+	require.NoError(t, sva.Validate())
+	require.Nil(t, sva.GetVestedCoins(now))
+
+	sva.AddSchedule(now, NewSchedule(now.Unix(), endTime.Add(24*time.Hour).Unix(), false, origCoins))
+	require.NoError(t, sva.Validate())
+	require.Equal(t, endTime.Add(24*time.Hour).Unix(), sva.GetEndTime())
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("unls", 1500)), sva.GetVestedCoins(endTime))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("unls", 500)), sva.GetVestingCoins(endTime))
+	require.Equal(t, sva.OriginalVesting, sva.GetVestedCoins(endTime.Add(24*time.Hour)))
+
+	sva.Schedules = nil
+	require.Error(t, sva.Validate())
+
+	_, err = NewScheduledVestingAccountFrom(vestingtypes.NewPermanentLockedAccount(authtypes.NewBaseAccountWithAddress(AccAddress()), origCoins))
+	require.Error(t, err)
+}